@@ -65,6 +65,7 @@ func GetSupportedGitProviders() []GitProvider {
 		{"aws-codecommit", "AWS CodeCommit"},
 		{"gogs", "Gogs"},
 		{"gitee", "Gitee"},
+		{"generic", "Generic Git"},
 	}
 }
 
@@ -96,6 +97,8 @@ func GetDocsLinkFromGitProvider(providerId string) string {
 		return "https://www.daytona.io/docs/configuration/git-providers/#gogs"
 	case "gitee":
 		return "https://www.daytona.io/docs/configuration/git-providers/#gitee"
+	case "generic":
+		return "https://www.daytona.io/docs/configuration/git-providers/#generic-git"
 	default:
 		return ""
 	}
@@ -151,6 +154,26 @@ func GetRequiredScopesFromGitProviderId(providerId string) string {
 	}
 }
 
+// Returns the space separated scopes requested in the OAuth device flow
+func GetOAuthScopesFromGitProviderId(providerId string) string {
+	switch providerId {
+	case "github":
+		fallthrough
+	case "github-enterprise-server":
+		return "repo read:user user:email"
+	case "gitlab":
+		fallthrough
+	case "gitlab-self-managed":
+		return "api read_user write_repository"
+	case "codeberg":
+		fallthrough
+	case "gitea":
+		return "read:organization write:repository read:user"
+	default:
+		return ""
+	}
+}
+
 func GetPrebuildScopesFromGitProviderId(providerId string) string {
 	switch providerId {
 	case "github":
@@ -13,6 +13,7 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/mock"
@@ -47,6 +48,16 @@ func (m *MockApiClient) ImageList(ctx context.Context, options image.ListOptions
 	return args.Get(0).([]image.Summary), args.Error(1)
 }
 
+func (m *MockApiClient) ImageBuild(ctx context.Context, buildContext io.Reader, options types.ImageBuildOptions) (types.ImageBuildResponse, error) {
+	args := m.Called(ctx, buildContext, options)
+	return args.Get(0).(types.ImageBuildResponse), args.Error(1)
+}
+
+func (m *MockApiClient) ImageInspectWithRaw(ctx context.Context, imageID string) (types.ImageInspect, []byte, error) {
+	args := m.Called(ctx, imageID)
+	return args.Get(0).(types.ImageInspect), nil, args.Error(1)
+}
+
 func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image.PullOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, ref, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -107,6 +118,11 @@ func (m *MockApiClient) ContainerList(ctx context.Context, options container.Lis
 	return args.Get(0).([]types.Container), args.Error(1)
 }
 
+func (m *MockApiClient) VolumeList(ctx context.Context, options volume.ListOptions) (volume.ListResponse, error) {
+	args := m.Called(ctx, options)
+	return args.Get(0).(volume.ListResponse), args.Error(1)
+}
+
 func (m *MockApiClient) VolumeRemove(ctx context.Context, volume string, force bool) error {
 	args := m.Called(ctx, volume, force)
 	return args.Error(0)
@@ -6,6 +6,7 @@
 package mocks
 
 import (
+	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -41,6 +42,56 @@ func (m *MockGitService) GetGitStatus() (*models.GitStatus, error) {
 	return args.Get(0).(*models.GitStatus), args.Error(1)
 }
 
+func (m *MockGitService) Diff(options git.GitDiffOptions) ([]git.GitFileDiff, error) {
+	args := m.Called(options)
+	return args.Get(0).([]git.GitFileDiff), args.Error(1)
+}
+
+func (m *MockGitService) Checkout(ref string, create bool) error {
+	args := m.Called(ref, create)
+	return args.Error(0)
+}
+
+func (m *MockGitService) StashPush(message string, includeUntracked bool) error {
+	args := m.Called(message, includeUntracked)
+	return args.Error(0)
+}
+
+func (m *MockGitService) StashPop(index int) error {
+	args := m.Called(index)
+	return args.Error(0)
+}
+
+func (m *MockGitService) StashList() ([]git.GitStashEntry, error) {
+	args := m.Called()
+	return args.Get(0).([]git.GitStashEntry), args.Error(1)
+}
+
+func (m *MockGitService) Reset(ref string, mode git.GitResetMode) error {
+	args := m.Called(ref, mode)
+	return args.Error(0)
+}
+
+func (m *MockGitService) Merge(branch string, noFastForward bool) (*git.GitMergeResult, error) {
+	args := m.Called(branch, noFastForward)
+	return args.Get(0).(*git.GitMergeResult), args.Error(1)
+}
+
+func (m *MockGitService) MergeAbort() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockGitService) RebaseContinue() (*git.GitMergeResult, error) {
+	args := m.Called()
+	return args.Get(0).(*git.GitMergeResult), args.Error(1)
+}
+
+func (m *MockGitService) RebaseAbort() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func NewMockGitService() *MockGitService {
 	gitService := new(MockGitService)
 	return gitService
@@ -36,6 +36,11 @@ func (m *MockGitProvider) GetUser() (*gitprovider.GitUser, error) {
 	return args.Get(0).(*gitprovider.GitUser), args.Error(1)
 }
 
+func (m *MockGitProvider) GetTokenInfo() (*gitprovider.TokenInfo, error) {
+	args := m.Called()
+	return args.Get(0).(*gitprovider.TokenInfo), args.Error(1)
+}
+
 func (m *MockGitProvider) GetBranchByCommit(staticContext *gitprovider.StaticGitContext) (string, error) {
 	args := m.Called(staticContext)
 	return args.String(0), args.Error(1)
@@ -81,8 +86,8 @@ func (m *MockGitProvider) GetDefaultBranch(staticContext *gitprovider.StaticGitC
 	return args.Get(0).(*string), args.Error(1)
 }
 
-func (m *MockGitProvider) RegisterPrebuildWebhook(repo *gitprovider.GitRepository, endpointUrl string) (string, error) {
-	args := m.Called(repo, endpointUrl)
+func (m *MockGitProvider) RegisterPrebuildWebhook(repo *gitprovider.GitRepository, endpointUrl string, secret string) (string, error) {
+	args := m.Called(repo, endpointUrl, secret)
 	return args.String(0), args.Error(1)
 }
 
@@ -96,6 +101,26 @@ func (m *MockGitProvider) UnregisterPrebuildWebhook(repo *gitprovider.GitReposit
 	return args.Error(0)
 }
 
+func (m *MockGitProvider) GetPrComment(repo *gitprovider.GitRepository, prNumber uint32, marker string) (*string, error) {
+	args := m.Called(repo, prNumber, marker)
+	return args.Get(0).(*string), args.Error(1)
+}
+
+func (m *MockGitProvider) CreatePrComment(repo *gitprovider.GitRepository, prNumber uint32, body string) (string, error) {
+	args := m.Called(repo, prNumber, body)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockGitProvider) UpdatePrComment(repo *gitprovider.GitRepository, prNumber uint32, commentId string, body string) error {
+	args := m.Called(repo, prNumber, commentId, body)
+	return args.Error(0)
+}
+
+func (m *MockGitProvider) SetCommitStatus(repo *gitprovider.GitRepository, status gitprovider.CommitStatus) error {
+	args := m.Called(repo, status)
+	return args.Error(0)
+}
+
 func (m *MockGitProvider) GetCommitsRange(repo *gitprovider.GitRepository, initialSha string, currentSha string) (int, error) {
 	args := m.Called(repo, initialSha, currentSha)
 	return args.Int(0), args.Error(1)
@@ -105,3 +130,8 @@ func (m *MockGitProvider) ParseEventData(request *http.Request) (*gitprovider.Gi
 	args := m.Called(request)
 	return args.Get(0).(*gitprovider.GitEventData), args.Error(1)
 }
+
+func (m *MockGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	args := m.Called(request, payload, secret)
+	return args.Error(0)
+}
@@ -63,6 +63,11 @@ func (b *MockBuilder) SaveBuild(r models.Build) error {
 	return args.Error(0)
 }
 
+func (b *MockBuilder) GetCacheStats() *models.BuildCacheStats {
+	args := b.Called()
+	return args.Get(0).(*models.BuildCacheStats)
+}
+
 func (b *MockBuilder) GetImageName(build models.Build) (string, error) {
 	args := b.Called(build)
 	return args.String(0), args.Error(1)
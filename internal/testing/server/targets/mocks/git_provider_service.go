@@ -86,6 +86,16 @@ func (m *MockGitProviderService) SaveGitProviderConfig(providerConfig *models.Gi
 	return args.Error(0)
 }
 
+func (m *MockGitProviderService) CheckTokenHealth() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockGitProviderService) StartTokenHealthPoller() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func (m *MockGitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (string, error) {
 	args := m.Called(repo)
 	return args.String(0), args.Error(1)
@@ -104,3 +114,18 @@ func (m *MockGitProviderService) UnregisterPrebuildWebhook(gitProviderId string,
 	args := m.Called(gitProviderId, repo, id)
 	return args.Error(0)
 }
+
+func (m *MockGitProviderService) SetPrebuildCommitStatus(repo *gitprovider.GitRepository, buildId string, jobState models.JobState) error {
+	args := m.Called(repo, buildId, jobState)
+	return args.Error(0)
+}
+
+func (m *MockGitProviderService) PostPrBotComment(data gitprovider.GitEventData) error {
+	args := m.Called(data)
+	return args.Error(0)
+}
+
+func (m *MockGitProviderService) UpdatePrBotComment(repo *gitprovider.GitRepository, buildState models.JobState) error {
+	args := m.Called(repo, buildState)
+	return args.Error(0)
+}
@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/daytonaio/daytona/pkg/services"
@@ -76,6 +78,21 @@ func (m *mockWorkspaceTemplateService) EnforceRetentionPolicy() error {
 	return args.Error(0)
 }
 
+func (m *mockWorkspaceTemplateService) StartSchedulePoller() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *mockWorkspaceTemplateService) MigratePrebuildWebhooks() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *mockWorkspaceTemplateService) ProcessScheduledPrebuilds(from, to time.Time) error {
+	args := m.Called(from, to)
+	return args.Error(0)
+}
+
 func (m *mockWorkspaceTemplateService) ProcessGitEvent(data gitprovider.GitEventData) error {
 	args := m.Called(data)
 	return args.Error(0)
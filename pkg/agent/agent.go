@@ -153,6 +153,8 @@ func (a *Agent) startWorkspaceMode() error {
 			}
 		}
 
+		a.cloneAdditionalRepositories()
+
 		var gitUser *gitprovider.GitUser
 		if gitProvider != nil {
 			user, err := a.getGitUser(gitProvider.Id)
@@ -186,6 +188,17 @@ func (a *Agent) startWorkspaceMode() error {
 		log.Error(fmt.Sprintf("failed to set docker config: %s", err))
 	}
 
+	a.forwardPorts = a.getForwardPorts()
+
+	if a.LifecycleHooks != nil {
+		go func() {
+			err := a.LifecycleHooks.Run()
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to run lifecycle hooks: %s", err))
+			}
+		}()
+	}
+
 	go func() {
 		for {
 			err := a.updateWorkspaceMetadata()
@@ -200,6 +213,46 @@ func (a *Agent) startWorkspaceMode() error {
 	return nil
 }
 
+// cloneAdditionalRepositories clones the additional repositories of the workspace
+// with the credentials of the git provider matching each repository
+func (a *Agent) cloneAdditionalRepositories() {
+	for _, additionalRepository := range a.Workspace.AdditionalRepositories {
+		gitService, ok := a.AdditionalGit[additionalRepository.ClonePath]
+		if !ok {
+			continue
+		}
+
+		exists, err := gitService.RepositoryExists()
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to clone repository %s: %s", additionalRepository.ClonePath, err))
+			continue
+		}
+
+		if exists {
+			log.Info(fmt.Sprintf("Repository %s already exists. Skipping clone...", additionalRepository.ClonePath))
+			continue
+		}
+
+		// Ignoring error because we don't want to fail if the git provider is not found
+		gitProvider, _ := a.getGitProvider(additionalRepository.Repository.Url)
+
+		var auth *http.BasicAuth
+		if gitProvider != nil {
+			auth = &http.BasicAuth{}
+			auth.Username = gitProvider.Username
+			auth.Password = gitProvider.Token
+		}
+
+		log.Info(fmt.Sprintf("Cloning repository %s...", additionalRepository.ClonePath))
+		err = gitService.CloneRepository(additionalRepository.Repository, auth)
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to clone repository %s: %s", additionalRepository.ClonePath, err))
+		} else {
+			log.Info(fmt.Sprintf("Repository %s cloned", additionalRepository.ClonePath))
+		}
+	}
+}
+
 func (a *Agent) getGitProvider(repoUrl string) (*apiclient.GitProvider, error) {
 	ctx := context.Background()
 
@@ -276,12 +329,15 @@ func (a *Agent) updateWorkspaceMetadata() error {
 	}
 
 	var gitStatus *models.GitStatus
+	var additionalGitStatuses map[string]*models.GitStatus
 	if a.Config.SkipClone == "" {
 		var err error
 		gitStatus, err = a.Git.GetGitStatus()
 		if err != nil {
 			return err
 		}
+
+		additionalGitStatuses = a.getAdditionalGitStatuses()
 	}
 
 	uptime := a.uptime()
@@ -291,9 +347,32 @@ func (a *Agent) updateWorkspaceMetadata() error {
 		return err
 	}
 
+	var lifecycleHooks []apiclient.LifecycleHookStatus
+	if a.LifecycleHooks != nil {
+		statuses := a.LifecycleHooks.Status()
+		lifecycleHooksDto, err := conversion.Convert[[]models.LifecycleHookStatus, []apiclient.LifecycleHookStatus](&statuses)
+		if err != nil {
+			return err
+		}
+		lifecycleHooks = *lifecycleHooksDto
+	}
+
+	forwardPorts, err := conversion.Convert[[]models.WorkspacePort, []apiclient.WorkspacePort](&a.forwardPorts)
+	if err != nil {
+		return err
+	}
+
+	additionalGitStatusesDto, err := conversion.Convert[map[string]*models.GitStatus, map[string]apiclient.GitStatus](&additionalGitStatuses)
+	if err != nil {
+		return err
+	}
+
 	res, err := apiClient.WorkspaceAPI.UpdateWorkspaceMetadata(context.Background(), a.Config.WorkspaceId).WorkspaceMetadata(apiclient.UpdateWorkspaceMetadataDTO{
-		Uptime:    uptime,
-		GitStatus: gitStatusDto,
+		Uptime:                uptime,
+		GitStatus:             gitStatusDto,
+		LifecycleHooks:        lifecycleHooks,
+		ForwardPorts:          *forwardPorts,
+		AdditionalGitStatuses: *additionalGitStatusesDto,
 	}).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
@@ -302,6 +381,26 @@ func (a *Agent) updateWorkspaceMetadata() error {
 	return nil
 }
 
+// getAdditionalGitStatuses returns the git status of each cloned additional repository.
+// Repositories that are not cloned are skipped so they don't prevent the metadata update.
+func (a *Agent) getAdditionalGitStatuses() map[string]*models.GitStatus {
+	if len(a.AdditionalGit) == 0 {
+		return nil
+	}
+
+	gitStatuses := map[string]*models.GitStatus{}
+	for clonePath, gitService := range a.AdditionalGit {
+		gitStatus, err := gitService.GetGitStatus()
+		if err != nil {
+			log.Debug(fmt.Sprintf("failed to get git status of repository %s: %s", clonePath, err))
+			continue
+		}
+		gitStatuses[clonePath] = gitStatus
+	}
+
+	return gitStatuses
+}
+
 func (a *Agent) updateTargetMetadata() error {
 	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
 	if err != nil {
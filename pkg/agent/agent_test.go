@@ -15,6 +15,7 @@ import (
 	mock_git "github.com/daytonaio/daytona/internal/testing/git/mocks"
 	"github.com/daytonaio/daytona/pkg/agent"
 	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 )
@@ -34,6 +35,15 @@ var workspace1 = &models.Workspace{
 	},
 }
 
+var additionalRepository1 = &models.WorkspaceRepository{
+	Repository: &gitprovider.GitRepository{
+		Id:   "456",
+		Url:  "https://github.com/daytonaio/docs",
+		Name: "docs",
+	},
+	ClonePath: "docs",
+}
+
 var targetConfig1 = &models.TargetConfig{
 	Name: "test",
 	ProviderInfo: models.ProviderInfo{
@@ -84,6 +94,14 @@ func TestAgent(t *testing.T) {
 	mockGitService.On("SetGitConfig", mock.Anything, mock.Anything).Return(nil)
 	mockGitService.On("GetGitStatus").Return(gitStatus1, nil).Maybe()
 
+	mockAdditionalGitService := mock_git.NewMockGitService()
+	mockAdditionalGitService.On("RepositoryExists").Return(false, nil)
+	mockAdditionalGitService.On("CloneRepository", additionalRepository1.Repository, mock.Anything).Return(nil)
+	mockAdditionalGitService.On("GetGitStatus").Return(gitStatus1, nil).Maybe()
+
+	workspace := *workspace1
+	workspace.AdditionalRepositories = []*models.WorkspaceRepository{additionalRepository1}
+
 	mockSshServer := mocks.NewMockSshServer()
 	mockTailscaleServer := mocks.NewMockTailscaleServer()
 	mockToolboxServer := mocks.NewMockToolboxServer()
@@ -95,10 +113,11 @@ func TestAgent(t *testing.T) {
 	a := &agent.Agent{
 		Config:           mockConfig,
 		Git:              mockGitService,
+		AdditionalGit:    map[string]git.IGitService{additionalRepository1.ClonePath: mockAdditionalGitService},
 		Ssh:              mockSshServer,
 		Tailscale:        mockTailscaleServer,
 		Toolbox:          mockToolboxServer,
-		Workspace:        workspace1,
+		Workspace:        &workspace,
 		DockerCredHelper: mockDockerCredHelper,
 	}
 
@@ -110,6 +129,7 @@ func TestAgent(t *testing.T) {
 
 	t.Cleanup(func() {
 		mockGitService.AssertExpectations(t)
+		mockAdditionalGitService.AssertExpectations(t)
 		mockSshServer.AssertExpectations(t)
 		mockTailscaleServer.AssertExpectations(t)
 		mockToolboxServer.AssertExpectations(t)
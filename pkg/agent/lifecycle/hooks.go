@@ -0,0 +1,264 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+const (
+	PostCreateCommand = "postCreateCommand"
+	PostStartCommand  = "postStartCommand"
+	PostAttachCommand = "postAttachCommand"
+)
+
+// Lifecycle commands in the order they are run by the devcontainer CLI
+var lifecycleOrder = []string{
+	string(devcontainer.WaitForInitializeCommand),
+	string(devcontainer.WaitForOnCreateCommand),
+	string(devcontainer.WaitForUpdateContentCommand),
+	PostCreateCommand,
+	PostStartCommand,
+	PostAttachCommand,
+}
+
+// HookRunner runs the devcontainer lifecycle commands that the devcontainer CLI skips as non-blocking,
+// i.e. the postCreateCommand and postStartCommand if they come after the waitFor command. The
+// postCreateCommand is only run once per container while the postStartCommand is run on every start.
+// The postAttachCommand is not run since tools attach to the workspace over SSH without notifying the agent.
+type HookRunner struct {
+	WorkspaceDir string
+	// Build config of the workspace, automatic build configs are resolved to the detected devcontainer config
+	BuildConfig *models.BuildConfig
+	// Directory used to mark create commands as completed
+	StateDir  string
+	LogWriter io.Writer
+
+	mutex    sync.Mutex
+	statuses []models.LifecycleHookStatus
+}
+
+func (r *HookRunner) Run() error {
+	configFilePath, ok := detect.GetDevcontainerConfigFilePath(r.BuildConfig, r.WorkspaceDir)
+	if !ok {
+		return nil
+	}
+
+	config, err := devcontainer.ReadConfiguration(filepath.Join(r.WorkspaceDir, configFilePath))
+	if err != nil {
+		return err
+	}
+
+	hooks := []struct {
+		name    string
+		command devcontainer.Command
+	}{
+		{PostCreateCommand, config.PostCreateCommand},
+		{PostStartCommand, config.PostStartCommand},
+	}
+
+	for _, hook := range hooks {
+		if isBlocking(hook.name, config.WaitFor) || isEmptyCommand(hook.command) {
+			continue
+		}
+
+		if hook.name == PostCreateCommand {
+			if _, err := os.Stat(filepath.Join(r.StateDir, hook.name)); err == nil {
+				r.writeLog(fmt.Sprintf("Skipping %s, it already ran in this workspace\n", hook.name))
+				continue
+			}
+		}
+
+		err := r.runHook(hook.name, hook.command)
+		if err != nil {
+			// Like the devcontainer CLI, the remaining commands are not run after a failure
+			return fmt.Errorf("%s failed: %w", hook.name, err)
+		}
+
+		if hook.name == PostCreateCommand {
+			err = os.MkdirAll(r.StateDir, 0755)
+			if err == nil {
+				err = os.WriteFile(filepath.Join(r.StateDir, hook.name), []byte(time.Now().Format(time.RFC3339)), 0644)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to mark %s as completed: %w", hook.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Status returns the results of the lifecycle commands run since the agent started
+func (r *HookRunner) Status() []models.LifecycleHookStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if len(r.statuses) == 0 {
+		return nil
+	}
+
+	statuses := make([]models.LifecycleHookStatus, len(r.statuses))
+	copy(statuses, r.statuses)
+
+	return statuses
+}
+
+func (r *HookRunner) runHook(name string, command devcontainer.Command) error {
+	index := r.setStatus(-1, models.LifecycleHookStatus{
+		Name:      name,
+		State:     models.LifecycleHookStateRunning,
+		StartedAt: time.Now(),
+	})
+
+	r.writeLog(fmt.Sprintf("Running %s...\n", name))
+
+	err := r.runCommand(command)
+
+	status := r.Status()[index]
+	status.FinishedAt = util.Pointer(time.Now())
+	if err != nil {
+		status.State = models.LifecycleHookStateFailed
+		status.Error = util.Pointer(err.Error())
+		r.writeLog(fmt.Sprintf("%s failed: %s\n", name, err))
+	} else {
+		status.State = models.LifecycleHookStateSucceeded
+		r.writeLog(fmt.Sprintf("%s completed\n", name))
+	}
+	r.setStatus(index, status)
+
+	return err
+}
+
+// A command is either a shell command string, an array of arguments run without a shell
+// or an object of named commands that are run in parallel
+func (r *HookRunner) runCommand(command devcontainer.Command) error {
+	switch c := command.(type) {
+	case string:
+		if runtime.GOOS == "windows" {
+			return r.exec("cmd", "/C", c)
+		}
+		return r.exec("sh", "-c", c)
+	case []interface{}:
+		args, err := toArgs(c)
+		if err != nil {
+			return err
+		}
+		return r.exec(args[0], args[1:]...)
+	case map[string]interface{}:
+		names := make([]string, 0, len(c))
+		for name := range c {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(names))
+		for i, name := range names {
+			wg.Add(1)
+			go func(i int, name string) {
+				defer wg.Done()
+				err := r.runCommand(c[name])
+				if err != nil {
+					errs[i] = fmt.Errorf("%s: %w", name, err)
+				}
+			}(i, name)
+		}
+		wg.Wait()
+
+		return errors.Join(errs...)
+	default:
+		return fmt.Errorf("unsupported command type %T", command)
+	}
+}
+
+func (r *HookRunner) exec(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = r.WorkspaceDir
+	cmd.Env = os.Environ()
+	cmd.Stdout = r.LogWriter
+	cmd.Stderr = r.LogWriter
+
+	return cmd.Run()
+}
+
+// setStatus replaces the status at the index or appends it if the index is negative and returns its index
+func (r *HookRunner) setStatus(index int, status models.LifecycleHookStatus) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if index < 0 {
+		r.statuses = append(r.statuses, status)
+		return len(r.statuses) - 1
+	}
+
+	r.statuses[index] = status
+	return index
+}
+
+func (r *HookRunner) writeLog(msg string) {
+	if r.LogWriter != nil {
+		r.LogWriter.Write([]byte(msg)) // nolint: errcheck
+	}
+}
+
+// The commands up to and including waitFor are run by the devcontainer CLI
+func isBlocking(name string, waitFor devcontainer.WaitFor) bool {
+	if waitFor == "" {
+		waitFor = devcontainer.WaitForUpdateContentCommand
+	}
+
+	for _, command := range lifecycleOrder {
+		if command == name {
+			return true
+		}
+		if command == string(waitFor) {
+			return false
+		}
+	}
+
+	return false
+}
+
+func isEmptyCommand(command devcontainer.Command) bool {
+	switch c := command.(type) {
+	case nil:
+		return true
+	case string:
+		return c == ""
+	case []interface{}:
+		return len(c) == 0
+	case map[string]interface{}:
+		return len(c) == 0
+	}
+
+	return false
+}
+
+func toArgs(command []interface{}) ([]string, error) {
+	args := make([]string, 0, len(command))
+	for _, arg := range command {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid command argument %v", arg)
+		}
+		args = append(args, s)
+	}
+
+	return args, nil
+}
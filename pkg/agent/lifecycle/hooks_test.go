@@ -0,0 +1,139 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `{
+	// Comments are allowed in devcontainer.json
+	"image": "ubuntu",
+	"onCreateCommand": "echo on-create >> hooks.log",
+	"postCreateCommand": "echo post-create >> hooks.log",
+	"postStartCommand": ["sh", "-c", "echo post-start >> hooks.log"],
+	"postAttachCommand": {
+		"first": "echo post-attach-1 >> attach-1.log",
+		"second": "echo post-attach-2 >> attach-2.log",
+	},
+}`
+
+func newTestHookRunner(t *testing.T, config string) *HookRunner {
+	workspaceDir := t.TempDir()
+
+	err := os.MkdirAll(filepath.Join(workspaceDir, ".devcontainer"), 0755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(workspaceDir, ".devcontainer", "devcontainer.json"), []byte(config), 0644)
+	require.NoError(t, err)
+
+	return &HookRunner{
+		WorkspaceDir: workspaceDir,
+		BuildConfig:  &models.BuildConfig{},
+		StateDir:     t.TempDir(),
+		LogWriter:    &bytes.Buffer{},
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(content)
+}
+
+func TestRunLifecycleHooks(t *testing.T) {
+	r := newTestHookRunner(t, testConfig)
+
+	require.NoError(t, r.Run())
+
+	require.Equal(t, "post-create\npost-start\n", readFile(t, filepath.Join(r.WorkspaceDir, "hooks.log")))
+	// The postAttachCommand is not run on start
+	require.NoFileExists(t, filepath.Join(r.WorkspaceDir, "attach-1.log"))
+
+	statuses := r.Status()
+	require.Len(t, statuses, 2)
+	for i, name := range []string{PostCreateCommand, PostStartCommand} {
+		require.Equal(t, name, statuses[i].Name)
+		require.Equal(t, models.LifecycleHookStateSucceeded, statuses[i].State)
+		require.NotNil(t, statuses[i].FinishedAt)
+	}
+
+	require.Contains(t, r.LogWriter.(*bytes.Buffer).String(), "Running postStartCommand...")
+}
+
+func TestRunLifecycleHooksOnRestart(t *testing.T) {
+	r := newTestHookRunner(t, testConfig)
+
+	require.NoError(t, r.Run())
+
+	restarted := &HookRunner{
+		WorkspaceDir: r.WorkspaceDir,
+		BuildConfig:  r.BuildConfig,
+		StateDir:     r.StateDir,
+	}
+	require.NoError(t, restarted.Run())
+
+	// The postCreateCommand only runs once per container
+	require.Equal(t, "post-create\npost-start\npost-start\n", readFile(t, filepath.Join(r.WorkspaceDir, "hooks.log")))
+
+	statuses := restarted.Status()
+	require.Len(t, statuses, 1)
+	require.Equal(t, PostStartCommand, statuses[0].Name)
+}
+
+func TestRunLifecycleHooksWaitFor(t *testing.T) {
+	r := newTestHookRunner(t, `{
+		"postCreateCommand": "echo post-create >> hooks.log",
+		"postStartCommand": "echo post-start >> hooks.log",
+		"waitFor": "postCreateCommand"
+	}`)
+
+	require.NoError(t, r.Run())
+
+	// Commands up to waitFor are run by the devcontainer CLI
+	require.Equal(t, "post-start\n", readFile(t, filepath.Join(r.WorkspaceDir, "hooks.log")))
+	require.Len(t, r.Status(), 1)
+}
+
+func TestRunLifecycleHooksFailure(t *testing.T) {
+	r := newTestHookRunner(t, `{
+		"postCreateCommand": "exit 3",
+		"postStartCommand": "echo post-start >> hooks.log"
+	}`)
+
+	require.Error(t, r.Run())
+
+	statuses := r.Status()
+	require.Len(t, statuses, 1)
+	require.Equal(t, models.LifecycleHookStateFailed, statuses[0].State)
+	require.NotNil(t, statuses[0].Error)
+	require.NoFileExists(t, filepath.Join(r.WorkspaceDir, "hooks.log"))
+}
+
+func TestRunLifecycleHooksWithoutCommands(t *testing.T) {
+	r := newTestHookRunner(t, `{"image": "ubuntu"}`)
+
+	require.NoError(t, r.Run())
+	require.Nil(t, r.Status())
+}
+
+func TestRunLifecycleHooksWithoutDevcontainer(t *testing.T) {
+	r := newTestHookRunner(t, testConfig)
+	r.BuildConfig = &models.BuildConfig{
+		Dockerfile: &models.DockerfileConfig{FilePath: "Dockerfile"},
+	}
+
+	require.NoError(t, r.Run())
+	require.NoFileExists(t, filepath.Join(r.WorkspaceDir, "hooks.log"))
+	require.Nil(t, r.Status())
+}
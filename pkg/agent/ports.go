@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	"github.com/daytonaio/daytona/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// getForwardPorts reads the ports the devcontainer config asks to forward so the CLI can forward them on connect
+func (a *Agent) getForwardPorts() []models.WorkspacePort {
+	if a.Workspace == nil {
+		return nil
+	}
+
+	configFilePath, ok := detect.GetDevcontainerConfigFilePath(a.Workspace.BuildConfig, a.Config.WorkspaceDir)
+	if !ok {
+		return nil
+	}
+
+	config, err := devcontainer.ReadConfiguration(filepath.Join(a.Config.WorkspaceDir, configFilePath))
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to read forward ports: %s", err))
+		return nil
+	}
+
+	return getWorkspacePorts(config)
+}
+
+func getWorkspacePorts(config *devcontainer.Configuration) []models.WorkspacePort {
+	var workspacePorts []models.WorkspacePort
+	seen := map[int]bool{}
+
+	for _, forwardPort := range config.ForwardPorts {
+		// Ports of other compose services are not reachable through the workspace
+		if !forwardPort.IsLocal() || forwardPort.Port <= 0 || forwardPort.Port > 65535 || seen[forwardPort.Port] {
+			continue
+		}
+		seen[forwardPort.Port] = true
+
+		workspacePort := models.WorkspacePort{
+			Port: uint16(forwardPort.Port),
+		}
+
+		attributes := config.GetPortAttributes(forwardPort.Port)
+		if attributes != nil {
+			workspacePort.Label = attributes.Label
+			workspacePort.OnAutoForward = attributes.OnAutoForward
+			if attributes.RequireLocalPort != nil {
+				workspacePort.RequireLocalPort = *attributes.RequireLocalPort
+			}
+		}
+
+		workspacePorts = append(workspacePorts, workspacePort)
+	}
+
+	return workspacePorts
+}
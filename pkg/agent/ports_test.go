@@ -0,0 +1,95 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetForwardPorts(t *testing.T) {
+	workspaceDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(workspaceDir, ".devcontainer.json"), []byte(`{
+		"forwardPorts": [3000, "localhost:8080", "db:5432", 3000, 9229],
+		"portsAttributes": {
+			"3000": {
+				"label": "Frontend",
+				"onAutoForward": "notify",
+				"requireLocalPort": true,
+			},
+			"9000-9999": {
+				"onAutoForward": "ignore"
+			}
+		}
+	}`), 0644)
+	require.NoError(t, err)
+
+	a := &Agent{
+		Config: &agent_config.Config{
+			WorkspaceDir: workspaceDir,
+		},
+		Workspace: &models.Workspace{
+			BuildConfig: &models.BuildConfig{
+				Devcontainer: &models.DevcontainerConfig{
+					FilePath: ".devcontainer.json",
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []models.WorkspacePort{
+		{
+			Port:             3000,
+			Label:            util.Pointer("Frontend"),
+			OnAutoForward:    util.Pointer(models.PortOnAutoForwardNotify),
+			RequireLocalPort: true,
+		},
+		{
+			Port: 8080,
+		},
+		{
+			Port:          9229,
+			OnAutoForward: util.Pointer(models.PortOnAutoForwardIgnore),
+		},
+	}, a.getForwardPorts())
+}
+
+func TestGetForwardPortsWithAutomaticBuildConfig(t *testing.T) {
+	workspaceDir := t.TempDir()
+
+	err := os.MkdirAll(filepath.Join(workspaceDir, ".devcontainer"), 0755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(workspaceDir, ".devcontainer", "devcontainer.json"), []byte(`{"forwardPorts": [3000]}`), 0644)
+	require.NoError(t, err)
+
+	a := &Agent{
+		Config: &agent_config.Config{
+			WorkspaceDir: workspaceDir,
+		},
+		Workspace: &models.Workspace{
+			BuildConfig: &models.BuildConfig{},
+		},
+	}
+
+	require.Equal(t, []models.WorkspacePort{{Port: 3000}}, a.getForwardPorts())
+}
+
+func TestGetForwardPortsWithoutDevcontainer(t *testing.T) {
+	a := &Agent{
+		Config:    &agent_config.Config{},
+		Workspace: &models.Workspace{},
+	}
+
+	require.Nil(t, a.getForwardPorts())
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+const MAX_AUDIT_BODY_SIZE = 64 * 1024
+
+var auditedPathPrefixes = []string{"/files", "/process", "/git"}
+
+// AuditMiddleware writes an entry for every fs, process and git operation to the audit log writers.
+// Failing writers are logged and do not affect the request.
+func AuditMiddleware(writers ...io.Writer) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !isAuditedPath(ctx.Request.URL.Path) {
+			ctx.Next()
+			return
+		}
+
+		startTime := time.Now()
+		path, command := extractAuditDetails(ctx)
+
+		ctx.Next()
+
+		caller := ctx.GetString(authenticatedCallerKey)
+		if caller == "" {
+			caller = "unauthenticated"
+		}
+
+		operation := ctx.FullPath()
+		if operation == "" {
+			operation = ctx.Request.URL.Path
+		}
+
+		entry := logs.ToolboxAuditEntry{
+			Time:           startTime.Format(time.RFC3339),
+			Caller:         caller,
+			ReportedCaller: ctx.GetHeader(config.TOOLBOX_CALLER_HEADER),
+			RemoteAddr:     ctx.ClientIP(),
+			Method:         ctx.Request.Method,
+			Operation:      operation,
+			Path:           path,
+			Command:        command,
+			Status:         ctx.Writer.Status(),
+			DurationMs:     time.Since(startTime).Milliseconds(),
+		}
+		if len(ctx.Errors) > 0 {
+			entry.Error = strings.Join(ctx.Errors.Errors(), "; ")
+		}
+
+		b, err := json.Marshal(entry)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+		b = append(b, []byte(logs.LogDelimiter)...)
+
+		for _, w := range writers {
+			_, err := w.Write(b)
+			if err != nil {
+				log.Debugf("failed to write audit log entry: %s", err)
+			}
+		}
+	}
+}
+
+func isAuditedPath(path string) bool {
+	for _, prefix := range auditedPathPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// extractAuditDetails reads the affected path and the executed command from the query or a JSON body.
+// The body is restored so handlers can bind it afterwards.
+func extractAuditDetails(ctx *gin.Context) (string, string) {
+	path := ctx.Query("path")
+	if path == "" {
+		path = ctx.Query("source")
+	}
+
+	if ctx.ContentType() != gin.MIMEJSON || ctx.Request.Body == nil {
+		return path, ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, MAX_AUDIT_BODY_SIZE))
+	if err != nil {
+		return path, ""
+	}
+	ctx.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), ctx.Request.Body))
+
+	var details struct {
+		Path    string `json:"path"`
+		Command string `json:"command"`
+	}
+	// Bodies that are not JSON objects or exceed the size limit are not audited in detail
+	_ = json.Unmarshal(body, &details)
+
+	if path == "" {
+		path = details.Path
+	}
+
+	return path, details.Command
+}
@@ -0,0 +1,111 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(auditLog *bytes.Buffer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AuditMiddleware(auditLog))
+	r.Use(AuthMiddleware("secret"))
+
+	r.POST("/process/execute", func(ctx *gin.Context) {
+		var req struct {
+			Command string `json:"command"`
+		}
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.AbortWithError(400, err)
+			return
+		}
+		ctx.String(200, req.Command)
+	})
+	r.GET("/workspace-dir", func(ctx *gin.Context) {
+		ctx.Status(200)
+	})
+
+	return r
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	r := newTestRouter(&bytes.Buffer{})
+
+	req := httptest.NewRequest(http.MethodGet, "/workspace-dir", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, 401, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/workspace-dir", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, 401, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/workspace-dir", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, 200, rec.Code)
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	auditLog := &bytes.Buffer{}
+	r := newTestRouter(auditLog)
+
+	req := httptest.NewRequest(http.MethodPost, "/process/execute?path=/workspace", strings.NewReader(`{"command":"ls -la"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set(config.TOOLBOX_CALLER_HEADER, "default")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, 200, rec.Code)
+	require.Equal(t, "ls -la", rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/process/execute", strings.NewReader(`{"command":"rm -rf /"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(config.TOOLBOX_CALLER_HEADER, "forged")
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, 401, rec.Code)
+
+	// Requests outside of the fs, process and git groups are not audited
+	req = httptest.NewRequest(http.MethodGet, "/workspace-dir", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := strings.Split(strings.TrimSuffix(auditLog.String(), logs.LogDelimiter), logs.LogDelimiter)
+	require.Len(t, entries, 2)
+
+	var entry logs.ToolboxAuditEntry
+	require.NoError(t, json.Unmarshal([]byte(entries[0]), &entry))
+	require.Equal(t, "workspace-api-key", entry.Caller)
+	require.Equal(t, "default", entry.ReportedCaller)
+	require.Equal(t, http.MethodPost, entry.Method)
+	require.Equal(t, "/process/execute", entry.Operation)
+	require.Equal(t, "/workspace", entry.Path)
+	require.Equal(t, "ls -la", entry.Command)
+	require.Equal(t, 200, entry.Status)
+
+	entry = logs.ToolboxAuditEntry{}
+	require.NoError(t, json.Unmarshal([]byte(entries[1]), &entry))
+	require.Equal(t, "unauthenticated", entry.Caller)
+	require.Equal(t, "forged", entry.ReportedCaller)
+	require.Equal(t, "rm -rf /", entry.Command)
+	require.Equal(t, 401, entry.Status)
+	require.Equal(t, "unauthorized", entry.Error)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/api/util"
+	"github.com/gin-gonic/gin"
+)
+
+// authenticatedCallerKey holds the credential a request was authenticated with
+const authenticatedCallerKey = "toolboxAuthenticatedCaller"
+
+// AuthMiddleware requires requests to carry the workspace API key as a bearer token.
+// The server adds the token when proxying requests, direct callers have to provide it themselves.
+func AuthMiddleware(apiKey string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token := util.ExtractToken(ctx)
+		if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(apiKey)) != 1 {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		ctx.Set(authenticatedCallerKey, "workspace-api-key")
+		ctx.Next()
+	}
+}
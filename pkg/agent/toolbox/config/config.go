@@ -4,3 +4,7 @@
 package config
 
 const TOOLBOX_API_PORT = 2280
+
+// TOOLBOX_CALLER_HEADER is set by the server on proxied requests to report the caller in the audit log.
+// Any client can set it, so it is recorded separately from the authenticated caller.
+const TOOLBOX_CALLER_HEADER = "X-Daytona-Toolbox-Caller"
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/archive"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func DownloadArchive(c *gin.Context) {
+	requestedPath := c.Query("path")
+	if requestedPath == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	absPath, err := filepath.Abs(requestedPath)
+	if err != nil {
+		c.AbortWithError(400, errors.New("invalid path"))
+		return
+	}
+
+	_, err = os.Stat(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.AbortWithError(404, errors.New("path not found"))
+			return
+		}
+		c.AbortWithError(400, errors.New("unable to access path"))
+		return
+	}
+
+	format, err := archive.ParseFormat(c.Query("format"))
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	compression, err := archive.ParseCompression(c.DefaultQuery("compression", string(archive.CompressionGzip)))
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Header("Content-Description", "File Transfer")
+	c.Header("Content-Type", "application/octet-stream")
+	c.Header("Content-Disposition", "attachment; filename="+filepath.Base(absPath)+archive.Extension(format, compression))
+	c.Header("Content-Transfer-Encoding", "binary")
+	c.Header("Expires", "0")
+	c.Header("Cache-Control", "must-revalidate")
+	c.Header("Pragma", "public")
+	c.Status(200)
+
+	// The archive is streamed so errors can only be logged once the response has started
+	err = archive.Create(c.Writer, absPath, archive.CreateOptions{
+		Format:      format,
+		Compression: compression,
+		Exclude:     parsePatterns(c.QueryArray("exclude")),
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
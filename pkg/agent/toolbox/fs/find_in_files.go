@@ -4,15 +4,19 @@
 package fs
 
 import (
-	"bufio"
+	"context"
 	"errors"
-	"os"
-	"path/filepath"
-	"strings"
+	"io"
+	"sort"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
 )
 
+// FindInFiles searches the contents of the files under path. Files are searched concurrently and the search
+// stops once maxResults matches were found, so any maxResults matches are returned rather than the first ones
+// in path order. The returned matches are sorted by file and line.
 func FindInFiles(c *gin.Context) {
 	path := c.Query("path")
 	pattern := c.Query("pattern")
@@ -21,59 +25,107 @@ func FindInFiles(c *gin.Context) {
 		return
 	}
 
-	var matches []Match = make([]Match, 0)
-	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return filepath.SkipDir
-		}
+	opts := searchOptions{
+		Pattern:    pattern,
+		Regex:      c.Query("regex") == "true",
+		IgnoreCase: c.Query("ignoreCase") == "true",
+	}
 
-		if !info.Mode().IsRegular() {
-			return nil
+	if contextQuery := c.Query("context"); contextQuery != "" {
+		contextLines, err := strconv.Atoi(contextQuery)
+		if err != nil || contextLines < 0 {
+			c.AbortWithError(400, errors.New("invalid context value"))
+			return
 		}
+		opts.ContextLines = contextLines
+	}
 
-		file, err := os.Open(filePath)
-		if err != nil {
-			return nil
-		}
-		defer file.Close()
+	maxResults, err := parseMaxResults(c)
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	// Validate the pattern before starting the search so invalid expressions return 400
+	_, err = newLineMatcher(opts)
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	results := make(chan []Match)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- searchInFiles(ctx, path, parseWalkOptions(c), opts, results)
+	}()
 
-		buf := make([]byte, 512)
-		n, err := file.Read(buf)
-		if err != nil {
-			return nil
+	if c.Query("stream") == "true" {
+		streamMatches(c, cancel, results, errChan, maxResults)
+		return
+	}
+
+	var matches []Match = make([]Match, 0)
+	for fileMatches := range results {
+		matches = append(matches, fileMatches...)
+		// Stop searching once enough matches were found, the remaining results are drained
+		if maxResults > 0 && len(matches) >= maxResults {
+			cancel()
 		}
+	}
 
-		for i := 0; i < n; i++ {
-			// skip binary files
-			if buf[i] == 0 {
-				return nil
-			}
+	err = <-errChan
+	if err != nil {
+		log.Error(err)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].File != matches[j].File {
+			return matches[i].File < matches[j].File
 		}
+		return matches[i].Line < matches[j].Line
+	})
+
+	if maxResults > 0 && len(matches) > maxResults {
+		matches = matches[:maxResults]
+	}
 
-		_, err = file.Seek(0, 0)
-		if err != nil {
-			return nil
+	c.JSON(200, matches)
+}
+
+// streamMatches sends every match as a server-sent event as soon as it is found
+func streamMatches(c *gin.Context, cancel context.CancelFunc, results <-chan []Match, errChan <-chan error, maxResults int) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	count := 0
+	c.Stream(func(_ io.Writer) bool {
+		fileMatches, ok := <-results
+		if !ok {
+			return false
 		}
 
-		scanner := bufio.NewScanner(file)
-		lineNum := 1
-		for scanner.Scan() {
-			if strings.Contains(scanner.Text(), pattern) {
-				matches = append(matches, Match{
-					File:    filePath,
-					Line:    lineNum,
-					Content: scanner.Text(),
-				})
+		for _, match := range fileMatches {
+			c.SSEvent("match", match)
+			count++
+			if maxResults > 0 && count >= maxResults {
+				cancel()
+				return false
 			}
-			lineNum++
 		}
-		return nil
+		return true
 	})
 
-	if err != nil {
-		c.JSON(200, matches)
-		return
+	// Drain the remaining results so the search can exit
+	cancel()
+	for range results {
 	}
 
-	c.JSON(200, matches)
+	err := <-errChan
+	if err != nil {
+		log.Error(err)
+	}
 }
@@ -0,0 +1,353 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/archive"
+	"github.com/gin-gonic/gin"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+const MAX_SEARCH_LINE_SIZE = 1024 * 1024
+
+type walkOptions struct {
+	// Include contains glob patterns a file must match to be visited. Directories are always visited.
+	Include []string
+	Exclude []string
+	// NoIgnore disables .gitignore and .git/info/exclude handling
+	NoIgnore bool
+}
+
+func parseWalkOptions(c *gin.Context) walkOptions {
+	return walkOptions{
+		Include:  parsePatterns(c.QueryArray("include")),
+		Exclude:  parsePatterns(c.QueryArray("exclude")),
+		NoIgnore: c.Query("noIgnore") == "true",
+	}
+}
+
+// parseMaxResults returns 0 when no limit is set
+func parseMaxResults(c *gin.Context) (int, error) {
+	value := c.Query("maxResults")
+	if value == "" {
+		return 0, nil
+	}
+
+	maxResults, err := strconv.Atoi(value)
+	if err != nil || maxResults < 0 {
+		return 0, errors.New("invalid maxResults value")
+	}
+
+	return maxResults, nil
+}
+
+// walkFiles walks the tree under root and calls fn for every entry that is not ignored or excluded.
+// Files not matching the include patterns are skipped. The walk stops when the context is cancelled.
+func walkFiles(ctx context.Context, root string, opts walkOptions, fn func(path string, entry os.DirEntry) error) error {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+
+	ignore := newIgnoreMatcher(absRoot, opts.NoIgnore)
+
+	return filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			// Skip entries that can not be read
+			if entry != nil && entry.IsDir() && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if path != root {
+			if entry.IsDir() && entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			relPath, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+
+			if archive.MatchesAny(opts.Exclude, relPath) || ignore.Match(filepath.Join(absRoot, relPath), entry.IsDir()) {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !entry.IsDir() && len(opts.Include) > 0 && !archive.MatchesAny(opts.Include, relPath) {
+				return nil
+			}
+		}
+
+		if entry.IsDir() {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return err
+			}
+			ignore.Load(absPath)
+		}
+
+		return fn(path, entry)
+	})
+}
+
+// ignoreMatcher evaluates .gitignore files found while walking the tree.
+// Patterns are scoped to the directory of the ignore file, relative to the enclosing git repository.
+type ignoreMatcher struct {
+	disabled bool
+	base     string
+	patterns []gitignore.Pattern
+}
+
+func newIgnoreMatcher(root string, disabled bool) *ignoreMatcher {
+	m := &ignoreMatcher{disabled: disabled, base: root}
+	if disabled {
+		return m
+	}
+
+	repoRoot := findRepositoryRoot(root)
+	if repoRoot == "" {
+		return m
+	}
+
+	m.base = repoRoot
+	m.patterns = append(m.patterns, readIgnoreFile(filepath.Join(repoRoot, ".git", "info", "exclude"), nil)...)
+
+	// Load the ignore files of the directories above the search root, the root itself is loaded by the walk.
+	// Deeper ignore files are loaded last so their patterns take precedence.
+	var parents []string
+	for dir := root; dir != repoRoot; {
+		dir = filepath.Dir(dir)
+		parents = append(parents, dir)
+	}
+	for i := len(parents) - 1; i >= 0; i-- {
+		m.Load(parents[i])
+	}
+
+	return m
+}
+
+func (m *ignoreMatcher) Load(dir string) {
+	if m.disabled {
+		return
+	}
+
+	m.patterns = append(m.patterns, readIgnoreFile(filepath.Join(dir, ".gitignore"), m.segments(dir))...)
+}
+
+func (m *ignoreMatcher) Match(path string, isDir bool) bool {
+	if m.disabled || len(m.patterns) == 0 {
+		return false
+	}
+
+	return gitignore.NewMatcher(m.patterns).Match(m.segments(path), isDir)
+}
+
+func (m *ignoreMatcher) segments(path string) []string {
+	relPath, err := filepath.Rel(m.base, path)
+	if err != nil || relPath == "." {
+		return []string{}
+	}
+
+	return strings.Split(filepath.ToSlash(relPath), "/")
+}
+
+func readIgnoreFile(path string, domain []string) []gitignore.Pattern {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+
+	return patterns
+}
+
+func findRepositoryRoot(path string) string {
+	for {
+		_, err := os.Stat(filepath.Join(path, ".git"))
+		if err == nil {
+			return path
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			return ""
+		}
+		path = parent
+	}
+}
+
+type searchOptions struct {
+	Pattern      string
+	Regex        bool
+	IgnoreCase   bool
+	ContextLines int
+}
+
+type lineMatcher func(line string) bool
+
+func newLineMatcher(opts searchOptions) (lineMatcher, error) {
+	if !opts.Regex && !opts.IgnoreCase {
+		return func(line string) bool {
+			return strings.Contains(line, opts.Pattern)
+		}, nil
+	}
+
+	pattern := opts.Pattern
+	if !opts.Regex {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if opts.IgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString, nil
+}
+
+// searchInFiles searches the files under root concurrently and sends the matches of each file to results.
+// Matches of a single file are sent together and in line order. results is closed when the search is done.
+func searchInFiles(ctx context.Context, root string, walkOpts walkOptions, opts searchOptions, results chan<- []Match) error {
+	defer close(results)
+
+	matchLine, err := newLineMatcher(opts)
+	if err != nil {
+		return err
+	}
+
+	paths := make(chan string)
+	workers := runtime.NumCPU()
+	done := make(chan struct{}, workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for path := range paths {
+				matches := searchFile(path, matchLine, opts.ContextLines)
+				if len(matches) == 0 {
+					continue
+				}
+				select {
+				case results <- matches:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	err = walkFiles(ctx, root, walkOpts, func(path string, entry os.DirEntry) error {
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		select {
+		case paths <- path:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	close(paths)
+
+	for i := 0; i < workers; i++ {
+		<-done
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func searchFile(path string, matchLine lineMatcher, contextLines int) []Match {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	// skip binary files
+	header, _ := reader.Peek(512)
+	for _, b := range header {
+		if b == 0 {
+			return nil
+		}
+	}
+
+	var matches []Match
+	var before []string
+	// index of the first match still collecting trailing context lines
+	pending := 0
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), MAX_SEARCH_LINE_SIZE)
+	lineNum := 1
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if contextLines > 0 {
+			for i := pending; i < len(matches); i++ {
+				matches[i].After = append(matches[i].After, line)
+			}
+			for pending < len(matches) && len(matches[pending].After) >= contextLines {
+				pending++
+			}
+		}
+
+		if matchLine(line) {
+			match := Match{
+				File:    path,
+				Line:    lineNum,
+				Content: line,
+			}
+			if len(before) > 0 {
+				match.Before = append([]string(nil), before...)
+			}
+			matches = append(matches, match)
+		}
+
+		if contextLines > 0 {
+			before = append(before, line)
+			if len(before) > contextLines {
+				before = before[1:]
+			}
+		}
+		lineNum++
+	}
+
+	return matches
+}
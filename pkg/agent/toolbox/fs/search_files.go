@@ -4,11 +4,16 @@
 package fs
 
 import (
+	"context"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
 )
 
 func SearchFiles(c *gin.Context) {
@@ -19,23 +24,110 @@ func SearchFiles(c *gin.Context) {
 		return
 	}
 
-	var matches []string
-	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+	_, err := filepath.Match(pattern, "")
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	maxResults, err := parseMaxResults(c)
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	files := make(chan string)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- searchFileNames(ctx, path, pattern, parseWalkOptions(c), files)
+	}()
+
+	if c.Query("stream") == "true" {
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+
+		count := 0
+		c.Stream(func(_ io.Writer) bool {
+			file, ok := <-files
+			if !ok {
+				return false
+			}
+
+			c.SSEvent("file", file)
+			count++
+			return maxResults == 0 || count < maxResults
+		})
+
+		cancel()
+		for range files {
+		}
+
+		err = <-errChan
 		if err != nil {
-			return filepath.SkipDir
+			log.Error(err)
 		}
-		if matched, _ := filepath.Match(pattern, info.Name()); matched {
-			matches = append(matches, path)
+		return
+	}
+
+	matches := make([]string, 0)
+	for file := range files {
+		matches = append(matches, file)
+		if maxResults > 0 && len(matches) >= maxResults {
+			cancel()
 		}
-		return nil
-	})
+	}
 
+	err = <-errChan
 	if err != nil {
-		c.JSON(400, err)
+		c.AbortWithError(400, err)
 		return
 	}
 
+	sort.Strings(matches)
+	if maxResults > 0 && len(matches) > maxResults {
+		matches = matches[:maxResults]
+	}
+
 	c.JSON(200, SearchFilesResponse{
 		Files: matches,
 	})
 }
+
+// searchFileNames sends the paths under root whose name matches the glob pattern.
+// Patterns containing a path separator are matched against the path relative to root.
+func searchFileNames(ctx context.Context, root, pattern string, opts walkOptions, files chan<- string) error {
+	defer close(files)
+
+	matchPath := strings.Contains(pattern, "/")
+
+	err := walkFiles(ctx, root, opts, func(path string, entry os.DirEntry) error {
+		name := entry.Name()
+		if matchPath {
+			relPath, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			name = filepath.ToSlash(relPath)
+		}
+
+		if matched, _ := filepath.Match(pattern, name); !matched {
+			return nil
+		}
+
+		select {
+		case files <- path:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,155 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchTestSuite struct {
+	suite.Suite
+	root string
+}
+
+func TestSearch(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
+
+func (s *SearchTestSuite) SetupTest() {
+	s.root = s.T().TempDir()
+
+	files := map[string]string{
+		".git/HEAD":           "ref: refs/heads/main",
+		".gitignore":          "dist/\n*.log\n",
+		"main.go":             "package main\n\nfunc main() {\n\tprintln(\"Hello\")\n}\n",
+		"README.md":           "# hello\n",
+		"dist/bundle.js":      "hello",
+		"debug.log":           "hello",
+		"web/.gitignore":      "!keep.log\n",
+		"web/keep.log":        "hello",
+		"web/index.ts":        "const greeting = 'hello'\n",
+		"assets/image.binary": "hello\x00world",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(s.root, name)
+		require.NoError(s.T(), os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(s.T(), os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func (s *SearchTestSuite) TestLiteralSearchIsCaseSensitive() {
+	matches := s.search(walkOptions{}, searchOptions{Pattern: "hello"})
+
+	s.Equal([]string{"README.md:1", "web/index.ts:1", "web/keep.log:1"}, matches)
+}
+
+func (s *SearchTestSuite) TestIgnoreCase() {
+	matches := s.search(walkOptions{Include: []string{"*.go"}}, searchOptions{Pattern: "hello", IgnoreCase: true})
+
+	s.Equal([]string{"main.go:4"}, matches)
+}
+
+func (s *SearchTestSuite) TestRegex() {
+	matches := s.search(walkOptions{}, searchOptions{Pattern: `^func \w+\(`, Regex: true})
+
+	s.Equal([]string{"main.go:3"}, matches)
+}
+
+func (s *SearchTestSuite) TestNoIgnore() {
+	matches := s.search(walkOptions{NoIgnore: true, Exclude: []string{"web"}}, searchOptions{Pattern: "hello"})
+
+	s.Equal([]string{"README.md:1", "debug.log:1", "dist/bundle.js:1"}, matches)
+}
+
+func (s *SearchTestSuite) TestContextLines() {
+	results := make(chan []Match)
+	go func() {
+		require.NoError(s.T(), searchInFiles(context.Background(), s.root, walkOptions{Include: []string{"*.go"}}, searchOptions{Pattern: "println", ContextLines: 2}, results))
+	}()
+
+	var matches []Match
+	for fileMatches := range results {
+		matches = append(matches, fileMatches...)
+	}
+
+	require.Len(s.T(), matches, 1)
+	s.Equal([]string{"", "func main() {"}, matches[0].Before)
+	s.Equal([]string{"}"}, matches[0].After)
+}
+
+func (s *SearchTestSuite) TestSearchFileNames() {
+	files := make(chan string)
+	go func() {
+		require.NoError(s.T(), searchFileNames(context.Background(), s.root, "*.log", walkOptions{}, files))
+	}()
+
+	var matches []string
+	for file := range files {
+		relPath, err := filepath.Rel(s.root, file)
+		require.NoError(s.T(), err)
+		matches = append(matches, filepath.ToSlash(relPath))
+	}
+
+	s.Equal([]string{"web/keep.log"}, matches)
+}
+
+func (s *SearchTestSuite) TestFindInFilesMaxResults() {
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest("GET", "/files/find?"+url.Values{
+		"path":       {s.root},
+		"pattern":    {"hello"},
+		"maxResults": {"2"},
+	}.Encode(), nil)
+
+	FindInFiles(c)
+
+	require.Equal(s.T(), 200, recorder.Code)
+
+	var matches []Match
+	require.NoError(s.T(), json.Unmarshal(recorder.Body.Bytes(), &matches))
+
+	// Files are searched concurrently, so any 2 of the matches are returned
+	require.Len(s.T(), matches, 2)
+	allMatches := s.search(walkOptions{}, searchOptions{Pattern: "hello"})
+	for _, match := range matches {
+		relPath, err := filepath.Rel(s.root, match.File)
+		require.NoError(s.T(), err)
+		s.Contains(allMatches, filepath.ToSlash(relPath)+":"+strconv.Itoa(match.Line))
+	}
+}
+
+func (s *SearchTestSuite) search(walkOpts walkOptions, opts searchOptions) []string {
+	results := make(chan []Match)
+	go func() {
+		require.NoError(s.T(), searchInFiles(context.Background(), s.root, walkOpts, opts, results))
+	}()
+
+	matches := []string{}
+	for fileMatches := range results {
+		for _, match := range fileMatches {
+			relPath, err := filepath.Rel(s.root, match.File)
+			require.NoError(s.T(), err)
+			matches = append(matches, filepath.ToSlash(relPath)+":"+strconv.Itoa(match.Line))
+		}
+	}
+
+	sort.Strings(matches)
+	return matches
+}
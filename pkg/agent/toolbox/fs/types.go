@@ -30,8 +30,19 @@ type Match struct {
 	File    string `json:"file" validate:"required"`
 	Line    int    `json:"line" validate:"required"`
 	Content string `json:"content" validate:"required"`
+	// Lines preceding the match when context lines are requested
+	Before []string `json:"before,omitempty" validate:"optional"`
+	// Lines following the match when context lines are requested
+	After []string `json:"after,omitempty" validate:"optional"`
 } // @name Match
 
 type SearchFilesResponse struct {
 	Files []string `json:"files" validate:"required"`
 } // @name SearchFilesResponse
+
+type FileWatchEvent struct {
+	Type  FileWatchEventType `json:"type" validate:"required"`
+	Path  string             `json:"path" validate:"required"`
+	IsDir bool               `json:"isDir" validate:"required"`
+	Time  string             `json:"time" validate:"required"`
+} // @name FileWatchEvent
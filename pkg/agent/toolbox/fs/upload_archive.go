@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/archive"
+	"github.com/gin-gonic/gin"
+)
+
+func UploadArchive(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+	defer src.Close()
+
+	if err := archive.Extract(src, path); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const DEFAULT_WATCH_DEBOUNCE = 100 * time.Millisecond
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+func WatchFiles(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.AbortWithError(404, errors.New("path not found"))
+			return
+		}
+		c.AbortWithError(400, err)
+		return
+	}
+
+	debounce := DEFAULT_WATCH_DEBOUNCE
+	if debounceQuery := c.Query("debounce"); debounceQuery != "" {
+		debounceMs, err := strconv.Atoi(debounceQuery)
+		if err != nil || debounceMs < 0 {
+			c.AbortWithError(400, errors.New("invalid debounce value"))
+			return
+		}
+		debounce = time.Duration(debounceMs) * time.Millisecond
+	}
+
+	w, err := newWatcher(watcherConfig{
+		Root:      path,
+		Recursive: info.IsDir() && c.DefaultQuery("recursive", "true") == "true",
+		Include:   parsePatterns(c.QueryArray("include")),
+		Exclude:   parsePatterns(c.QueryArray("exclude")),
+		Debounce:  debounce,
+	})
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	events := make(chan FileWatchEvent)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- w.Run(ctx, events)
+	}()
+
+	if c.Request.Header.Get("Upgrade") == "websocket" {
+		streamWatchEventsToWs(c, ctx, cancel, events, errChan)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	c.Stream(func(_ io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case err := <-errChan:
+			if err != nil {
+				log.Error(err)
+			}
+			return false
+		case event := <-events:
+			c.SSEvent(string(event.Type), event)
+			return true
+		}
+	})
+}
+
+func streamWatchEventsToWs(c *gin.Context, ctx context.Context, cancel context.CancelFunc, events chan FileWatchEvent, errChan chan error) {
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	go func() {
+		for {
+			_, _, err := ws.ReadMessage()
+			if err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-errChan:
+			if err != nil {
+				log.Error(err)
+				_ = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error()), time.Now().Add(time.Second))
+			}
+			return
+		case event := <-events:
+			err := ws.WriteJSON(event)
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// parsePatterns accepts both repeated query parameters and comma separated values
+func parsePatterns(values []string) []string {
+	var patterns []string
+	for _, value := range values {
+		for _, pattern := range strings.Split(value, ",") {
+			pattern = strings.TrimSpace(pattern)
+			if pattern != "" {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+	return patterns
+}
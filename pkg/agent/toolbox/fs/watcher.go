@@ -0,0 +1,231 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/archive"
+	"github.com/fsnotify/fsnotify"
+)
+
+type FileWatchEventType string
+
+const (
+	FileWatchEventCreate FileWatchEventType = "create"
+	FileWatchEventModify FileWatchEventType = "modify"
+	FileWatchEventDelete FileWatchEventType = "delete"
+	FileWatchEventRename FileWatchEventType = "rename"
+)
+
+// Pending events are flushed after this many debounce intervals even if events keep arriving
+const watchMaxWaitFactor = 10
+
+type watcherConfig struct {
+	Root      string
+	Recursive bool
+	Include   []string
+	Exclude   []string
+	Debounce  time.Duration
+	// Upper bound for delaying pending events, defaults to watchMaxWaitFactor times the debounce
+	MaxWait time.Duration
+}
+
+// watcher wraps fsnotify (inotify on Linux) to provide recursive watching of a directory tree
+// with glob filtering and per-path debouncing of the emitted events
+type watcher struct {
+	config    watcherConfig
+	fsWatcher *fsnotify.Watcher
+	pending   map[string]*FileWatchEvent
+	order     []string
+}
+
+func newWatcher(config watcherConfig) (*watcher, error) {
+	root, err := filepath.Abs(config.Root)
+	if err != nil {
+		return nil, err
+	}
+	config.Root = root
+
+	if config.MaxWait == 0 {
+		config.MaxWait = config.Debounce * watchMaxWaitFactor
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &watcher{
+		config:    config,
+		fsWatcher: fsWatcher,
+		pending:   make(map[string]*FileWatchEvent),
+	}
+
+	err = w.addPath(root)
+	if err != nil {
+		fsWatcher.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Run blocks until the context is done or the underlying watcher fails.
+// Debounced events are sent to the events channel. Continuous changes delay the events
+// by at most the max wait of the config.
+func (w *watcher) Run(ctx context.Context, events chan<- FileWatchEvent) error {
+	defer w.fsWatcher.Close()
+
+	timer := time.NewTimer(w.config.Debounce)
+	timer.Stop()
+
+	// Time of the first event that has not been flushed yet
+	var firstPendingAt time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return nil
+			}
+			if w.handleEvent(event) {
+				if firstPendingAt.IsZero() {
+					firstPendingAt = time.Now()
+				}
+				timer.Reset(min(w.config.Debounce, max(0, w.config.MaxWait-time.Since(firstPendingAt))))
+			}
+		case err, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+		case <-timer.C:
+			for _, path := range w.order {
+				select {
+				case events <- *w.pending[path]:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+			w.pending = make(map[string]*FileWatchEvent)
+			w.order = nil
+			firstPendingAt = time.Time{}
+		}
+	}
+}
+
+// handleEvent records the event as pending and returns true if it passed the filters
+func (w *watcher) handleEvent(event fsnotify.Event) bool {
+	relPath, err := filepath.Rel(w.config.Root, event.Name)
+	if err != nil {
+		return false
+	}
+
+	if archive.MatchesAny(w.config.Exclude, relPath) {
+		return false
+	}
+
+	isDir := false
+	if event.Has(fsnotify.Create) {
+		info, err := os.Stat(event.Name)
+		if err == nil && info.IsDir() {
+			isDir = true
+			if w.config.Recursive {
+				// Errors are ignored because the directory might have been removed in the meantime
+				_ = w.addPath(event.Name)
+			}
+		}
+	}
+
+	if len(w.config.Include) > 0 && !isDir && !archive.MatchesAny(w.config.Include, relPath) {
+		return false
+	}
+
+	var eventType FileWatchEventType
+	switch {
+	case event.Has(fsnotify.Create):
+		eventType = FileWatchEventCreate
+	case event.Has(fsnotify.Write):
+		eventType = FileWatchEventModify
+	case event.Has(fsnotify.Remove):
+		eventType = FileWatchEventDelete
+	case event.Has(fsnotify.Rename):
+		eventType = FileWatchEventRename
+	default:
+		// Chmod events are not reported
+		return false
+	}
+
+	existing, ok := w.pending[event.Name]
+	if !ok {
+		w.pending[event.Name] = &FileWatchEvent{
+			Type:  eventType,
+			Path:  event.Name,
+			IsDir: isDir,
+			Time:  time.Now().Format(time.RFC3339Nano),
+		}
+		w.order = append(w.order, event.Name)
+		return true
+	}
+
+	existing.Time = time.Now().Format(time.RFC3339Nano)
+	existing.IsDir = existing.IsDir || isDir
+
+	if existing.Type == FileWatchEventCreate {
+		switch eventType {
+		case FileWatchEventModify:
+			// A file that was created within the debounce window is still reported as created
+			return true
+		case FileWatchEventDelete, FileWatchEventRename:
+			// The client never saw the file so neither event is reported
+			w.removePending(event.Name)
+			return true
+		}
+	}
+
+	existing.Type = eventType
+	return true
+}
+
+func (w *watcher) removePending(path string) {
+	delete(w.pending, path)
+	for i, p := range w.order {
+		if p == path {
+			w.order = append(w.order[:i], w.order[i+1:]...)
+			return
+		}
+	}
+}
+
+func (w *watcher) addPath(path string) error {
+	if !w.config.Recursive {
+		return w.fsWatcher.Add(path)
+	}
+
+	return filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			if p == path {
+				return err
+			}
+			return nil
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(w.config.Root, p)
+		if err == nil && relPath != "." && archive.MatchesAny(w.config.Exclude, relPath) {
+			return filepath.SkipDir
+		}
+
+		return w.fsWatcher.Add(p)
+	})
+}
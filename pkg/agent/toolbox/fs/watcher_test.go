@@ -0,0 +1,222 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type watchedEvent struct {
+	Type  FileWatchEventType
+	Path  string
+	IsDir bool
+}
+
+func TestWatcher(t *testing.T) {
+	tests := []struct {
+		name      string
+		recursive bool
+		include   []string
+		exclude   []string
+		actions   func(t *testing.T, root string)
+		expected  []watchedEvent
+	}{
+		{
+			name:      "repeated writes are debounced into a single event",
+			recursive: true,
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "main.go", "package main")
+				writeFile(t, root, "main.go", "package main\n")
+				writeFile(t, root, "main.go", "package main\n\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventModify, Path: "main.go"}},
+		},
+		{
+			name:      "a file created and modified is reported as created",
+			recursive: true,
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "new.go", "package main")
+				writeFile(t, root, "new.go", "package main\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventCreate, Path: "new.go"}},
+		},
+		{
+			name:      "a file created and deleted is not reported",
+			recursive: true,
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "tmp.go", "package main")
+				require.NoError(t, os.Remove(filepath.Join(root, "tmp.go")))
+				writeFile(t, root, "main.go", "package main\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventModify, Path: "main.go"}},
+		},
+		{
+			name:      "excluded directories are not reported",
+			recursive: true,
+			exclude:   []string{"node_modules"},
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "node_modules/lib/index.js", "module.exports = {}\n")
+				writeFile(t, root, "node_modules/new.js", "module.exports = {}\n")
+				writeFile(t, root, "main.go", "package main\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventModify, Path: "main.go"}},
+		},
+		{
+			name:      "only included files are reported",
+			recursive: true,
+			include:   []string{"*.go"},
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "README.md", "# readme\n")
+				writeFile(t, root, "main.go", "package main\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventModify, Path: "main.go"}},
+		},
+		{
+			name:      "new directories are watched recursively",
+			recursive: true,
+			actions: func(t *testing.T, root string) {
+				require.NoError(t, os.Mkdir(filepath.Join(root, "pkg"), 0755))
+				// Give the watcher time to add the new directory before writing into it
+				time.Sleep(50 * time.Millisecond)
+				writeFile(t, root, "pkg/util.go", "package pkg\n")
+			},
+			expected: []watchedEvent{
+				{Type: FileWatchEventCreate, Path: "pkg", IsDir: true},
+				{Type: FileWatchEventCreate, Path: "pkg/util.go"},
+			},
+		},
+		{
+			name:      "nested directories are not watched without recursion",
+			recursive: false,
+			actions: func(t *testing.T, root string) {
+				writeFile(t, root, "node_modules/lib/index.js", "module.exports = {}\n")
+				writeFile(t, root, "main.go", "package main\n")
+			},
+			expected: []watchedEvent{{Type: FileWatchEventModify, Path: "main.go"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFile(t, root, "main.go", "package main")
+			writeFile(t, root, "node_modules/lib/index.js", "module.exports = {}")
+
+			w, err := newWatcher(watcherConfig{
+				Root:      root,
+				Recursive: tt.recursive,
+				Include:   tt.include,
+				Exclude:   tt.exclude,
+				Debounce:  100 * time.Millisecond,
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events := make(chan FileWatchEvent)
+			go func() {
+				_ = w.Run(ctx, events)
+			}()
+
+			tt.actions(t, root)
+
+			require.Equal(t, tt.expected, collectEvents(t, root, events))
+		})
+	}
+}
+
+func TestWatcherFlushesContinuousWritesAfterMaxWait(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "main.go", "package main")
+
+	w, err := newWatcher(watcherConfig{
+		Root:     root,
+		Debounce: 100 * time.Millisecond,
+		MaxWait:  300 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan FileWatchEvent)
+	go func() {
+		_ = w.Run(ctx, events)
+	}()
+
+	// Writes arrive faster than the debounce for longer than the max wait
+	writerDone := make(chan struct{})
+	defer func() {
+		cancel()
+		<-writerDone
+	}()
+	go func() {
+		defer close(writerDone)
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = os.WriteFile(filepath.Join(root, "main.go"), []byte(time.Now().String()), 0644)
+			}
+		}
+	}()
+
+	select {
+	case event := <-events:
+		require.Equal(t, FileWatchEventModify, event.Type)
+		require.Equal(t, filepath.Join(root, "main.go"), event.Path)
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "pending events were not flushed while writes continued")
+	}
+}
+
+func TestWatcherSkipsExcludedDirectories(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "src/main.go", "package main")
+	writeFile(t, root, "node_modules/lib/index.js", "module.exports = {}")
+
+	w, err := newWatcher(watcherConfig{
+		Root:      root,
+		Recursive: true,
+		Exclude:   []string{"node_modules"},
+		Debounce:  DEFAULT_WATCH_DEBOUNCE,
+	})
+	require.NoError(t, err)
+	defer w.fsWatcher.Close()
+
+	require.ElementsMatch(t, []string{root, filepath.Join(root, "src")}, w.fsWatcher.WatchList())
+}
+
+// collectEvents returns the events received until no new events arrive for a while
+func collectEvents(t *testing.T, root string, events <-chan FileWatchEvent) []watchedEvent {
+	result := []watchedEvent{}
+	for {
+		select {
+		case event := <-events:
+			relPath, err := filepath.Rel(root, event.Path)
+			require.NoError(t, err)
+			result = append(result, watchedEvent{Type: event.Type, Path: filepath.ToSlash(relPath), IsDir: event.IsDir})
+		case <-time.After(500 * time.Millisecond):
+			return result
+		}
+	}
+}
+
+func writeFile(t *testing.T, root, name, content string) {
+	path := filepath.Join(root, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func GetDiff(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	options := git.GitDiffOptions{
+		Staged:  c.Query("staged") == "true",
+		FromRef: c.Query("from"),
+		ToRef:   c.Query("to"),
+	}
+
+	if options.ToRef != "" && options.FromRef == "" {
+		c.AbortWithError(400, errors.New("from is required when to is set"))
+		return
+	}
+
+	for _, value := range c.QueryArray("files") {
+		for _, file := range strings.Split(value, ",") {
+			if file = strings.TrimSpace(file); file != "" {
+				options.Files = append(options.Files, file)
+			}
+		}
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: path,
+	}
+
+	diff, err := gitService.Diff(options)
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.JSON(200, diff)
+}
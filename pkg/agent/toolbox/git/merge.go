@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func Merge(c *gin.Context) {
+	var req GitMergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	result, err := gitService.Merge(req.Branch, req.NoFastForward)
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.JSON(200, result)
+}
+
+func MergeAbort(c *gin.Context) {
+	var req GitPathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	if err := gitService.MergeAbort(); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
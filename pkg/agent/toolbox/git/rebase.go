@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func RebaseContinue(c *gin.Context) {
+	var req GitPathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	result, err := gitService.RebaseContinue()
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.JSON(200, result)
+}
+
+func RebaseAbort(c *gin.Context) {
+	var req GitPathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	if err := gitService.RebaseAbort(); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func Reset(c *gin.Context) {
+	var req GitResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	ref := ""
+	if req.Ref != nil {
+		ref = *req.Ref
+	}
+
+	if err := gitService.Reset(ref, git.GitResetMode(req.Mode)); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
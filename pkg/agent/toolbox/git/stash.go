@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/gin-gonic/gin"
+)
+
+func ListStashes(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.AbortWithError(400, errors.New("path is required"))
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: path,
+	}
+
+	stashes, err := gitService.StashList()
+	if err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.JSON(200, stashes)
+}
+
+func PushStash(c *gin.Context) {
+	var req GitStashRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	message := ""
+	if req.Message != nil {
+		message = *req.Message
+	}
+
+	if err := gitService.StashPush(message, req.IncludeUntracked); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(201)
+}
+
+func PopStash(c *gin.Context) {
+	var req GitStashPopRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	if req.Index < 0 {
+		c.AbortWithError(400, errors.New("invalid stash index"))
+		return
+	}
+
+	gitService := git.Service{
+		WorkspaceDir: req.Path,
+	}
+
+	if err := gitService.StashPop(req.Index); err != nil {
+		c.AbortWithError(400, err)
+		return
+	}
+
+	c.Status(200)
+}
@@ -43,3 +43,41 @@ type GitRepoRequest struct {
 	Username *string `json:"username,omitempty" validate:"optional"`
 	Password *string `json:"password,omitempty" validate:"optional"`
 } // @name GitRepoRequest
+
+type GitCheckoutRequest struct {
+	Path string `json:"path" validate:"required"`
+	// branch, tag or commit to check out
+	Ref string `json:"ref" validate:"required"`
+	// create a new branch named ref
+	Create bool `json:"create" validate:"optional"`
+} // @name GitCheckoutRequest
+
+type GitStashRequest struct {
+	Path             string  `json:"path" validate:"required"`
+	Message          *string `json:"message,omitempty" validate:"optional"`
+	IncludeUntracked bool    `json:"includeUntracked" validate:"optional"`
+} // @name GitStashRequest
+
+type GitStashPopRequest struct {
+	Path string `json:"path" validate:"required"`
+	// index of the stash entry, defaults to the latest one
+	Index int `json:"index" validate:"optional"`
+} // @name GitStashPopRequest
+
+type GitResetRequest struct {
+	Path string `json:"path" validate:"required"`
+	// ref to reset to, defaults to HEAD
+	Ref *string `json:"ref,omitempty" validate:"optional"`
+	// soft, mixed or hard
+	Mode string `json:"mode" validate:"required"`
+} // @name GitResetRequest
+
+type GitMergeRequest struct {
+	Path          string `json:"path" validate:"required"`
+	Branch        string `json:"branch" validate:"required"`
+	NoFastForward bool   `json:"noFastForward" validate:"optional"`
+} // @name GitMergeRequest
+
+type GitPathRequest struct {
+	Path string `json:"path" validate:"required"`
+} // @name GitPathRequest
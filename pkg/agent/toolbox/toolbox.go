@@ -5,6 +5,7 @@ package toolbox
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 
@@ -23,6 +24,9 @@ import (
 type Server struct {
 	ConfigDir    string
 	WorkspaceDir string
+	// ApiKey is required as a bearer token on every request
+	ApiKey          string
+	AuditLogWriters []io.Writer
 }
 
 type WorkspaceDirResponse struct {
@@ -42,6 +46,8 @@ func (s *Server) Start() error {
 	r := gin.New()
 	r.Use(gin.Recovery())
 	r.Use(middlewares.LoggingMiddleware())
+	r.Use(AuditMiddleware(s.AuditLogWriters...))
+	r.Use(AuthMiddleware(s.ApiKey))
 	binding.Validator = new(api.DefaultValidator)
 
 	r.GET("/workspace-dir", s.GetWorkspaceDir)
@@ -51,9 +57,11 @@ func (s *Server) Start() error {
 		// read operations
 		fsController.GET("", fs.ListFiles)
 		fsController.GET("/download", fs.DownloadFile)
+		fsController.GET("/download-archive", fs.DownloadArchive)
 		fsController.GET("/find", fs.FindInFiles)
 		fsController.GET("/info", fs.GetFileInfo)
 		fsController.GET("/search", fs.SearchFiles)
+		fsController.GET("/watch", fs.WatchFiles)
 
 		// create/modify operations
 		fsController.POST("/folder", fs.CreateFolder)
@@ -61,6 +69,7 @@ func (s *Server) Start() error {
 		fsController.POST("/permissions", fs.SetFilePermissions)
 		fsController.POST("/replace", fs.ReplaceInFiles)
 		fsController.POST("/upload", fs.UploadFile)
+		fsController.POST("/upload-archive", fs.UploadArchive)
 
 		// delete operations
 		fsController.DELETE("", fs.DeleteFile)
@@ -83,15 +92,25 @@ func (s *Server) Start() error {
 	gitController := r.Group("/git")
 	{
 		gitController.GET("/branches", git.ListBranches)
+		gitController.GET("/diff", git.GetDiff)
 		gitController.GET("/history", git.GetCommitHistory)
+		gitController.GET("/stash", git.ListStashes)
 		gitController.GET("/status", git.GetStatus)
 
 		gitController.POST("/add", git.AddFiles)
 		gitController.POST("/branches", git.CreateBranch)
+		gitController.POST("/checkout", git.Checkout)
 		gitController.POST("/clone", git.CloneRepository)
 		gitController.POST("/commit", git.CommitChanges)
+		gitController.POST("/merge", git.Merge)
+		gitController.POST("/merge/abort", git.MergeAbort)
 		gitController.POST("/pull", git.PullChanges)
 		gitController.POST("/push", git.PushChanges)
+		gitController.POST("/rebase/abort", git.RebaseAbort)
+		gitController.POST("/rebase/continue", git.RebaseContinue)
+		gitController.POST("/reset", git.Reset)
+		gitController.POST("/stash", git.PushStash)
+		gitController.POST("/stash/pop", git.PopStash)
 	}
 
 	lspController := r.Group("/lsp")
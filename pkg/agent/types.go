@@ -25,15 +25,24 @@ type ToolboxServer interface {
 	Start() error
 }
 
+type LifecycleHookRunner interface {
+	Run() error
+	Status() []models.LifecycleHookStatus
+}
+
 type Agent struct {
-	Config           *config.Config
-	Git              git.IGitService
+	Config *config.Config
+	Git    git.IGitService
+	// Git services of the additional repositories of the workspace keyed by their clone path
+	AdditionalGit    map[string]git.IGitService
 	DockerCredHelper docker.IDockerCredHelper
 	Ssh              SshServer
 	Toolbox          ToolboxServer
 	Tailscale        TailscaleServer
+	LifecycleHooks   LifecycleHookRunner
 	LogWriter        io.Writer
 	TelemetryEnabled bool
 	startTime        time.Time
 	Workspace        *models.Workspace
+	forwardPorts     []models.WorkspacePort
 }
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/services"
+	"github.com/gin-gonic/gin"
+)
+
+// PruneBuilds godoc
+//
+//	@Tags			build
+//	@Summary		Prune build images
+//	@Description	Remove the build images that are no longer referenced by workspaces or prebuilds from the builder registry
+//	@Produce		json
+//	@Param			dryRun	query		bool	false	"Report the images that would be removed without removing them"
+//	@Success		200		{object}	BuildPruneResult
+//	@Router			/build/prune [post]
+//
+//	@id				PruneBuilds
+func PruneBuilds(ctx *gin.Context) {
+	dryRunQuery := ctx.Query("dryRun")
+	var dryRun bool
+	var err error
+
+	if dryRunQuery != "" {
+		dryRun, err = strconv.ParseBool(dryRunQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for dryRun flag"))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	result, err := server.BuildService.Prune(ctx.Request.Context(), dryRun)
+	if err != nil {
+		if errors.Is(err, services.ErrBuildPruneInProgress) {
+			ctx.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to prune builds: %w", err))
+		return
+	}
+
+	ctx.JSON(200, result)
+}
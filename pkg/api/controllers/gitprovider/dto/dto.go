@@ -4,6 +4,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/models"
 )
 
@@ -20,4 +22,8 @@ type SetGitProviderConfig struct {
 	Alias         *string               `json:"alias,omitempty" validate:"optional"`
 	SigningKey    *string               `json:"signingKey,omitempty" validate:"optional"`
 	SigningMethod *models.SigningMethod `json:"signingMethod,omitempty" validate:"optional"`
+	// OAuth client the token and refresh token were issued to
+	OAuthClientId  *string    `json:"oauthClientId,omitempty" validate:"optional"`
+	RefreshToken   *string    `json:"refreshToken,omitempty" validate:"optional"`
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty" validate:"optional"`
 } // @name SetGitProviderConfig
@@ -176,12 +176,15 @@ func SaveGitProvider(ctx *gin.Context) {
 	}
 
 	gitProviderConfig := models.GitProviderConfig{
-		Id:            setConfigDto.Id,
-		ProviderId:    setConfigDto.ProviderId,
-		Token:         setConfigDto.Token,
-		BaseApiUrl:    setConfigDto.BaseApiUrl,
-		SigningKey:    setConfigDto.SigningKey,
-		SigningMethod: setConfigDto.SigningMethod,
+		Id:             setConfigDto.Id,
+		ProviderId:     setConfigDto.ProviderId,
+		Token:          setConfigDto.Token,
+		BaseApiUrl:     setConfigDto.BaseApiUrl,
+		SigningKey:     setConfigDto.SigningKey,
+		SigningMethod:  setConfigDto.SigningMethod,
+		OAuthClientId:  setConfigDto.OAuthClientId,
+		RefreshToken:   setConfigDto.RefreshToken,
+		TokenExpiresAt: setConfigDto.TokenExpiresAt,
 	}
 
 	if setConfigDto.Username != nil {
@@ -114,6 +114,20 @@ func ReadWorkspaceLog(ctx *gin.Context) {
 	ReadLog(ctx, workspaceLogReader, logs.ReadJSONLog, writeJSONToWs)
 }
 
+func ReadToolboxAuditLog(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	auditLogReader, err := server.WorkspaceService.GetToolboxAuditLogReader(ctx.Request.Context(), workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ReadLog(ctx, auditLogReader, logs.ReadToolboxAuditLog, writeJSONToWs)
+}
+
 func ReadBuildLog(ctx *gin.Context) {
 	buildId := ctx.Param("buildId")
 	retryQuery := ctx.DefaultQuery("retry", "true")
@@ -32,6 +32,22 @@ func WriteWorkspaceLog(ctx *gin.Context) {
 	writeLog(ctx, logWriter)
 }
 
+// The audit log is only written by the agent of the workspace, the route requires the API key of the workspace
+func WriteToolboxAuditLog(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	logWriter, err := server.WorkspaceService.GetToolboxAuditLogWriter(ctx, workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get toolbox audit log writer: %w", err))
+		return
+	}
+	defer logWriter.Close()
+
+	writeLog(ctx, logWriter)
+}
+
 func WriteTargetLog(ctx *gin.Context) {
 	targetId := ctx.Param("targetId")
 
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/services"
+	"github.com/daytonaio/daytona/pkg/stores"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateBuildResult godoc
+//
+//	@Tags			runner
+//	@Summary		Update build result
+//	@Description	Update the image, user and cache statistics of a build finished by the runner
+//	@Accept			json
+//	@Param			runnerId	path	string					true	"Runner ID"
+//	@Param			buildId		path	string					true	"Build ID"
+//	@Param			result		body	UpdateBuildResultDTO	true	"Build result"
+//	@Success		200
+//	@Router			/runner/{runnerId}/builds/{buildId}/result [post]
+//
+//	@id				UpdateBuildResult
+func UpdateBuildResult(ctx *gin.Context) {
+	runnerId := ctx.Param("runnerId")
+	buildId := ctx.Param("buildId")
+
+	var result services.UpdateBuildResultDTO
+	err := ctx.BindJSON(&result)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	b, err := server.BuildService.Find(ctx.Request.Context(), &services.BuildFilter{
+		StoreFilter: stores.BuildFilter{
+			Id: &buildId,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find build: %w", err))
+		return
+	}
+
+	// Build jobs are claimed by the runner that started them
+	if b.LastJob == nil || b.LastJob.RunnerId == nil || *b.LastJob.RunnerId != runnerId {
+		ctx.AbortWithError(http.StatusUnauthorized, fmt.Errorf("build job does not belong to runner"))
+		return
+	}
+
+	err = server.BuildService.UpdateResult(ctx.Request.Context(), buildId, result)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to update build result for %s: %w", buildId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
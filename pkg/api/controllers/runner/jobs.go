@@ -101,6 +101,8 @@ func UpdateJobState(ctx *gin.Context) {
 		return
 	}
 
+	updateJobState.RunnerId = &runnerId
+
 	err = server.RunnerService.UpdateJobState(ctx.Request.Context(), jobId, updateJobState)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to update job state: %w", err))
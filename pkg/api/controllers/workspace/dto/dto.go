@@ -8,8 +8,12 @@ import (
 )
 
 type UpdateWorkspaceMetadataDTO struct {
-	Uptime    uint64            `json:"uptime" validate:"required"`
-	GitStatus *models.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	Uptime         uint64                       `json:"uptime" validate:"required"`
+	GitStatus      *models.GitStatus            `json:"gitStatus,omitempty" validate:"optional"`
+	LifecycleHooks []models.LifecycleHookStatus `json:"lifecycleHooks,omitempty" validate:"optional"`
+	ForwardPorts   []models.WorkspacePort       `json:"forwardPorts,omitempty" validate:"optional"`
+	// Git status of each additional repository keyed by its clone path
+	AdditionalGitStatuses map[string]*models.GitStatus `json:"additionalGitStatuses,omitempty" validate:"optional"`
 } // @name UpdateWorkspaceMetadataDTO
 
 type UpdateWorkspaceProviderMetadataDTO struct {
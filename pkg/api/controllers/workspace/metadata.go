@@ -37,8 +37,11 @@ func UpdateWorkspaceMetadata(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	_, err = server.WorkspaceService.UpdateMetadata(ctx.Request.Context(), workspaceId, &models.WorkspaceMetadata{
-		Uptime:    updateDTO.Uptime,
-		GitStatus: updateDTO.GitStatus,
+		Uptime:                updateDTO.Uptime,
+		GitStatus:             updateDTO.GitStatus,
+		LifecycleHooks:        updateDTO.LifecycleHooks,
+		ForwardPorts:          updateDTO.ForwardPorts,
+		AdditionalGitStatuses: updateDTO.AdditionalGitStatuses,
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set workspace metadata for %s: %w", workspaceId, err))
@@ -38,6 +38,25 @@ func FsDeleteFile(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// FsDownloadArchive 			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Download archive
+//	@Description	Download a file or directory from a workspace as a streamed archive
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			path		query	string	true	"Path"
+//	@Param			format		query	string	false	"Archive format (tar or zip, default tar)"
+//	@Param			compression	query	string	false	"Tar archive compression (none, gzip or zstd, default gzip)"
+//	@Param			exclude		query	string	false	"Comma separated glob patterns of files and directories to exclude"
+//	@Success		200			{file}	file	"response contains the archive"
+//	@Router			/workspace/{workspaceId}/toolbox/files/download-archive [get]
+//
+//	@id				FsDownloadArchive
+func FsDownloadArchive(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 // FsDownloadFile 			godoc
 //
 //	@Tags			workspace toolbox
@@ -58,11 +77,19 @@ func FsDownloadFile(ctx *gin.Context) {
 //
 //	@Tags			workspace toolbox
 //	@Summary		Search for text/pattern in files
-//	@Description	Search for text/pattern inside a workspace files
+//	@Description	Search for text/pattern inside a workspace files. Files ignored by .gitignore are skipped unless noIgnore is set.
 //	@Produce		json
 //	@Param			workspaceId	path	string	true	"Workspace ID or Name"
 //	@Param			path		query	string	true	"Path"
 //	@Param			pattern		query	string	true	"Pattern"
+//	@Param			regex		query	bool	false	"Treat the pattern as a regular expression"
+//	@Param			ignoreCase	query	bool	false	"Case insensitive search"
+//	@Param			context		query	int		false	"Number of context lines around each match"
+//	@Param			maxResults	query	int		false	"Maximum number of matches, the search stops once it is reached so any matches up to the limit are returned"
+//	@Param			include		query	string	false	"Comma separated glob patterns of files to search"
+//	@Param			exclude		query	string	false	"Comma separated glob patterns of files and directories to skip"
+//	@Param			noIgnore	query	bool	false	"Do not respect .gitignore files"
+//	@Param			stream		query	bool	false	"Stream matches as server-sent events"
 //	@Success		200			{array}	Match
 //	@Router			/workspace/{workspaceId}/toolbox/files/find [get]
 //
@@ -140,11 +167,16 @@ func FsReplaceInFiles(ctx *gin.Context) {
 //
 //	@Tags			workspace toolbox
 //	@Summary		Search for files
-//	@Description	Search for files inside a workspace
+//	@Description	Search for files inside a workspace. Files ignored by .gitignore are skipped unless noIgnore is set.
 //	@Produce		json
 //	@Param			workspaceId	path		string	true	"Workspace ID or Name"
 //	@Param			path		query		string	true	"Path"
 //	@Param			pattern		query		string	true	"Pattern"
+//	@Param			maxResults	query		int		false	"Maximum number of files"
+//	@Param			include		query		string	false	"Comma separated glob patterns of files to return"
+//	@Param			exclude		query		string	false	"Comma separated glob patterns of files and directories to skip"
+//	@Param			noIgnore	query		bool	false	"Do not respect .gitignore files"
+//	@Param			stream		query		bool	false	"Stream file paths as server-sent events"
 //	@Success		200			{object}	SearchFilesResponse
 //	@Router			/workspace/{workspaceId}/toolbox/files/search [get]
 //
@@ -172,6 +204,23 @@ func FsSetFilePermissions(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
 
+// FsUploadArchive 			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Upload archive
+//	@Description	Upload a tar or zip archive, optionally compressed with gzip or zstd, and extract it at a path inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			path		query		string	true	"Destination directory"
+//	@Param			file		formData	file	true	"Archive"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/files/upload-archive [post]
+//
+//	@id				FsUploadArchive
+func FsUploadArchive(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
 // FsUploadFile 			godoc
 //
 //	@Tags			workspace toolbox
@@ -188,3 +237,23 @@ func FsSetFilePermissions(ctx *gin.Context) {
 func FsUploadFile(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
+
+// FsWatchFiles 			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Watch files
+//	@Description	Stream file change events under a path inside a workspace. Events are sent over a websocket connection or as server-sent events.
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			path		query		string	true	"Path"
+//	@Param			include		query		string	false	"Comma separated glob patterns of files to include"
+//	@Param			exclude		query		string	false	"Comma separated glob patterns of files and directories to exclude"
+//	@Param			recursive	query		bool	false	"Watch subdirectories (default true)"
+//	@Param			debounce	query		int		false	"Debounce interval in milliseconds (default 100)"
+//	@Success		200			{object}	FileWatchEvent
+//	@Router			/workspace/{workspaceId}/toolbox/files/watch [get]
+//
+//	@id				FsWatchFiles
+func FsWatchFiles(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
@@ -148,3 +148,167 @@ func GitPushChanges(ctx *gin.Context) {
 func GitStatus(ctx *gin.Context) {
 	forwardRequestToToolbox(ctx)
 }
+
+// GitDiff			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Get diff
+//	@Description	Get diff of the working tree, the index or between refs from git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			path		query	string	true	"Path to git repository"
+//	@Param			staged		query	bool	false	"Compare the index instead of the working tree"
+//	@Param			from		query	string	false	"Ref to compare with"
+//	@Param			to			query	string	false	"Second ref to compare, requires from"
+//	@Param			files		query	string	false	"Comma separated list of files to limit the diff to"
+//	@Success		200			{array}	GitFileDiff
+//	@Router			/workspace/{workspaceId}/toolbox/git/diff [get]
+//
+//	@id				GitDiff
+func GitDiff(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitCheckout			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Checkout ref
+//	@Description	Checkout a branch, tag or commit on git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			params		body	GitCheckoutRequest	true	"GitCheckoutRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/git/checkout [post]
+//
+//	@id				GitCheckout
+func GitCheckout(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitStashList			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Get stash list
+//	@Description	Get stash entries from git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			path		query	string	true	"Path to git repository"
+//	@Success		200			{array}	GitStashEntry
+//	@Router			/workspace/{workspaceId}/toolbox/git/stash [get]
+//
+//	@id				GitStashList
+func GitStashList(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitStashPush			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Stash changes
+//	@Description	Stash changes on git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			params		body	GitStashRequest	true	"GitStashRequest"
+//	@Success		201
+//	@Router			/workspace/{workspaceId}/toolbox/git/stash [post]
+//
+//	@id				GitStashPush
+func GitStashPush(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitStashPop			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Pop stash
+//	@Description	Apply and remove a stash entry on git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			params		body	GitStashPopRequest	true	"GitStashPopRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/git/stash/pop [post]
+//
+//	@id				GitStashPop
+func GitStashPop(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitReset			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Reset
+//	@Description	Reset current branch of git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			params		body	GitResetRequest	true	"GitResetRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/git/reset [post]
+//
+//	@id				GitReset
+func GitReset(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitMerge			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Merge branch
+//	@Description	Merge a branch into the current branch of git repository inside a workspace. Conflicting files are returned in the response.
+//	@Produce		json
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			params		body		GitMergeRequest	true	"GitMergeRequest"
+//	@Success		200			{object}	GitMergeResult
+//	@Router			/workspace/{workspaceId}/toolbox/git/merge [post]
+//
+//	@id				GitMerge
+func GitMerge(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitMergeAbort			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Abort merge
+//	@Description	Abort the merge in progress on git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			params		body	GitPathRequest	true	"GitPathRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/git/merge/abort [post]
+//
+//	@id				GitMergeAbort
+func GitMergeAbort(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitRebaseContinue			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Continue rebase
+//	@Description	Continue the rebase in progress on git repository inside a workspace. Conflicting files are returned in the response.
+//	@Produce		json
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			params		body		GitPathRequest	true	"GitPathRequest"
+//	@Success		200			{object}	GitMergeResult
+//	@Router			/workspace/{workspaceId}/toolbox/git/rebase/continue [post]
+//
+//	@id				GitRebaseContinue
+func GitRebaseContinue(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
+
+// GitRebaseAbort			godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Abort rebase
+//	@Description	Abort the rebase in progress on git repository inside a workspace
+//	@Produce		json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			params		body	GitPathRequest	true	"GitPathRequest"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/toolbox/git/rebase/abort [post]
+//
+//	@id				GitRebaseAbort
+func GitRebaseAbort(ctx *gin.Context) {
+	forwardRequestToToolbox(ctx)
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/api/util"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/services"
@@ -96,6 +97,15 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 		}
 	}
 
+	// The toolbox only accepts requests authenticated with the workspace API key
+	header := http.Header{}
+	header.Set("Authorization", fmt.Sprintf("Bearer %s", w.ApiKey))
+	caller, err := server.ApiKeyService.GetApiKeyName(ctx.Request.Context(), util.ExtractToken(ctx))
+	if err != nil {
+		caller = "unknown"
+	}
+	header.Set(config.TOOLBOX_CALLER_HEADER, caller)
+
 	copy := ctx.Copy()
 
 	if scheme == "ws" {
@@ -106,7 +116,7 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 		}
 		defer ws.Close()
 
-		conn, _, err := websocketDialer.DialContext(ctx, reqUrl, nil)
+		conn, _, err := websocketDialer.DialContext(ctx, reqUrl, header)
 		if err != nil {
 			ctx.AbortWithError(http.StatusInternalServerError, err)
 			return
@@ -129,6 +139,9 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 
 	copy.Request.URL = newUrl
 	copy.Request.RequestURI = ""
+	for key := range header {
+		copy.Request.Header.Set(key, header.Get(key))
+	}
 
 	resp, err := client.Do(copy.Request)
 	if err != nil {
@@ -136,5 +149,31 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 		return
 	}
 
+	defer resp.Body.Close()
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		streamResponse(ctx, resp)
+		return
+	}
+
 	ctx.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
 }
+
+// streamResponse forwards server-sent events as they arrive instead of buffering the response
+func streamResponse(ctx *gin.Context, resp *http.Response) {
+	ctx.Header("Content-Type", resp.Header.Get("Content-Type"))
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Status(resp.StatusCode)
+
+	buf := make([]byte, 32*1024)
+	ctx.Stream(func(w io.Writer) bool {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+				return false
+			}
+		}
+		return err == nil
+	})
+}
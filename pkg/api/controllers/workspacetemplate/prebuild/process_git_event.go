@@ -4,12 +4,16 @@
 package prebuild
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
-	_ "github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // ProcessGitEvent 			godoc
@@ -26,6 +30,10 @@ func ProcessGitEvent(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	gitProvider, err := server.GitProviderService.GetGitProviderForHttpRequest(ctx.Request.Context(), ctx.Request)
+	if errors.Is(err, gitprovider.ErrInvalidWebhookSignature) {
+		ctx.AbortWithError(http.StatusUnauthorized, err)
+		return
+	}
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for request: %s", err.Error()))
 		return
@@ -41,6 +49,14 @@ func ProcessGitEvent(ctx *gin.Context) {
 		return
 	}
 
+	// Commenting calls the git provider so it does not delay the webhook response
+	go func(data gitprovider.GitEventData) {
+		err := server.GitProviderService.PostPrBotComment(context.Background(), data)
+		if err != nil {
+			log.Errorf("failed to post pull request comment: %s", err.Error())
+		}
+	}(*gitEventData)
+
 	err = server.WorkspaceTemplateService.ProcessGitEvent(ctx.Request.Context(), *gitEventData)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to process git event: %s", err.Error()))
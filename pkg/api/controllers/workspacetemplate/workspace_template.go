@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/services"
@@ -136,6 +137,8 @@ func SaveWorkspaceTemplate(ctx *gin.Context) {
 		RepositoryUrl:       req.RepositoryUrl,
 		EnvVars:             req.EnvVars,
 		GitProviderConfigId: req.GitProviderConfigId,
+		SparsePaths:         gitprovider.NormalizeSparsePaths(req.SparsePaths),
+		Lfs:                 req.Lfs,
 	}
 
 	if req.Image != nil {
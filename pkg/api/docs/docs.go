@@ -196,6 +196,35 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/prune": {
+            "post": {
+                "description": "Remove the build images that are no longer referenced by workspaces or prebuilds from the builder registry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "Prune build images",
+                "operationId": "PruneBuilds",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Report the images that would be removed without removing them",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildPruneResult"
+                        }
+                    }
+                }
+            }
+        },
         "/build/successful/{repoUrl}": {
             "get": {
                 "description": "List successful builds for Git repository",
@@ -1117,6 +1146,49 @@ const docTemplate = `{
                 }
             }
         },
+        "/runner/{runnerId}/builds/{buildId}/result": {
+            "post": {
+                "description": "Update the image, user and cache statistics of a build finished by the runner",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "runner"
+                ],
+                "summary": "Update build result",
+                "operationId": "UpdateBuildResult",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Runner ID",
+                        "name": "runnerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Build result",
+                        "name": "result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateBuildResultDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/runner/{runnerId}/jobs": {
             "get": {
                 "description": "List runner jobs",
@@ -2711,9 +2783,64 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/toolbox/files/download-archive": {
+            "get": {
+                "description": "Download a file or directory from a workspace as a streamed archive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Download archive",
+                "operationId": "FsDownloadArchive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Archive format (tar or zip, default tar)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tar archive compression (none, gzip or zstd, default gzip)",
+                        "name": "compression",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files and directories to exclude",
+                        "name": "exclude",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "response contains the archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/toolbox/files/find": {
             "get": {
-                "description": "Search for text/pattern inside a workspace files",
+                "description": "Search for text/pattern inside a workspace files. Files ignored by .gitignore are skipped unless noIgnore is set.",
                 "produces": [
                     "application/json"
                 ],
@@ -2743,6 +2870,54 @@ const docTemplate = `{
                         "name": "pattern",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Treat the pattern as a regular expression",
+                        "name": "regex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Case insensitive search",
+                        "name": "ignoreCase",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of context lines around each match",
+                        "name": "context",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of matches, the search stops once it is reached so any matches up to the limit are returned",
+                        "name": "maxResults",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files to search",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files and directories to skip",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Do not respect .gitignore files",
+                        "name": "noIgnore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream matches as server-sent events",
+                        "name": "stream",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2973,7 +3148,7 @@ const docTemplate = `{
         },
         "/workspace/{workspaceId}/toolbox/files/search": {
             "get": {
-                "description": "Search for files inside a workspace",
+                "description": "Search for files inside a workspace. Files ignored by .gitignore are skipped unless noIgnore is set.",
                 "produces": [
                     "application/json"
                 ],
@@ -3003,6 +3178,36 @@ const docTemplate = `{
                         "name": "pattern",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of files",
+                        "name": "maxResults",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files to return",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files and directories to skip",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Do not respect .gitignore files",
+                        "name": "noIgnore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream file paths as server-sent events",
+                        "name": "stream",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3056,6 +3261,108 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/toolbox/files/upload-archive": {
+            "post": {
+                "description": "Upload a tar or zip archive, optionally compressed with gzip or zstd, and extract it at a path inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Upload archive",
+                "operationId": "FsUploadArchive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Destination directory",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Archive",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/files/watch": {
+            "get": {
+                "description": "Stream file change events under a path inside a workspace. Events are sent over a websocket connection or as server-sent events.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Watch files",
+                "operationId": "FsWatchFiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files to include",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated glob patterns of files and directories to exclude",
+                        "name": "exclude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Watch subdirectories (default true)",
+                        "name": "recursive",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Debounce interval in milliseconds (default 100)",
+                        "name": "debounce",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/FileWatchEvent"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/toolbox/git/add": {
             "post": {
                 "description": "Add files to git commit",
@@ -3163,17 +3470,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}/toolbox/git/clone": {
+        "/workspace/{workspaceId}/toolbox/git/checkout": {
             "post": {
-                "description": "Clone git repository inside a workspace",
+                "description": "Checkout a branch, tag or commit on git repository inside a workspace",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace toolbox"
                 ],
-                "summary": "Clone git repository",
-                "operationId": "GitCloneRepository",
+                "summary": "Checkout ref",
+                "operationId": "GitCheckout",
                 "parameters": [
                     {
                         "type": "string",
@@ -3183,12 +3490,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "GitCloneRequest",
+                        "description": "GitCheckoutRequest",
                         "name": "params",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/GitCloneRequest"
+                            "$ref": "#/definitions/GitCheckoutRequest"
                         }
                     }
                 ],
@@ -3199,17 +3506,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}/toolbox/git/commit": {
+        "/workspace/{workspaceId}/toolbox/git/clone": {
             "post": {
-                "description": "Commit changes to git repository inside a workspace",
+                "description": "Clone git repository inside a workspace",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace toolbox"
                 ],
-                "summary": "Commit changes",
-                "operationId": "GitCommitChanges",
+                "summary": "Clone git repository",
+                "operationId": "GitCloneRepository",
                 "parameters": [
                     {
                         "type": "string",
@@ -3219,18 +3526,54 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "GitCommitRequest",
+                        "description": "GitCloneRequest",
                         "name": "params",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/GitCommitRequest"
+                            "$ref": "#/definitions/GitCloneRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/commit": {
+            "post": {
+                "description": "Commit changes to git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Commit changes",
+                "operationId": "GitCommitChanges",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitCommitRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitCommitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/GitCommitResponse"
                         }
@@ -3238,6 +3581,70 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/toolbox/git/diff": {
+            "get": {
+                "description": "Get diff of the working tree, the index or between refs from git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Get diff",
+                "operationId": "GitDiff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path to git repository",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Compare the index instead of the working tree",
+                        "name": "staged",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ref to compare with",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Second ref to compare, requires from",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of files to limit the diff to",
+                        "name": "files",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GitFileDiff"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/toolbox/git/history": {
             "get": {
                 "description": "Get commit history from git repository inside a workspace",
@@ -3278,6 +3685,81 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/toolbox/git/merge": {
+            "post": {
+                "description": "Merge a branch into the current branch of git repository inside a workspace. Conflicting files are returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Merge branch",
+                "operationId": "GitMerge",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitMergeRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitMergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GitMergeResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/merge/abort": {
+            "post": {
+                "description": "Abort the merge in progress on git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Abort merge",
+                "operationId": "GitMergeAbort",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitPathRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitPathRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/toolbox/git/pull": {
             "post": {
                 "description": "Pull changes from remote to git repository inside a workspace",
@@ -3350,6 +3832,227 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/toolbox/git/rebase/abort": {
+            "post": {
+                "description": "Abort the rebase in progress on git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Abort rebase",
+                "operationId": "GitRebaseAbort",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitPathRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitPathRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/rebase/continue": {
+            "post": {
+                "description": "Continue the rebase in progress on git repository inside a workspace. Conflicting files are returned in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Continue rebase",
+                "operationId": "GitRebaseContinue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitPathRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitPathRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GitMergeResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/reset": {
+            "post": {
+                "description": "Reset current branch of git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Reset",
+                "operationId": "GitReset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitResetRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/stash": {
+            "get": {
+                "description": "Get stash entries from git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Get stash list",
+                "operationId": "GitStashList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Path to git repository",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GitStashEntry"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Stash changes on git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Stash changes",
+                "operationId": "GitStashPush",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitStashRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitStashRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/toolbox/git/stash/pop": {
+            "post": {
+                "description": "Apply and remove a stash entry on git repository inside a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Pop stash",
+                "operationId": "GitStashPop",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitStashPopRequest",
+                        "name": "params",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GitStashPopRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/toolbox/git/status": {
             "get": {
                 "description": "Get status from git repository inside a workspace",
@@ -3956,14 +4659,48 @@ const docTemplate = `{
                 }
             }
         },
+        "BuildCacheStats": {
+            "type": "object",
+            "required": [
+                "cacheFrom",
+                "cachedSteps",
+                "totalSteps"
+            ],
+            "properties": {
+                "cacheFrom": {
+                    "description": "Images the build layers were reused from",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cachedSteps": {
+                    "description": "Number of build steps that were reused from the cache",
+                    "type": "integer"
+                },
+                "totalSteps": {
+                    "description": "Number of build steps of the image build",
+                    "type": "integer"
+                }
+            }
+        },
         "BuildConfig": {
             "type": "object",
             "properties": {
+                "buildpacks": {
+                    "$ref": "#/definitions/BuildpacksConfig"
+                },
                 "cachedBuild": {
                     "$ref": "#/definitions/CachedBuild"
                 },
                 "devcontainer": {
                     "$ref": "#/definitions/DevcontainerConfig"
+                },
+                "dockerfile": {
+                    "$ref": "#/definitions/DockerfileConfig"
+                },
+                "nix": {
+                    "$ref": "#/definitions/NixConfig"
                 }
             }
         },
@@ -3982,6 +4719,9 @@ const docTemplate = `{
                 "buildConfig": {
                     "$ref": "#/definitions/BuildConfig"
                 },
+                "cacheStats": {
+                    "$ref": "#/definitions/BuildCacheStats"
+                },
                 "containerConfig": {
                     "$ref": "#/definitions/ContainerConfig"
                 },
@@ -4015,10 +4755,42 @@ const docTemplate = `{
                 "state": {
                     "$ref": "#/definitions/ResourceState"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "updatedAt": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "BuildPruneResult": {
+            "type": "object",
+            "required": [
+                "dryRun",
+                "images",
+                "reclaimedSpace"
+            ],
+            "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PrunedBuildImage"
+                    }
                 },
-                "user": {
+                "reclaimedSpace": {
+                    "description": "Size in bytes of the registry storage reclaimed by removing the images",
+                    "type": "integer"
+                }
+            }
+        },
+        "BuildpacksConfig": {
+            "type": "object",
+            "properties": {
+                "builder": {
+                    "description": "Buildpacks builder image, defaults to the Paketo Jammy base builder",
                     "type": "string"
                 }
             }
@@ -4042,11 +4814,13 @@ const docTemplate = `{
             "type": "string",
             "enum": [
                 "branch",
-                "commit"
+                "commit",
+                "tag"
             ],
             "x-enum-varnames": [
                 "CloneTargetBranch",
-                "CloneTargetCommit"
+                "CloneTargetCommit",
+                "CloneTargetTag"
             ]
         },
         "Command": {
@@ -4177,9 +4951,15 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "prNumber": {
+                    "type": "integer"
+                },
                 "prebuildId": {
                     "type": "string"
                 },
+                "tag": {
+                    "type": "string"
+                },
                 "workspaceTemplateName": {
                     "type": "string"
                 }
@@ -4200,9 +4980,24 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "pullRequestTargets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "schedule": {
+                    "type": "string"
+                },
+                "tagPatterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -4352,6 +5147,12 @@ const docTemplate = `{
                 "repository"
             ],
             "properties": {
+                "additionalRepositories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspaceRepository"
+                    }
+                },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 }
@@ -4380,12 +5181,21 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "lfs": {
+                    "$ref": "#/definitions/GitLfsConfig"
+                },
                 "name": {
                     "type": "string"
                 },
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "sparsePaths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "user": {
                     "type": "string"
                 }
@@ -4402,6 +5212,32 @@ const docTemplate = `{
                 }
             }
         },
+        "DockerfileConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "args": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "context": {
+                    "description": "Build context directory relative to the repository root, defaults to the repository root",
+                    "type": "string"
+                },
+                "filePath": {
+                    "description": "Path to the Dockerfile relative to the repository root",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target stage of a multi-stage Dockerfile",
+                    "type": "string"
+                }
+            }
+        },
         "EnvironmentVariable": {
             "type": "object",
             "required": [
@@ -4528,6 +5364,29 @@ const docTemplate = `{
                 }
             }
         },
+        "FileWatchEvent": {
+            "type": "object",
+            "required": [
+                "isDir",
+                "path",
+                "time",
+                "type"
+            ],
+            "properties": {
+                "isDir": {
+                    "type": "boolean"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/fs.FileWatchEventType"
+                }
+            }
+        },
         "GetRepositoryContext": {
             "type": "object",
             "required": [
@@ -4612,6 +5471,26 @@ const docTemplate = `{
                 }
             }
         },
+        "GitCheckoutRequest": {
+            "type": "object",
+            "required": [
+                "path",
+                "ref"
+            ],
+            "properties": {
+                "create": {
+                    "description": "create a new branch named ref",
+                    "type": "boolean"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "ref": {
+                    "description": "branch, tag or commit to check out",
+                    "type": "string"
+                }
+            }
+        },
         "GitCloneRequest": {
             "type": "object",
             "required": [
@@ -4700,6 +5579,123 @@ const docTemplate = `{
                 }
             }
         },
+        "GitDiffHunk": {
+            "type": "object",
+            "required": [
+                "header",
+                "lines",
+                "newLines",
+                "newStart",
+                "oldLines",
+                "oldStart"
+            ],
+            "properties": {
+                "header": {
+                    "type": "string"
+                },
+                "lines": {
+                    "description": "Lines of the hunk prefixed with ' ', '+' or '-'",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "newLines": {
+                    "type": "integer"
+                },
+                "newStart": {
+                    "type": "integer"
+                },
+                "oldLines": {
+                    "type": "integer"
+                },
+                "oldStart": {
+                    "type": "integer"
+                }
+            }
+        },
+        "GitFileDiff": {
+            "type": "object",
+            "required": [
+                "binary",
+                "hunks",
+                "newPath",
+                "oldPath",
+                "status"
+            ],
+            "properties": {
+                "binary": {
+                    "type": "boolean"
+                },
+                "hunks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/GitDiffHunk"
+                    }
+                },
+                "newPath": {
+                    "type": "string"
+                },
+                "oldPath": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/Status"
+                }
+            }
+        },
+        "GitLfsConfig": {
+            "type": "object",
+            "properties": {
+                "exclude": {
+                    "description": "Paths of the LFS objects to skip",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "include": {
+                    "description": "Paths of the LFS objects to fetch, all objects are fetched if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "GitMergeRequest": {
+            "type": "object",
+            "required": [
+                "branch",
+                "path"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "noFastForward": {
+                    "type": "boolean"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitMergeResult": {
+            "type": "object",
+            "required": [
+                "conflicts"
+            ],
+            "properties": {
+                "conflicts": {
+                    "description": "Files with unresolved conflicts, empty if the operation completed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "GitNamespace": {
             "type": "object",
             "required": [
@@ -4715,6 +5711,17 @@ const docTemplate = `{
                 }
             }
         },
+        "GitPathRequest": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
         "GitProvider": {
             "type": "object",
             "required": [
@@ -4734,6 +5741,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "oauthClientId": {
+                    "description": "Set if the token was obtained with the OAuth device flow",
+                    "type": "string"
+                },
                 "providerId": {
                     "type": "string"
                 },
@@ -4746,11 +5757,51 @@ const docTemplate = `{
                 "token": {
                     "type": "string"
                 },
+                "tokenExpiresAt": {
+                    "type": "string"
+                },
+                "tokenStatus": {
+                    "description": "Result of the last token health check",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GitProviderTokenStatus"
+                        }
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "GitProviderTokenStatus": {
+            "type": "object",
+            "required": [
+                "checkedAt",
+                "valid"
+            ],
+            "properties": {
+                "checkedAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Error returned by the git provider if the token is not valid",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scopes and expiry are only set if the git provider exposes them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
         "GitPullRequest": {
             "type": "object",
             "required": [
@@ -4824,25 +5875,115 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "lfs": {
+                    "description": "Git LFS objects are only fetched if set",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GitLfsConfig"
+                        }
+                    ]
+                },
                 "name": {
                     "type": "string"
                 },
                 "owner": {
                     "type": "string"
                 },
-                "path": {
+                "path": {
+                    "type": "string"
+                },
+                "prNumber": {
+                    "type": "integer"
+                },
+                "pullRequest": {
+                    "description": "Set if the repository context was created from a pull request",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PullRequestRefs"
+                        }
+                    ]
+                },
+                "sha": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "sparsePaths": {
+                    "description": "Directories checked out in cone mode, the whole repository is checked out if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitResetRequest": {
+            "type": "object",
+            "required": [
+                "mode",
+                "path"
+            ],
+            "properties": {
+                "mode": {
+                    "description": "soft, mixed or hard",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "ref": {
+                    "description": "ref to reset to, defaults to HEAD",
+                    "type": "string"
+                }
+            }
+        },
+        "GitStashEntry": {
+            "type": "object",
+            "required": [
+                "index",
+                "message"
+            ],
+            "properties": {
+                "index": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
-                },
-                "prNumber": {
+                }
+            }
+        },
+        "GitStashPopRequest": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "index": {
+                    "description": "index of the stash entry, defaults to the latest one",
                     "type": "integer"
                 },
-                "sha": {
+                "path": {
                     "type": "string"
+                }
+            }
+        },
+        "GitStashRequest": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "includeUntracked": {
+                    "type": "boolean"
                 },
-                "source": {
+                "message": {
                     "type": "string"
                 },
-                "url": {
+                "path": {
                     "type": "string"
                 }
             }
@@ -4957,6 +6098,44 @@ const docTemplate = `{
                 "JobStateSuccess"
             ]
         },
+        "LifecycleHookState": {
+            "type": "string",
+            "enum": [
+                "running",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "LifecycleHookStateRunning",
+                "LifecycleHookStateSucceeded",
+                "LifecycleHookStateFailed"
+            ]
+        },
+        "LifecycleHookStatus": {
+            "type": "object",
+            "required": [
+                "name",
+                "startedAt",
+                "state"
+            ],
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "finishedAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/LifecycleHookState"
+                }
+            }
+        },
         "ListBranchResponse": {
             "type": "object",
             "required": [
@@ -5132,6 +6311,20 @@ const docTemplate = `{
                 "line"
             ],
             "properties": {
+                "after": {
+                    "description": "Lines following the match when context lines are requested",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "before": {
+                    "description": "Lines preceding the match when context lines are requested",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "content": {
                     "type": "string"
                 },
@@ -5154,6 +6347,18 @@ const docTemplate = `{
                 }
             }
         },
+        "NixConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "filePath": {
+                    "description": "Path to the flake.nix, devenv.nix or shell.nix file relative to the repository root",
+                    "type": "string"
+                }
+            }
+        },
         "Position": {
             "type": "object",
             "required": [
@@ -5187,9 +6392,24 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "pullRequestTargets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "schedule": {
+                    "type": "string"
+                },
+                "tagPatterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -5216,9 +6436,24 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "pullRequestTargets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "schedule": {
+                    "type": "string"
+                },
+                "tagPatterns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -5285,6 +6520,50 @@ const docTemplate = `{
                 }
             }
         },
+        "PrunedBuildImage": {
+            "type": "object",
+            "required": [
+                "image",
+                "size"
+            ],
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size in bytes of the layers only used by the image",
+                    "type": "integer"
+                }
+            }
+        },
+        "PullRequestRefs": {
+            "type": "object",
+            "required": [
+                "baseBranch",
+                "baseRepoId",
+                "baseRepoOwner",
+                "headBranch"
+            ],
+            "properties": {
+                "baseBranch": {
+                    "type": "string"
+                },
+                "baseRepoId": {
+                    "description": "ID and owner of the repository the pull request targets",
+                    "type": "string"
+                },
+                "baseRepoOwner": {
+                    "type": "string"
+                },
+                "forkOrigin": {
+                    "description": "Clone URL of the repository the pull request targets, only set if the head branch is in a fork",
+                    "type": "string"
+                },
+                "headBranch": {
+                    "type": "string"
+                }
+            }
+        },
         "ReplaceRequest": {
             "type": "object",
             "required": [
@@ -5476,6 +6755,10 @@ const docTemplate = `{
                 "buildImageNamespace": {
                     "type": "string"
                 },
+                "buildLinkTemplate": {
+                    "description": "URL of the page that shows a build, {buildId} is replaced with the ID of the build. Commit statuses of prebuilds link to it.",
+                    "type": "string"
+                },
                 "builderImage": {
                     "type": "string"
                 },
@@ -5509,6 +6792,14 @@ const docTemplate = `{
                 "logFile": {
                     "$ref": "#/definitions/LogFileConfig"
                 },
+                "prBotEnabled": {
+                    "description": "Comments a workspace creation link on pull requests of repositories with prebuilds",
+                    "type": "boolean"
+                },
+                "prBotLinkTemplate": {
+                    "description": "URL of the page that creates a workspace from the pull request, {url} is replaced with the escaped URL of the pull request branch",
+                    "type": "string"
+                },
                 "registryUrl": {
                     "type": "string"
                 },
@@ -5582,9 +6873,16 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "oauthClientId": {
+                    "description": "OAuth client the token and refresh token were issued to",
+                    "type": "string"
+                },
                 "providerId": {
                     "type": "string"
                 },
+                "refreshToken": {
+                    "type": "string"
+                },
                 "signingKey": {
                     "type": "string"
                 },
@@ -5594,6 +6892,9 @@ const docTemplate = `{
                 "token": {
                     "type": "string"
                 },
+                "tokenExpiresAt": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
                 }
@@ -5833,6 +7134,24 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateBuildResultDTO": {
+            "type": "object",
+            "required": [
+                "image",
+                "user"
+            ],
+            "properties": {
+                "cacheStats": {
+                    "$ref": "#/definitions/BuildCacheStats"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
         "UpdateJobState": {
             "type": "object",
             "required": [
@@ -5896,9 +7215,28 @@ const docTemplate = `{
                 "uptime"
             ],
             "properties": {
+                "additionalGitStatuses": {
+                    "description": "Git status of each additional repository keyed by its clone path",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/GitStatus"
+                    }
+                },
+                "forwardPorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspacePort"
+                    }
+                },
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lifecycleHooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleHookStatus"
+                    }
+                },
                 "uptime": {
                     "type": "integer"
                 }
@@ -5930,6 +7268,13 @@ const docTemplate = `{
                 "user"
             ],
             "properties": {
+                "additionalRepositories": {
+                    "description": "Repositories cloned next to the main repository of the workspace",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspaceRepository"
+                    }
+                },
                 "apiKey": {
                     "type": "string"
                 },
@@ -6002,6 +7347,13 @@ const docTemplate = `{
                 "user"
             ],
             "properties": {
+                "additionalRepositories": {
+                    "description": "Repositories cloned next to the main repository of the workspace",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspaceRepository"
+                    }
+                },
                 "apiKey": {
                     "type": "string"
                 },
@@ -6077,9 +7429,34 @@ const docTemplate = `{
                 "workspaceId"
             ],
             "properties": {
+                "additionalGitStatuses": {
+                    "description": "Git status of each additional repository keyed by its clone path",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/GitStatus"
+                    }
+                },
+                "forwardPorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspacePort"
+                    }
+                },
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lifecycleHooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleHookStatus"
+                    }
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspaceService"
+                    }
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -6091,6 +7468,60 @@ const docTemplate = `{
                 }
             }
         },
+        "WorkspacePort": {
+            "type": "object",
+            "required": [
+                "port"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "onAutoForward": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "requireLocalPort": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "WorkspaceRepository": {
+            "type": "object",
+            "required": [
+                "clonePath",
+                "repository"
+            ],
+            "properties": {
+                "clonePath": {
+                    "description": "Directory of the clone relative to the parent directory of the main repository",
+                    "type": "string"
+                },
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                }
+            }
+        },
+        "WorkspaceService": {
+            "type": "object",
+            "required": [
+                "name",
+                "state"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "WorkspaceTemplate": {
             "type": "object",
             "required": [
@@ -6127,6 +7558,14 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "lfs": {
+                    "description": "Git LFS objects are only fetched if set",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GitLfsConfig"
+                        }
+                    ]
+                },
                 "name": {
                     "type": "string"
                 },
@@ -6139,11 +7578,33 @@ const docTemplate = `{
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "sparsePaths": {
+                    "description": "Directories checked out in cone mode, the whole repository is checked out if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "user": {
                     "type": "string"
                 }
             }
         },
+        "fs.FileWatchEventType": {
+            "type": "string",
+            "enum": [
+                "create",
+                "modify",
+                "delete",
+                "rename"
+            ],
+            "x-enum-varnames": [
+                "FileWatchEventCreate",
+                "FileWatchEventModify",
+                "FileWatchEventDelete",
+                "FileWatchEventRename"
+            ]
+        },
         "models.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -33,6 +33,16 @@ func RunnerAuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Runner API keys are named after the runner they belong to
+		runnerId := ctx.Param("runnerId")
+		if runnerId != "" {
+			apiKeyName, err := server.ApiKeyService.GetApiKeyName(ctx.Request.Context(), token)
+			if err != nil || apiKeyName != runnerId {
+				ctx.AbortWithError(401, errors.New("unauthorized"))
+				return
+			}
+		}
+
 		ctx.Next()
 	}
 }
@@ -30,14 +30,27 @@ func WorkspaceAuthMiddleware() gin.HandlerFunc {
 
 		switch apiKeyType {
 		case models.ApiKeyTypeWorkspace:
-			fallthrough
 		case models.ApiKeyTypeTarget:
-			ctx.Next()
 		default:
 			ctx.AbortWithError(401, errors.New("unauthorized"))
 			return
 		}
 
+		// Workspace API keys are named after the workspace they belong to
+		workspaceId := ctx.Param("workspaceId")
+		if workspaceId != "" {
+			if apiKeyType != models.ApiKeyTypeWorkspace {
+				ctx.AbortWithError(401, errors.New("unauthorized"))
+				return
+			}
+
+			apiKeyName, err := server.ApiKeyService.GetApiKeyName(ctx.Request.Context(), token)
+			if err != nil || apiKeyName != workspaceId {
+				ctx.AbortWithError(401, errors.New("unauthorized"))
+				return
+			}
+		}
+
 		ctx.Next()
 	}
 }
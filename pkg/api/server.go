@@ -184,29 +184,42 @@ func (a *ApiServer) Start() error {
 
 				fsController.GET("", toolbox.FsListFiles)
 				fsController.GET("/download", toolbox.FsDownloadFile)
+				fsController.GET("/download-archive", toolbox.FsDownloadArchive)
 				fsController.GET("/find", toolbox.FsFindInFiles)
 				fsController.GET("/info", toolbox.FsGetFileDetails)
 				fsController.GET("/search", toolbox.FsSearchFiles)
+				fsController.GET("/watch", toolbox.FsWatchFiles)
 
 				fsController.POST("/folder", toolbox.FsCreateFolder)
 				fsController.POST("/move", toolbox.FsMoveFile)
 				fsController.POST("/replace", toolbox.FsReplaceInFiles)
 				fsController.POST("/permissions", toolbox.FsSetFilePermissions)
 				fsController.POST("/upload", toolbox.FsUploadFile)
+				fsController.POST("/upload-archive", toolbox.FsUploadArchive)
 			}
 
 			gitController := toolboxController.Group("/git")
 			{
 				gitController.GET("/branches", toolbox.GitBranchList)
+				gitController.GET("/diff", toolbox.GitDiff)
 				gitController.GET("/history", toolbox.GitCommitHistory)
+				gitController.GET("/stash", toolbox.GitStashList)
 				gitController.GET("/status", toolbox.GitStatus)
 
 				gitController.POST("/add", toolbox.GitAddFiles)
 				gitController.POST("/branches", toolbox.GitCreateBranch)
+				gitController.POST("/checkout", toolbox.GitCheckout)
 				gitController.POST("/clone", toolbox.GitCloneRepository)
 				gitController.POST("/commit", toolbox.GitCommitChanges)
+				gitController.POST("/merge", toolbox.GitMerge)
+				gitController.POST("/merge/abort", toolbox.GitMergeAbort)
 				gitController.POST("/pull", toolbox.GitPushChanges)
 				gitController.POST("/push", toolbox.GitPushChanges)
+				gitController.POST("/rebase/abort", toolbox.GitRebaseAbort)
+				gitController.POST("/rebase/continue", toolbox.GitRebaseContinue)
+				gitController.POST("/reset", toolbox.GitReset)
+				gitController.POST("/stash", toolbox.GitStashPush)
+				gitController.POST("/stash/pop", toolbox.GitStashPop)
 			}
 
 			lspController := toolboxController.Group("/lsp")
@@ -269,6 +282,7 @@ func (a *ApiServer) Start() error {
 		buildController.GET("/:buildId", build.FindBuild)
 		buildController.GET("", build.ListBuilds)
 		buildController.GET("/successful/:repoUrl", build.ListSuccessfulBuilds)
+		buildController.POST("/prune", build.PruneBuilds)
 		buildController.DELETE("", build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
@@ -291,6 +305,8 @@ func (a *ApiServer) Start() error {
 		logController.GET("/workspace/:workspaceId", log_controller.ReadWorkspaceLog)
 		logController.GET("/workspace/:workspaceId/write", log_controller.WriteWorkspaceLog)
 
+		logController.GET("/toolbox-audit/:workspaceId", log_controller.ReadToolboxAuditLog)
+
 		logController.GET("/build/:buildId", log_controller.ReadBuildLog)
 		logController.GET("/build/:buildId/write", log_controller.WriteBuildLog)
 
@@ -378,6 +394,7 @@ func (a *ApiServer) Start() error {
 	{
 		workspaceGroup.POST(workspaceController.BasePath()+"/:workspaceId/metadata", workspace.UpdateWorkspaceMetadata)
 		workspaceGroup.POST(targetController.BasePath()+"/:targetId/metadata", target.UpdateTargetMetadata)
+		workspaceGroup.GET(logController.BasePath()+"/toolbox-audit/:workspaceId/write", log_controller.WriteToolboxAuditLog)
 	}
 
 	runnerGroup := protected.Group("/")
@@ -386,6 +403,7 @@ func (a *ApiServer) Start() error {
 		runnerGroup.POST(runnerController.BasePath()+"/:runnerId/metadata", runner.UpdateRunnerMetadata)
 		runnerGroup.GET(runnerController.BasePath()+"/:runnerId/jobs", runner.ListRunnerJobs)
 		runnerGroup.POST(runnerController.BasePath()+"/:runnerId/jobs/:jobId/state", runner.UpdateJobState)
+		runnerGroup.POST(runnerController.BasePath()+"/:runnerId/builds/:buildId/result", runner.UpdateBuildResult)
 	}
 
 	a.httpServer = &http.Server{
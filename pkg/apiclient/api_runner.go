@@ -604,6 +604,128 @@ func (a *RunnerAPIService) ListRunnersExecute(r ApiListRunnersRequest) ([]Runner
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiUpdateBuildResultRequest struct {
+	ctx        context.Context
+	ApiService *RunnerAPIService
+	runnerId   string
+	buildId    string
+	result     *UpdateBuildResultDTO
+}
+
+// Build result
+func (r ApiUpdateBuildResultRequest) Result(result UpdateBuildResultDTO) ApiUpdateBuildResultRequest {
+	r.result = &result
+	return r
+}
+
+func (r ApiUpdateBuildResultRequest) Execute() (*http.Response, error) {
+	return r.ApiService.UpdateBuildResultExecute(r)
+}
+
+/*
+UpdateBuildResult Update build result
+
+Update the image, user and cache statistics of a build finished by the runner
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param runnerId Runner ID
+	@param buildId Build ID
+	@return ApiUpdateBuildResultRequest
+*/
+func (a *RunnerAPIService) UpdateBuildResult(ctx context.Context, runnerId string, buildId string) ApiUpdateBuildResultRequest {
+	return ApiUpdateBuildResultRequest{
+		ApiService: a,
+		ctx:        ctx,
+		runnerId:   runnerId,
+		buildId:    buildId,
+	}
+}
+
+// Execute executes the request
+func (a *RunnerAPIService) UpdateBuildResultExecute(r ApiUpdateBuildResultRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RunnerAPIService.UpdateBuildResult")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/runner/{runnerId}/builds/{buildId}/result"
+	localVarPath = strings.Replace(localVarPath, "{"+"runnerId"+"}", url.PathEscape(parameterValueToString(r.runnerId, "runnerId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"buildId"+"}", url.PathEscape(parameterValueToString(r.buildId, "buildId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.result == nil {
+		return nil, reportError("result is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.result
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiUpdateJobStateRequest struct {
 	ctx            context.Context
 	ApiService     *RunnerAPIService
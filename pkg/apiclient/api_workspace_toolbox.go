@@ -496,6 +496,165 @@ func (a *WorkspaceToolboxAPIService) FsDeleteFileExecute(r ApiFsDeleteFileReques
 	return localVarHTTPResponse, nil
 }
 
+type ApiFsDownloadArchiveRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	path        *string
+	format      *string
+	compression *string
+	exclude     *string
+}
+
+// Path
+func (r ApiFsDownloadArchiveRequest) Path(path string) ApiFsDownloadArchiveRequest {
+	r.path = &path
+	return r
+}
+
+// Archive format (tar or zip, default tar)
+func (r ApiFsDownloadArchiveRequest) Format(format string) ApiFsDownloadArchiveRequest {
+	r.format = &format
+	return r
+}
+
+// Tar archive compression (none, gzip or zstd, default gzip)
+func (r ApiFsDownloadArchiveRequest) Compression(compression string) ApiFsDownloadArchiveRequest {
+	r.compression = &compression
+	return r
+}
+
+// Comma separated glob patterns of files and directories to exclude
+func (r ApiFsDownloadArchiveRequest) Exclude(exclude string) ApiFsDownloadArchiveRequest {
+	r.exclude = &exclude
+	return r
+}
+
+func (r ApiFsDownloadArchiveRequest) Execute() (*os.File, *http.Response, error) {
+	return r.ApiService.FsDownloadArchiveExecute(r)
+}
+
+/*
+FsDownloadArchive Download archive
+
+Download a file or directory from a workspace as a streamed archive
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiFsDownloadArchiveRequest
+*/
+func (a *WorkspaceToolboxAPIService) FsDownloadArchive(ctx context.Context, workspaceId string) ApiFsDownloadArchiveRequest {
+	return ApiFsDownloadArchiveRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return *os.File
+func (a *WorkspaceToolboxAPIService) FsDownloadArchiveExecute(r ApiFsDownloadArchiveRequest) (*os.File, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *os.File
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.FsDownloadArchive")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/files/download-archive"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	if r.format != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "format", r.format, "")
+	}
+	if r.compression != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "compression", r.compression, "")
+	}
+	if r.exclude != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "exclude", r.exclude, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiFsDownloadFileRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
@@ -631,6 +790,14 @@ type ApiFsFindInFilesRequest struct {
 	workspaceId string
 	path        *string
 	pattern     *string
+	regex       *bool
+	ignoreCase  *bool
+	context     *int32
+	maxResults  *int32
+	include     *string
+	exclude     *string
+	noIgnore    *bool
+	stream      *bool
 }
 
 // Path
@@ -645,6 +812,54 @@ func (r ApiFsFindInFilesRequest) Pattern(pattern string) ApiFsFindInFilesRequest
 	return r
 }
 
+// Treat the pattern as a regular expression
+func (r ApiFsFindInFilesRequest) Regex(regex bool) ApiFsFindInFilesRequest {
+	r.regex = &regex
+	return r
+}
+
+// Case insensitive search
+func (r ApiFsFindInFilesRequest) IgnoreCase(ignoreCase bool) ApiFsFindInFilesRequest {
+	r.ignoreCase = &ignoreCase
+	return r
+}
+
+// Number of context lines around each match
+func (r ApiFsFindInFilesRequest) Context(context int32) ApiFsFindInFilesRequest {
+	r.context = &context
+	return r
+}
+
+// Maximum number of matches, the search stops once it is reached so any matches up to the limit are returned
+func (r ApiFsFindInFilesRequest) MaxResults(maxResults int32) ApiFsFindInFilesRequest {
+	r.maxResults = &maxResults
+	return r
+}
+
+// Comma separated glob patterns of files to search
+func (r ApiFsFindInFilesRequest) Include(include string) ApiFsFindInFilesRequest {
+	r.include = &include
+	return r
+}
+
+// Comma separated glob patterns of files and directories to skip
+func (r ApiFsFindInFilesRequest) Exclude(exclude string) ApiFsFindInFilesRequest {
+	r.exclude = &exclude
+	return r
+}
+
+// Do not respect .gitignore files
+func (r ApiFsFindInFilesRequest) NoIgnore(noIgnore bool) ApiFsFindInFilesRequest {
+	r.noIgnore = &noIgnore
+	return r
+}
+
+// Stream matches as server-sent events
+func (r ApiFsFindInFilesRequest) Stream(stream bool) ApiFsFindInFilesRequest {
+	r.stream = &stream
+	return r
+}
+
 func (r ApiFsFindInFilesRequest) Execute() ([]Match, *http.Response, error) {
 	return r.ApiService.FsFindInFilesExecute(r)
 }
@@ -652,7 +867,7 @@ func (r ApiFsFindInFilesRequest) Execute() ([]Match, *http.Response, error) {
 /*
 FsFindInFiles Search for text/pattern in files
 
-Search for text/pattern inside a workspace files
+Search for text/pattern inside a workspace files. Files ignored by .gitignore are skipped unless noIgnore is set.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
@@ -697,6 +912,30 @@ func (a *WorkspaceToolboxAPIService) FsFindInFilesExecute(r ApiFsFindInFilesRequ
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
 	parameterAddToHeaderOrQuery(localVarQueryParams, "pattern", r.pattern, "")
+	if r.regex != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "regex", r.regex, "")
+	}
+	if r.ignoreCase != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "ignoreCase", r.ignoreCase, "")
+	}
+	if r.context != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "context", r.context, "")
+	}
+	if r.maxResults != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "maxResults", r.maxResults, "")
+	}
+	if r.include != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "include", r.include, "")
+	}
+	if r.exclude != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "exclude", r.exclude, "")
+	}
+	if r.noIgnore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "noIgnore", r.noIgnore, "")
+	}
+	if r.stream != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "stream", r.stream, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1286,6 +1525,11 @@ type ApiFsSearchFilesRequest struct {
 	workspaceId string
 	path        *string
 	pattern     *string
+	maxResults  *int32
+	include     *string
+	exclude     *string
+	noIgnore    *bool
+	stream      *bool
 }
 
 // Path
@@ -1300,6 +1544,36 @@ func (r ApiFsSearchFilesRequest) Pattern(pattern string) ApiFsSearchFilesRequest
 	return r
 }
 
+// Maximum number of files
+func (r ApiFsSearchFilesRequest) MaxResults(maxResults int32) ApiFsSearchFilesRequest {
+	r.maxResults = &maxResults
+	return r
+}
+
+// Comma separated glob patterns of files to return
+func (r ApiFsSearchFilesRequest) Include(include string) ApiFsSearchFilesRequest {
+	r.include = &include
+	return r
+}
+
+// Comma separated glob patterns of files and directories to skip
+func (r ApiFsSearchFilesRequest) Exclude(exclude string) ApiFsSearchFilesRequest {
+	r.exclude = &exclude
+	return r
+}
+
+// Do not respect .gitignore files
+func (r ApiFsSearchFilesRequest) NoIgnore(noIgnore bool) ApiFsSearchFilesRequest {
+	r.noIgnore = &noIgnore
+	return r
+}
+
+// Stream file paths as server-sent events
+func (r ApiFsSearchFilesRequest) Stream(stream bool) ApiFsSearchFilesRequest {
+	r.stream = &stream
+	return r
+}
+
 func (r ApiFsSearchFilesRequest) Execute() (*SearchFilesResponse, *http.Response, error) {
 	return r.ApiService.FsSearchFilesExecute(r)
 }
@@ -1307,7 +1581,7 @@ func (r ApiFsSearchFilesRequest) Execute() (*SearchFilesResponse, *http.Response
 /*
 FsSearchFiles Search for files
 
-Search for files inside a workspace
+Search for files inside a workspace. Files ignored by .gitignore are skipped unless noIgnore is set.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
@@ -1352,6 +1626,21 @@ func (a *WorkspaceToolboxAPIService) FsSearchFilesExecute(r ApiFsSearchFilesRequ
 
 	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
 	parameterAddToHeaderOrQuery(localVarQueryParams, "pattern", r.pattern, "")
+	if r.maxResults != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "maxResults", r.maxResults, "")
+	}
+	if r.include != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "include", r.include, "")
+	}
+	if r.exclude != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "exclude", r.exclude, "")
+	}
+	if r.noIgnore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "noIgnore", r.noIgnore, "")
+	}
+	if r.stream != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "stream", r.stream, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1567,7 +1856,7 @@ func (a *WorkspaceToolboxAPIService) FsSetFilePermissionsExecute(r ApiFsSetFileP
 	return localVarHTTPResponse, nil
 }
 
-type ApiFsUploadFileRequest struct {
+type ApiFsUploadArchiveRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
@@ -1575,33 +1864,33 @@ type ApiFsUploadFileRequest struct {
 	file        *os.File
 }
 
-// Path
-func (r ApiFsUploadFileRequest) Path(path string) ApiFsUploadFileRequest {
+// Destination directory
+func (r ApiFsUploadArchiveRequest) Path(path string) ApiFsUploadArchiveRequest {
 	r.path = &path
 	return r
 }
 
-// File
-func (r ApiFsUploadFileRequest) File(file *os.File) ApiFsUploadFileRequest {
+// Archive
+func (r ApiFsUploadArchiveRequest) File(file *os.File) ApiFsUploadArchiveRequest {
 	r.file = file
 	return r
 }
 
-func (r ApiFsUploadFileRequest) Execute() (*http.Response, error) {
-	return r.ApiService.FsUploadFileExecute(r)
+func (r ApiFsUploadArchiveRequest) Execute() (*http.Response, error) {
+	return r.ApiService.FsUploadArchiveExecute(r)
 }
 
 /*
-FsUploadFile Upload file
+FsUploadArchive Upload archive
 
-Upload file inside a workspace
+Upload a tar or zip archive, optionally compressed with gzip or zstd, and extract it at a path inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiFsUploadFileRequest
+	@return ApiFsUploadArchiveRequest
 */
-func (a *WorkspaceToolboxAPIService) FsUploadFile(ctx context.Context, workspaceId string) ApiFsUploadFileRequest {
-	return ApiFsUploadFileRequest{
+func (a *WorkspaceToolboxAPIService) FsUploadArchive(ctx context.Context, workspaceId string) ApiFsUploadArchiveRequest {
+	return ApiFsUploadArchiveRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -1609,19 +1898,19 @@ func (a *WorkspaceToolboxAPIService) FsUploadFile(ctx context.Context, workspace
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) FsUploadFileExecute(r ApiFsUploadFileRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) FsUploadArchiveExecute(r ApiFsUploadArchiveRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.FsUploadFile")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.FsUploadArchive")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/files/upload"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/files/upload-archive"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1709,68 +1998,1526 @@ func (a *WorkspaceToolboxAPIService) FsUploadFileExecute(r ApiFsUploadFileReques
 	return localVarHTTPResponse, nil
 }
 
-type ApiGetSessionCommandLogsRequest struct {
+type ApiFsUploadFileRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	sessionId   string
-	commandId   string
+	path        *string
+	file        *os.File
 }
 
-func (r ApiGetSessionCommandLogsRequest) Execute() (string, *http.Response, error) {
-	return r.ApiService.GetSessionCommandLogsExecute(r)
+// Path
+func (r ApiFsUploadFileRequest) Path(path string) ApiFsUploadFileRequest {
+	r.path = &path
+	return r
+}
+
+// File
+func (r ApiFsUploadFileRequest) File(file *os.File) ApiFsUploadFileRequest {
+	r.file = file
+	return r
+}
+
+func (r ApiFsUploadFileRequest) Execute() (*http.Response, error) {
+	return r.ApiService.FsUploadFileExecute(r)
 }
 
 /*
-GetSessionCommandLogs Get session command logs
+FsUploadFile Upload file
 
-Get logs of a command inside a session inside workspace project
-Connect with websocket to get a stream of the logs
+Upload file inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@param sessionId Session ID
-	@param commandId Command ID
-	@return ApiGetSessionCommandLogsRequest
+	@return ApiFsUploadFileRequest
 */
-func (a *WorkspaceToolboxAPIService) GetSessionCommandLogs(ctx context.Context, workspaceId string, sessionId string, commandId string) ApiGetSessionCommandLogsRequest {
-	return ApiGetSessionCommandLogsRequest{
+func (a *WorkspaceToolboxAPIService) FsUploadFile(ctx context.Context, workspaceId string) ApiFsUploadFileRequest {
+	return ApiFsUploadFileRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
-		sessionId:   sessionId,
-		commandId:   commandId,
 	}
 }
 
 // Execute executes the request
-//
-//	@return string
-func (a *WorkspaceToolboxAPIService) GetSessionCommandLogsExecute(r ApiGetSessionCommandLogsRequest) (string, *http.Response, error) {
+func (a *WorkspaceToolboxAPIService) FsUploadFileExecute(r ApiFsUploadFileRequest) (*http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
-		localVarPostBody    interface{}
-		formFiles           []formFile
-		localVarReturnValue string
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GetSessionCommandLogs")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.FsUploadFile")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/process/session/{sessionId}/command/{commandId}/logs"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/files/upload"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"sessionId"+"}", url.PathEscape(parameterValueToString(r.sessionId, "sessionId")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"commandId"+"}", url.PathEscape(parameterValueToString(r.commandId, "commandId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
-	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
-
+	if r.path == nil {
+		return nil, reportError("path is required and must be specified")
+	}
+	if r.file == nil {
+		return nil, reportError("file is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	var fileLocalVarFormFileName string
+	var fileLocalVarFileName string
+	var fileLocalVarFileBytes []byte
+
+	fileLocalVarFormFileName = "file"
+	fileLocalVarFile := r.file
+
+	if fileLocalVarFile != nil {
+		fbs, _ := io.ReadAll(fileLocalVarFile)
+
+		fileLocalVarFileBytes = fbs
+		fileLocalVarFileName = fileLocalVarFile.Name()
+		fileLocalVarFile.Close()
+		formFiles = append(formFiles, formFile{fileBytes: fileLocalVarFileBytes, fileName: fileLocalVarFileName, formFileName: fileLocalVarFormFileName})
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiFsWatchFilesRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	path        *string
+	include     *string
+	exclude     *string
+	recursive   *bool
+	debounce    *int32
+}
+
+// Path
+func (r ApiFsWatchFilesRequest) Path(path string) ApiFsWatchFilesRequest {
+	r.path = &path
+	return r
+}
+
+// Comma separated glob patterns of files to include
+func (r ApiFsWatchFilesRequest) Include(include string) ApiFsWatchFilesRequest {
+	r.include = &include
+	return r
+}
+
+// Comma separated glob patterns of files and directories to exclude
+func (r ApiFsWatchFilesRequest) Exclude(exclude string) ApiFsWatchFilesRequest {
+	r.exclude = &exclude
+	return r
+}
+
+// Watch subdirectories (default true)
+func (r ApiFsWatchFilesRequest) Recursive(recursive bool) ApiFsWatchFilesRequest {
+	r.recursive = &recursive
+	return r
+}
+
+// Debounce interval in milliseconds (default 100)
+func (r ApiFsWatchFilesRequest) Debounce(debounce int32) ApiFsWatchFilesRequest {
+	r.debounce = &debounce
+	return r
+}
+
+func (r ApiFsWatchFilesRequest) Execute() (*FileWatchEvent, *http.Response, error) {
+	return r.ApiService.FsWatchFilesExecute(r)
+}
+
+/*
+FsWatchFiles Watch files
+
+Stream file change events under a path inside a workspace. Events are sent over a websocket connection or as server-sent events.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiFsWatchFilesRequest
+*/
+func (a *WorkspaceToolboxAPIService) FsWatchFiles(ctx context.Context, workspaceId string) ApiFsWatchFilesRequest {
+	return ApiFsWatchFilesRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return FileWatchEvent
+func (a *WorkspaceToolboxAPIService) FsWatchFilesExecute(r ApiFsWatchFilesRequest) (*FileWatchEvent, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *FileWatchEvent
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.FsWatchFiles")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/files/watch"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	if r.include != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "include", r.include, "")
+	}
+	if r.exclude != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "exclude", r.exclude, "")
+	}
+	if r.recursive != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "recursive", r.recursive, "")
+	}
+	if r.debounce != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "debounce", r.debounce, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetSessionCommandLogsRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	sessionId   string
+	commandId   string
+}
+
+func (r ApiGetSessionCommandLogsRequest) Execute() (string, *http.Response, error) {
+	return r.ApiService.GetSessionCommandLogsExecute(r)
+}
+
+/*
+GetSessionCommandLogs Get session command logs
+
+Get logs of a command inside a session inside workspace project
+Connect with websocket to get a stream of the logs
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@param sessionId Session ID
+	@param commandId Command ID
+	@return ApiGetSessionCommandLogsRequest
+*/
+func (a *WorkspaceToolboxAPIService) GetSessionCommandLogs(ctx context.Context, workspaceId string, sessionId string, commandId string) ApiGetSessionCommandLogsRequest {
+	return ApiGetSessionCommandLogsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+		sessionId:   sessionId,
+		commandId:   commandId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return string
+func (a *WorkspaceToolboxAPIService) GetSessionCommandLogsExecute(r ApiGetSessionCommandLogsRequest) (string, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue string
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GetSessionCommandLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/process/session/{sessionId}/command/{commandId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"sessionId"+"}", url.PathEscape(parameterValueToString(r.sessionId, "sessionId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"commandId"+"}", url.PathEscape(parameterValueToString(r.commandId, "commandId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"*/*"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetWorkspaceDirRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+}
+
+func (r ApiGetWorkspaceDirRequest) Execute() (*WorkspaceDirResponse, *http.Response, error) {
+	return r.ApiService.GetWorkspaceDirExecute(r)
+}
+
+/*
+GetWorkspaceDir Get workspace dir
+
+Get workspace directory
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGetWorkspaceDirRequest
+*/
+func (a *WorkspaceToolboxAPIService) GetWorkspaceDir(ctx context.Context, workspaceId string) ApiGetWorkspaceDirRequest {
+	return ApiGetWorkspaceDirRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return WorkspaceDirResponse
+func (a *WorkspaceToolboxAPIService) GetWorkspaceDirExecute(r ApiGetWorkspaceDirRequest) (*WorkspaceDirResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *WorkspaceDirResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GetWorkspaceDir")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/workspace-dir"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGitAddFilesRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitAddRequest
+}
+
+// GitAddRequest
+func (r ApiGitAddFilesRequest) Params(params GitAddRequest) ApiGitAddFilesRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitAddFilesRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitAddFilesExecute(r)
+}
+
+/*
+GitAddFiles Add files
+
+Add files to git commit
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitAddFilesRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitAddFiles(ctx context.Context, workspaceId string) ApiGitAddFilesRequest {
+	return ApiGitAddFilesRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+func (a *WorkspaceToolboxAPIService) GitAddFilesExecute(r ApiGitAddFilesRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitAddFiles")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/add"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return nil, reportError("params is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.params
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiGitBranchListRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	path        *string
+}
+
+// Path to git repository
+func (r ApiGitBranchListRequest) Path(path string) ApiGitBranchListRequest {
+	r.path = &path
+	return r
+}
+
+func (r ApiGitBranchListRequest) Execute() (*ListBranchResponse, *http.Response, error) {
+	return r.ApiService.GitBranchListExecute(r)
+}
+
+/*
+GitBranchList Get branch list
+
+Get branch list from git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitBranchListRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitBranchList(ctx context.Context, workspaceId string) ApiGitBranchListRequest {
+	return ApiGitBranchListRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ListBranchResponse
+func (a *WorkspaceToolboxAPIService) GitBranchListExecute(r ApiGitBranchListRequest) (*ListBranchResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ListBranchResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitBranchList")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/branches"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGitCheckoutRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitCheckoutRequest
+}
+
+// GitCheckoutRequest
+func (r ApiGitCheckoutRequest) Params(params GitCheckoutRequest) ApiGitCheckoutRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitCheckoutRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitCheckoutExecute(r)
+}
+
+/*
+GitCheckout Checkout ref
+
+Checkout a branch, tag or commit on git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitCheckoutRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitCheckout(ctx context.Context, workspaceId string) ApiGitCheckoutRequest {
+	return ApiGitCheckoutRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+func (a *WorkspaceToolboxAPIService) GitCheckoutExecute(r ApiGitCheckoutRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCheckout")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/checkout"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return nil, reportError("params is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.params
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiGitCloneRepositoryRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitCloneRequest
+}
+
+// GitCloneRequest
+func (r ApiGitCloneRepositoryRequest) Params(params GitCloneRequest) ApiGitCloneRepositoryRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitCloneRepositoryRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitCloneRepositoryExecute(r)
+}
+
+/*
+GitCloneRepository Clone git repository
+
+Clone git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitCloneRepositoryRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitCloneRepository(ctx context.Context, workspaceId string) ApiGitCloneRepositoryRequest {
+	return ApiGitCloneRepositoryRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+func (a *WorkspaceToolboxAPIService) GitCloneRepositoryExecute(r ApiGitCloneRepositoryRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCloneRepository")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/clone"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return nil, reportError("params is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.params
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiGitCommitChangesRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitCommitRequest
+}
+
+// GitCommitRequest
+func (r ApiGitCommitChangesRequest) Params(params GitCommitRequest) ApiGitCommitChangesRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitCommitChangesRequest) Execute() (*GitCommitResponse, *http.Response, error) {
+	return r.ApiService.GitCommitChangesExecute(r)
+}
+
+/*
+GitCommitChanges Commit changes
+
+Commit changes to git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitCommitChangesRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitCommitChanges(ctx context.Context, workspaceId string) ApiGitCommitChangesRequest {
+	return ApiGitCommitChangesRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return GitCommitResponse
+func (a *WorkspaceToolboxAPIService) GitCommitChangesExecute(r ApiGitCommitChangesRequest) (*GitCommitResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *GitCommitResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCommitChanges")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/commit"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return localVarReturnValue, nil, reportError("params is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.params
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGitCommitHistoryRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	path        *string
+}
+
+// Path to git repository
+func (r ApiGitCommitHistoryRequest) Path(path string) ApiGitCommitHistoryRequest {
+	r.path = &path
+	return r
+}
+
+func (r ApiGitCommitHistoryRequest) Execute() ([]GitCommitInfo, *http.Response, error) {
+	return r.ApiService.GitCommitHistoryExecute(r)
+}
+
+/*
+GitCommitHistory Get commit history
+
+Get commit history from git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitCommitHistoryRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitCommitHistory(ctx context.Context, workspaceId string) ApiGitCommitHistoryRequest {
+	return ApiGitCommitHistoryRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []GitCommitInfo
+func (a *WorkspaceToolboxAPIService) GitCommitHistoryExecute(r ApiGitCommitHistoryRequest) ([]GitCommitInfo, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []GitCommitInfo
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCommitHistory")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/history"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGitCreateBranchRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitBranchRequest
+}
+
+// GitBranchRequest
+func (r ApiGitCreateBranchRequest) Params(params GitBranchRequest) ApiGitCreateBranchRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitCreateBranchRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitCreateBranchExecute(r)
+}
+
+/*
+GitCreateBranch Create branch
+
+Create branch on git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitCreateBranchRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitCreateBranch(ctx context.Context, workspaceId string) ApiGitCreateBranchRequest {
+	return ApiGitCreateBranchRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+func (a *WorkspaceToolboxAPIService) GitCreateBranchExecute(r ApiGitCreateBranchRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCreateBranch")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/branches"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return nil, reportError("params is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.params
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiGitDiffRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	path        *string
+	staged      *bool
+	from        *string
+	to          *string
+	files       *string
+}
+
+// Path to git repository
+func (r ApiGitDiffRequest) Path(path string) ApiGitDiffRequest {
+	r.path = &path
+	return r
+}
+
+// Compare the index instead of the working tree
+func (r ApiGitDiffRequest) Staged(staged bool) ApiGitDiffRequest {
+	r.staged = &staged
+	return r
+}
+
+// Ref to compare with
+func (r ApiGitDiffRequest) From(from string) ApiGitDiffRequest {
+	r.from = &from
+	return r
+}
+
+// Second ref to compare, requires from
+func (r ApiGitDiffRequest) To(to string) ApiGitDiffRequest {
+	r.to = &to
+	return r
+}
+
+// Comma separated list of files to limit the diff to
+func (r ApiGitDiffRequest) Files(files string) ApiGitDiffRequest {
+	r.files = &files
+	return r
+}
+
+func (r ApiGitDiffRequest) Execute() ([]GitFileDiff, *http.Response, error) {
+	return r.ApiService.GitDiffExecute(r)
+}
+
+/*
+GitDiff Get diff
+
+Get diff of the working tree, the index or between refs from git repository inside a workspace
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitDiffRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitDiff(ctx context.Context, workspaceId string) ApiGitDiffRequest {
+	return ApiGitDiffRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []GitFileDiff
+func (a *WorkspaceToolboxAPIService) GitDiffExecute(r ApiGitDiffRequest) ([]GitFileDiff, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []GitFileDiff
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitDiff")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/diff"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	if r.staged != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "staged", r.staged, "")
+	}
+	if r.from != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "from", r.from, "")
+	}
+	if r.to != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "to", r.to, "")
+	}
+	if r.files != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "files", r.files, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
 	if localVarHTTPContentType != "" {
@@ -1778,7 +3525,7 @@ func (a *WorkspaceToolboxAPIService) GetSessionCommandLogsExecute(r ApiGetSessio
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"*/*"}
+	localVarHTTPHeaderAccepts := []string{"application/json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -1836,27 +3583,163 @@ func (a *WorkspaceToolboxAPIService) GetSessionCommandLogsExecute(r ApiGetSessio
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetWorkspaceDirRequest struct {
+type ApiGitGitStatusRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
+	path        *string
 }
 
-func (r ApiGetWorkspaceDirRequest) Execute() (*WorkspaceDirResponse, *http.Response, error) {
-	return r.ApiService.GetWorkspaceDirExecute(r)
+// Path to git repository
+func (r ApiGitGitStatusRequest) Path(path string) ApiGitGitStatusRequest {
+	r.path = &path
+	return r
+}
+
+func (r ApiGitGitStatusRequest) Execute() (*GitStatus, *http.Response, error) {
+	return r.ApiService.GitGitStatusExecute(r)
 }
 
 /*
-GetWorkspaceDir Get workspace dir
+GitGitStatus Get git status
 
-Get workspace directory
+Get status from git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGetWorkspaceDirRequest
+	@return ApiGitGitStatusRequest
+*/
+func (a *WorkspaceToolboxAPIService) GitGitStatus(ctx context.Context, workspaceId string) ApiGitGitStatusRequest {
+	return ApiGitGitStatusRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return GitStatus
+func (a *WorkspaceToolboxAPIService) GitGitStatusExecute(r ApiGitGitStatusRequest) (*GitStatus, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *GitStatus
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitGitStatus")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/status"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.path == nil {
+		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGitMergeRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceToolboxAPIService
+	workspaceId string
+	params      *GitMergeRequest
+}
+
+// GitMergeRequest
+func (r ApiGitMergeRequest) Params(params GitMergeRequest) ApiGitMergeRequest {
+	r.params = &params
+	return r
+}
+
+func (r ApiGitMergeRequest) Execute() (*GitMergeResult, *http.Response, error) {
+	return r.ApiService.GitMergeExecute(r)
+}
+
+/*
+GitMerge Merge branch
+
+Merge a branch into the current branch of git repository inside a workspace. Conflicting files are returned in the response.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@return ApiGitMergeRequest
 */
-func (a *WorkspaceToolboxAPIService) GetWorkspaceDir(ctx context.Context, workspaceId string) ApiGetWorkspaceDirRequest {
-	return ApiGetWorkspaceDirRequest{
+func (a *WorkspaceToolboxAPIService) GitMerge(ctx context.Context, workspaceId string) ApiGitMergeRequest {
+	return ApiGitMergeRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -1865,26 +3748,29 @@ func (a *WorkspaceToolboxAPIService) GetWorkspaceDir(ctx context.Context, worksp
 
 // Execute executes the request
 //
-//	@return WorkspaceDirResponse
-func (a *WorkspaceToolboxAPIService) GetWorkspaceDirExecute(r ApiGetWorkspaceDirRequest) (*WorkspaceDirResponse, *http.Response, error) {
+//	@return GitMergeResult
+func (a *WorkspaceToolboxAPIService) GitMergeExecute(r ApiGitMergeRequest) (*GitMergeResult, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *WorkspaceDirResponse
+		localVarReturnValue *GitMergeResult
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GetWorkspaceDir")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitMerge")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/workspace-dir"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/merge"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.params == nil {
+		return localVarReturnValue, nil, reportError("params is required and must be specified")
+	}
 
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -1903,6 +3789,8 @@ func (a *WorkspaceToolboxAPIService) GetWorkspaceDirExecute(r ApiGetWorkspaceDir
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.params
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -1954,34 +3842,34 @@ func (a *WorkspaceToolboxAPIService) GetWorkspaceDirExecute(r ApiGetWorkspaceDir
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGitAddFilesRequest struct {
+type ApiGitMergeAbortRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitAddRequest
+	params      *GitPathRequest
 }
 
-// GitAddRequest
-func (r ApiGitAddFilesRequest) Params(params GitAddRequest) ApiGitAddFilesRequest {
+// GitPathRequest
+func (r ApiGitMergeAbortRequest) Params(params GitPathRequest) ApiGitMergeAbortRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitAddFilesRequest) Execute() (*http.Response, error) {
-	return r.ApiService.GitAddFilesExecute(r)
+func (r ApiGitMergeAbortRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitMergeAbortExecute(r)
 }
 
 /*
-GitAddFiles Add files
+GitMergeAbort Abort merge
 
-Add files to git commit
+Abort the merge in progress on git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitAddFilesRequest
+	@return ApiGitMergeAbortRequest
 */
-func (a *WorkspaceToolboxAPIService) GitAddFiles(ctx context.Context, workspaceId string) ApiGitAddFilesRequest {
-	return ApiGitAddFilesRequest{
+func (a *WorkspaceToolboxAPIService) GitMergeAbort(ctx context.Context, workspaceId string) ApiGitMergeAbortRequest {
+	return ApiGitMergeAbortRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -1989,19 +3877,19 @@ func (a *WorkspaceToolboxAPIService) GitAddFiles(ctx context.Context, workspaceI
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) GitAddFilesExecute(r ApiGitAddFilesRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitMergeAbortExecute(r ApiGitMergeAbortRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitAddFiles")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitMergeAbort")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/add"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/merge/abort"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2072,34 +3960,34 @@ func (a *WorkspaceToolboxAPIService) GitAddFilesExecute(r ApiGitAddFilesRequest)
 	return localVarHTTPResponse, nil
 }
 
-type ApiGitBranchListRequest struct {
+type ApiGitPullChangesRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	path        *string
+	params      *GitRepoRequest
 }
 
-// Path to git repository
-func (r ApiGitBranchListRequest) Path(path string) ApiGitBranchListRequest {
-	r.path = &path
+// Git pull request
+func (r ApiGitPullChangesRequest) Params(params GitRepoRequest) ApiGitPullChangesRequest {
+	r.params = &params
 	return r
 }
 
-func (r ApiGitBranchListRequest) Execute() (*ListBranchResponse, *http.Response, error) {
-	return r.ApiService.GitBranchListExecute(r)
+func (r ApiGitPullChangesRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitPullChangesExecute(r)
 }
 
 /*
-GitBranchList Get branch list
+GitPullChanges Pull changes
 
-Get branch list from git repository inside a workspace
+Pull changes from remote to git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitBranchListRequest
+	@return ApiGitPullChangesRequest
 */
-func (a *WorkspaceToolboxAPIService) GitBranchList(ctx context.Context, workspaceId string) ApiGitBranchListRequest {
-	return ApiGitBranchListRequest{
+func (a *WorkspaceToolboxAPIService) GitPullChanges(ctx context.Context, workspaceId string) ApiGitPullChangesRequest {
+	return ApiGitPullChangesRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2107,32 +3995,28 @@ func (a *WorkspaceToolboxAPIService) GitBranchList(ctx context.Context, workspac
 }
 
 // Execute executes the request
-//
-//	@return ListBranchResponse
-func (a *WorkspaceToolboxAPIService) GitBranchListExecute(r ApiGitBranchListRequest) (*ListBranchResponse, *http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitPullChangesExecute(r ApiGitPullChangesRequest) (*http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
-		localVarPostBody    interface{}
-		formFiles           []formFile
-		localVarReturnValue *ListBranchResponse
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitBranchList")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitPullChanges")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/branches"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/pull"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.path == nil {
-		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	if r.params == nil {
+		return nil, reportError("params is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -2143,13 +4027,15 @@ func (a *WorkspaceToolboxAPIService) GitBranchListExecute(r ApiGitBranchListRequ
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.params
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -2166,19 +4052,19 @@ func (a *WorkspaceToolboxAPIService) GitBranchListExecute(r ApiGitBranchListRequ
 	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
-		return localVarReturnValue, nil, err
+		return nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarReturnValue, localVarHTTPResponse, err
+		return localVarHTTPResponse, err
 	}
 
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarReturnValue, localVarHTTPResponse, err
+		return localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -2186,49 +4072,40 @@ func (a *WorkspaceToolboxAPIService) GitBranchListExecute(r ApiGitBranchListRequ
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		return localVarReturnValue, localVarHTTPResponse, newErr
-	}
-
-	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-	if err != nil {
-		newErr := &GenericOpenAPIError{
-			body:  localVarBody,
-			error: err.Error(),
-		}
-		return localVarReturnValue, localVarHTTPResponse, newErr
+		return localVarHTTPResponse, newErr
 	}
 
-	return localVarReturnValue, localVarHTTPResponse, nil
+	return localVarHTTPResponse, nil
 }
 
-type ApiGitCloneRepositoryRequest struct {
+type ApiGitPushChangesRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitCloneRequest
+	params      *GitRepoRequest
 }
 
-// GitCloneRequest
-func (r ApiGitCloneRepositoryRequest) Params(params GitCloneRequest) ApiGitCloneRepositoryRequest {
+// Git push request
+func (r ApiGitPushChangesRequest) Params(params GitRepoRequest) ApiGitPushChangesRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitCloneRepositoryRequest) Execute() (*http.Response, error) {
-	return r.ApiService.GitCloneRepositoryExecute(r)
+func (r ApiGitPushChangesRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitPushChangesExecute(r)
 }
 
 /*
-GitCloneRepository Clone git repository
+GitPushChanges Push changes
 
-Clone git repository inside a workspace
+Push changes to remote from git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitCloneRepositoryRequest
+	@return ApiGitPushChangesRequest
 */
-func (a *WorkspaceToolboxAPIService) GitCloneRepository(ctx context.Context, workspaceId string) ApiGitCloneRepositoryRequest {
-	return ApiGitCloneRepositoryRequest{
+func (a *WorkspaceToolboxAPIService) GitPushChanges(ctx context.Context, workspaceId string) ApiGitPushChangesRequest {
+	return ApiGitPushChangesRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2236,19 +4113,19 @@ func (a *WorkspaceToolboxAPIService) GitCloneRepository(ctx context.Context, wor
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) GitCloneRepositoryExecute(r ApiGitCloneRepositoryRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitPushChangesExecute(r ApiGitPushChangesRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCloneRepository")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitPushChanges")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/clone"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/push"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2319,34 +4196,34 @@ func (a *WorkspaceToolboxAPIService) GitCloneRepositoryExecute(r ApiGitCloneRepo
 	return localVarHTTPResponse, nil
 }
 
-type ApiGitCommitChangesRequest struct {
+type ApiGitRebaseAbortRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitCommitRequest
+	params      *GitPathRequest
 }
 
-// GitCommitRequest
-func (r ApiGitCommitChangesRequest) Params(params GitCommitRequest) ApiGitCommitChangesRequest {
+// GitPathRequest
+func (r ApiGitRebaseAbortRequest) Params(params GitPathRequest) ApiGitRebaseAbortRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitCommitChangesRequest) Execute() (*GitCommitResponse, *http.Response, error) {
-	return r.ApiService.GitCommitChangesExecute(r)
+func (r ApiGitRebaseAbortRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitRebaseAbortExecute(r)
 }
 
 /*
-GitCommitChanges Commit changes
+GitRebaseAbort Abort rebase
 
-Commit changes to git repository inside a workspace
+Abort the rebase in progress on git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitCommitChangesRequest
+	@return ApiGitRebaseAbortRequest
 */
-func (a *WorkspaceToolboxAPIService) GitCommitChanges(ctx context.Context, workspaceId string) ApiGitCommitChangesRequest {
-	return ApiGitCommitChangesRequest{
+func (a *WorkspaceToolboxAPIService) GitRebaseAbort(ctx context.Context, workspaceId string) ApiGitRebaseAbortRequest {
+	return ApiGitRebaseAbortRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2354,29 +4231,26 @@ func (a *WorkspaceToolboxAPIService) GitCommitChanges(ctx context.Context, works
 }
 
 // Execute executes the request
-//
-//	@return GitCommitResponse
-func (a *WorkspaceToolboxAPIService) GitCommitChangesExecute(r ApiGitCommitChangesRequest) (*GitCommitResponse, *http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitRebaseAbortExecute(r ApiGitRebaseAbortRequest) (*http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
-		localVarPostBody    interface{}
-		formFiles           []formFile
-		localVarReturnValue *GitCommitResponse
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCommitChanges")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitRebaseAbort")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/commit"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/rebase/abort"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 	if r.params == nil {
-		return localVarReturnValue, nil, reportError("params is required and must be specified")
+		return nil, reportError("params is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -2389,7 +4263,7 @@ func (a *WorkspaceToolboxAPIService) GitCommitChangesExecute(r ApiGitCommitChang
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -2414,19 +4288,19 @@ func (a *WorkspaceToolboxAPIService) GitCommitChangesExecute(r ApiGitCommitChang
 	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
-		return localVarReturnValue, nil, err
+		return nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarReturnValue, localVarHTTPResponse, err
+		return localVarHTTPResponse, err
 	}
 
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarReturnValue, localVarHTTPResponse, err
+		return localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -2434,49 +4308,40 @@ func (a *WorkspaceToolboxAPIService) GitCommitChangesExecute(r ApiGitCommitChang
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		return localVarReturnValue, localVarHTTPResponse, newErr
-	}
-
-	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-	if err != nil {
-		newErr := &GenericOpenAPIError{
-			body:  localVarBody,
-			error: err.Error(),
-		}
-		return localVarReturnValue, localVarHTTPResponse, newErr
+		return localVarHTTPResponse, newErr
 	}
 
-	return localVarReturnValue, localVarHTTPResponse, nil
+	return localVarHTTPResponse, nil
 }
 
-type ApiGitCommitHistoryRequest struct {
+type ApiGitRebaseContinueRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	path        *string
+	params      *GitPathRequest
 }
 
-// Path to git repository
-func (r ApiGitCommitHistoryRequest) Path(path string) ApiGitCommitHistoryRequest {
-	r.path = &path
+// GitPathRequest
+func (r ApiGitRebaseContinueRequest) Params(params GitPathRequest) ApiGitRebaseContinueRequest {
+	r.params = &params
 	return r
 }
 
-func (r ApiGitCommitHistoryRequest) Execute() ([]GitCommitInfo, *http.Response, error) {
-	return r.ApiService.GitCommitHistoryExecute(r)
+func (r ApiGitRebaseContinueRequest) Execute() (*GitMergeResult, *http.Response, error) {
+	return r.ApiService.GitRebaseContinueExecute(r)
 }
 
 /*
-GitCommitHistory Get commit history
+GitRebaseContinue Continue rebase
 
-Get commit history from git repository inside a workspace
+Continue the rebase in progress on git repository inside a workspace. Conflicting files are returned in the response.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitCommitHistoryRequest
+	@return ApiGitRebaseContinueRequest
 */
-func (a *WorkspaceToolboxAPIService) GitCommitHistory(ctx context.Context, workspaceId string) ApiGitCommitHistoryRequest {
-	return ApiGitCommitHistoryRequest{
+func (a *WorkspaceToolboxAPIService) GitRebaseContinue(ctx context.Context, workspaceId string) ApiGitRebaseContinueRequest {
+	return ApiGitRebaseContinueRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2485,31 +4350,30 @@ func (a *WorkspaceToolboxAPIService) GitCommitHistory(ctx context.Context, works
 
 // Execute executes the request
 //
-//	@return []GitCommitInfo
-func (a *WorkspaceToolboxAPIService) GitCommitHistoryExecute(r ApiGitCommitHistoryRequest) ([]GitCommitInfo, *http.Response, error) {
+//	@return GitMergeResult
+func (a *WorkspaceToolboxAPIService) GitRebaseContinueExecute(r ApiGitRebaseContinueRequest) (*GitMergeResult, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitCommitInfo
+		localVarReturnValue *GitMergeResult
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCommitHistory")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitRebaseContinue")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/history"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/rebase/continue"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.path == nil {
-		return localVarReturnValue, nil, reportError("path is required and must be specified")
+	if r.params == nil {
+		return localVarReturnValue, nil, reportError("params is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "path", r.path, "")
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -2527,6 +4391,8 @@ func (a *WorkspaceToolboxAPIService) GitCommitHistoryExecute(r ApiGitCommitHisto
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.params
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -2578,34 +4444,34 @@ func (a *WorkspaceToolboxAPIService) GitCommitHistoryExecute(r ApiGitCommitHisto
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGitCreateBranchRequest struct {
+type ApiGitResetRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitBranchRequest
+	params      *GitResetRequest
 }
 
-// GitBranchRequest
-func (r ApiGitCreateBranchRequest) Params(params GitBranchRequest) ApiGitCreateBranchRequest {
+// GitResetRequest
+func (r ApiGitResetRequest) Params(params GitResetRequest) ApiGitResetRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitCreateBranchRequest) Execute() (*http.Response, error) {
-	return r.ApiService.GitCreateBranchExecute(r)
+func (r ApiGitResetRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitResetExecute(r)
 }
 
 /*
-GitCreateBranch Create branch
+GitReset Reset
 
-Create branch on git repository inside a workspace
+Reset current branch of git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitCreateBranchRequest
+	@return ApiGitResetRequest
 */
-func (a *WorkspaceToolboxAPIService) GitCreateBranch(ctx context.Context, workspaceId string) ApiGitCreateBranchRequest {
-	return ApiGitCreateBranchRequest{
+func (a *WorkspaceToolboxAPIService) GitReset(ctx context.Context, workspaceId string) ApiGitResetRequest {
+	return ApiGitResetRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2613,19 +4479,19 @@ func (a *WorkspaceToolboxAPIService) GitCreateBranch(ctx context.Context, worksp
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) GitCreateBranchExecute(r ApiGitCreateBranchRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitResetExecute(r ApiGitResetRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitCreateBranch")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitReset")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/branches"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/reset"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2696,7 +4562,7 @@ func (a *WorkspaceToolboxAPIService) GitCreateBranchExecute(r ApiGitCreateBranch
 	return localVarHTTPResponse, nil
 }
 
-type ApiGitGitStatusRequest struct {
+type ApiGitStashListRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
@@ -2704,26 +4570,26 @@ type ApiGitGitStatusRequest struct {
 }
 
 // Path to git repository
-func (r ApiGitGitStatusRequest) Path(path string) ApiGitGitStatusRequest {
+func (r ApiGitStashListRequest) Path(path string) ApiGitStashListRequest {
 	r.path = &path
 	return r
 }
 
-func (r ApiGitGitStatusRequest) Execute() (*GitStatus, *http.Response, error) {
-	return r.ApiService.GitGitStatusExecute(r)
+func (r ApiGitStashListRequest) Execute() ([]GitStashEntry, *http.Response, error) {
+	return r.ApiService.GitStashListExecute(r)
 }
 
 /*
-GitGitStatus Get git status
+GitStashList Get stash list
 
-Get status from git repository inside a workspace
+Get stash entries from git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitGitStatusRequest
+	@return ApiGitStashListRequest
 */
-func (a *WorkspaceToolboxAPIService) GitGitStatus(ctx context.Context, workspaceId string) ApiGitGitStatusRequest {
-	return ApiGitGitStatusRequest{
+func (a *WorkspaceToolboxAPIService) GitStashList(ctx context.Context, workspaceId string) ApiGitStashListRequest {
+	return ApiGitStashListRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2732,21 +4598,21 @@ func (a *WorkspaceToolboxAPIService) GitGitStatus(ctx context.Context, workspace
 
 // Execute executes the request
 //
-//	@return GitStatus
-func (a *WorkspaceToolboxAPIService) GitGitStatusExecute(r ApiGitGitStatusRequest) (*GitStatus, *http.Response, error) {
+//	@return []GitStashEntry
+func (a *WorkspaceToolboxAPIService) GitStashListExecute(r ApiGitStashListRequest) ([]GitStashEntry, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *GitStatus
+		localVarReturnValue []GitStashEntry
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitGitStatus")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitStashList")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/status"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/stash"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2825,34 +4691,34 @@ func (a *WorkspaceToolboxAPIService) GitGitStatusExecute(r ApiGitGitStatusReques
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGitPullChangesRequest struct {
+type ApiGitStashPopRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitRepoRequest
+	params      *GitStashPopRequest
 }
 
-// Git pull request
-func (r ApiGitPullChangesRequest) Params(params GitRepoRequest) ApiGitPullChangesRequest {
+// GitStashPopRequest
+func (r ApiGitStashPopRequest) Params(params GitStashPopRequest) ApiGitStashPopRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitPullChangesRequest) Execute() (*http.Response, error) {
-	return r.ApiService.GitPullChangesExecute(r)
+func (r ApiGitStashPopRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitStashPopExecute(r)
 }
 
 /*
-GitPullChanges Pull changes
+GitStashPop Pop stash
 
-Pull changes from remote to git repository inside a workspace
+Apply and remove a stash entry on git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitPullChangesRequest
+	@return ApiGitStashPopRequest
 */
-func (a *WorkspaceToolboxAPIService) GitPullChanges(ctx context.Context, workspaceId string) ApiGitPullChangesRequest {
-	return ApiGitPullChangesRequest{
+func (a *WorkspaceToolboxAPIService) GitStashPop(ctx context.Context, workspaceId string) ApiGitStashPopRequest {
+	return ApiGitStashPopRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2860,19 +4726,19 @@ func (a *WorkspaceToolboxAPIService) GitPullChanges(ctx context.Context, workspa
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) GitPullChangesExecute(r ApiGitPullChangesRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitStashPopExecute(r ApiGitStashPopRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitPullChanges")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitStashPop")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/pull"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/stash/pop"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2943,34 +4809,34 @@ func (a *WorkspaceToolboxAPIService) GitPullChangesExecute(r ApiGitPullChangesRe
 	return localVarHTTPResponse, nil
 }
 
-type ApiGitPushChangesRequest struct {
+type ApiGitStashPushRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceToolboxAPIService
 	workspaceId string
-	params      *GitRepoRequest
+	params      *GitStashRequest
 }
 
-// Git push request
-func (r ApiGitPushChangesRequest) Params(params GitRepoRequest) ApiGitPushChangesRequest {
+// GitStashRequest
+func (r ApiGitStashPushRequest) Params(params GitStashRequest) ApiGitStashPushRequest {
 	r.params = &params
 	return r
 }
 
-func (r ApiGitPushChangesRequest) Execute() (*http.Response, error) {
-	return r.ApiService.GitPushChangesExecute(r)
+func (r ApiGitStashPushRequest) Execute() (*http.Response, error) {
+	return r.ApiService.GitStashPushExecute(r)
 }
 
 /*
-GitPushChanges Push changes
+GitStashPush Stash changes
 
-Push changes to remote from git repository inside a workspace
+Stash changes on git repository inside a workspace
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param workspaceId Workspace ID or Name
-	@return ApiGitPushChangesRequest
+	@return ApiGitStashPushRequest
 */
-func (a *WorkspaceToolboxAPIService) GitPushChanges(ctx context.Context, workspaceId string) ApiGitPushChangesRequest {
-	return ApiGitPushChangesRequest{
+func (a *WorkspaceToolboxAPIService) GitStashPush(ctx context.Context, workspaceId string) ApiGitStashPushRequest {
+	return ApiGitStashPushRequest{
 		ApiService:  a,
 		ctx:         ctx,
 		workspaceId: workspaceId,
@@ -2978,19 +4844,19 @@ func (a *WorkspaceToolboxAPIService) GitPushChanges(ctx context.Context, workspa
 }
 
 // Execute executes the request
-func (a *WorkspaceToolboxAPIService) GitPushChangesExecute(r ApiGitPushChangesRequest) (*http.Response, error) {
+func (a *WorkspaceToolboxAPIService) GitStashPushExecute(r ApiGitStashPushRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitPushChanges")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceToolboxAPIService.GitStashPush")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/push"
+	localVarPath := localBasePath + "/workspace/{workspaceId}/toolbox/git/stash"
 	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
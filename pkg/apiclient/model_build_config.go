@@ -19,8 +19,11 @@ var _ MappedNullable = &BuildConfig{}
 
 // BuildConfig struct for BuildConfig
 type BuildConfig struct {
+	Buildpacks   *BuildpacksConfig   `json:"buildpacks,omitempty"`
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty"`
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty"`
+	Nix          *NixConfig          `json:"nix,omitempty"`
 }
 
 // NewBuildConfig instantiates a new BuildConfig object
@@ -40,6 +43,38 @@ func NewBuildConfigWithDefaults() *BuildConfig {
 	return &this
 }
 
+// GetBuildpacks returns the Buildpacks field value if set, zero value otherwise.
+func (o *BuildConfig) GetBuildpacks() BuildpacksConfig {
+	if o == nil || IsNil(o.Buildpacks) {
+		var ret BuildpacksConfig
+		return ret
+	}
+	return *o.Buildpacks
+}
+
+// GetBuildpacksOk returns a tuple with the Buildpacks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildConfig) GetBuildpacksOk() (*BuildpacksConfig, bool) {
+	if o == nil || IsNil(o.Buildpacks) {
+		return nil, false
+	}
+	return o.Buildpacks, true
+}
+
+// HasBuildpacks returns a boolean if a field has been set.
+func (o *BuildConfig) HasBuildpacks() bool {
+	if o != nil && !IsNil(o.Buildpacks) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildpacks gets a reference to the given BuildpacksConfig and assigns it to the Buildpacks field.
+func (o *BuildConfig) SetBuildpacks(v BuildpacksConfig) {
+	o.Buildpacks = &v
+}
+
 // GetCachedBuild returns the CachedBuild field value if set, zero value otherwise.
 func (o *BuildConfig) GetCachedBuild() CachedBuild {
 	if o == nil || IsNil(o.CachedBuild) {
@@ -104,6 +139,70 @@ func (o *BuildConfig) SetDevcontainer(v DevcontainerConfig) {
 	o.Devcontainer = &v
 }
 
+// GetDockerfile returns the Dockerfile field value if set, zero value otherwise.
+func (o *BuildConfig) GetDockerfile() DockerfileConfig {
+	if o == nil || IsNil(o.Dockerfile) {
+		var ret DockerfileConfig
+		return ret
+	}
+	return *o.Dockerfile
+}
+
+// GetDockerfileOk returns a tuple with the Dockerfile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildConfig) GetDockerfileOk() (*DockerfileConfig, bool) {
+	if o == nil || IsNil(o.Dockerfile) {
+		return nil, false
+	}
+	return o.Dockerfile, true
+}
+
+// HasDockerfile returns a boolean if a field has been set.
+func (o *BuildConfig) HasDockerfile() bool {
+	if o != nil && !IsNil(o.Dockerfile) {
+		return true
+	}
+
+	return false
+}
+
+// SetDockerfile gets a reference to the given DockerfileConfig and assigns it to the Dockerfile field.
+func (o *BuildConfig) SetDockerfile(v DockerfileConfig) {
+	o.Dockerfile = &v
+}
+
+// GetNix returns the Nix field value if set, zero value otherwise.
+func (o *BuildConfig) GetNix() NixConfig {
+	if o == nil || IsNil(o.Nix) {
+		var ret NixConfig
+		return ret
+	}
+	return *o.Nix
+}
+
+// GetNixOk returns a tuple with the Nix field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildConfig) GetNixOk() (*NixConfig, bool) {
+	if o == nil || IsNil(o.Nix) {
+		return nil, false
+	}
+	return o.Nix, true
+}
+
+// HasNix returns a boolean if a field has been set.
+func (o *BuildConfig) HasNix() bool {
+	if o != nil && !IsNil(o.Nix) {
+		return true
+	}
+
+	return false
+}
+
+// SetNix gets a reference to the given NixConfig and assigns it to the Nix field.
+func (o *BuildConfig) SetNix(v NixConfig) {
+	o.Nix = &v
+}
+
 func (o BuildConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -114,12 +213,21 @@ func (o BuildConfig) MarshalJSON() ([]byte, error) {
 
 func (o BuildConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Buildpacks) {
+		toSerialize["buildpacks"] = o.Buildpacks
+	}
 	if !IsNil(o.CachedBuild) {
 		toSerialize["cachedBuild"] = o.CachedBuild
 	}
 	if !IsNil(o.Devcontainer) {
 		toSerialize["devcontainer"] = o.Devcontainer
 	}
+	if !IsNil(o.Dockerfile) {
+		toSerialize["dockerfile"] = o.Dockerfile
+	}
+	if !IsNil(o.Nix) {
+		toSerialize["nix"] = o.Nix
+	}
 	return toSerialize, nil
 }
 
@@ -22,6 +22,7 @@ var _ MappedNullable = &BuildDTO{}
 // BuildDTO struct for BuildDTO
 type BuildDTO struct {
 	BuildConfig     *BuildConfig      `json:"buildConfig,omitempty"`
+	CacheStats      *BuildCacheStats  `json:"cacheStats,omitempty"`
 	ContainerConfig ContainerConfig   `json:"containerConfig"`
 	CreatedAt       string            `json:"createdAt"`
 	EnvVars         map[string]string `json:"envVars"`
@@ -94,6 +95,38 @@ func (o *BuildDTO) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetCacheStats returns the CacheStats field value if set, zero value otherwise.
+func (o *BuildDTO) GetCacheStats() BuildCacheStats {
+	if o == nil || IsNil(o.CacheStats) {
+		var ret BuildCacheStats
+		return ret
+	}
+	return *o.CacheStats
+}
+
+// GetCacheStatsOk returns a tuple with the CacheStats field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildDTO) GetCacheStatsOk() (*BuildCacheStats, bool) {
+	if o == nil || IsNil(o.CacheStats) {
+		return nil, false
+	}
+	return o.CacheStats, true
+}
+
+// HasCacheStats returns a boolean if a field has been set.
+func (o *BuildDTO) HasCacheStats() bool {
+	if o != nil && !IsNil(o.CacheStats) {
+		return true
+	}
+
+	return false
+}
+
+// SetCacheStats gets a reference to the given BuildCacheStats and assigns it to the CacheStats field.
+func (o *BuildDTO) SetCacheStats(v BuildCacheStats) {
+	o.CacheStats = &v
+}
+
 // GetContainerConfig returns the ContainerConfig field value
 func (o *BuildDTO) GetContainerConfig() ContainerConfig {
 	if o == nil {
@@ -435,6 +468,9 @@ func (o BuildDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.CacheStats) {
+		toSerialize["cacheStats"] = o.CacheStats
+	}
 	toSerialize["containerConfig"] = o.ContainerConfig
 	toSerialize["createdAt"] = o.CreatedAt
 	toSerialize["envVars"] = o.EnvVars
@@ -22,12 +22,14 @@ type CloneTarget string
 const (
 	CloneTargetBranch CloneTarget = "branch"
 	CloneTargetCommit CloneTarget = "commit"
+	CloneTargetTag    CloneTarget = "tag"
 )
 
 // All allowed values of CloneTarget enum
 var AllowedCloneTargetEnumValues = []CloneTarget{
 	"branch",
 	"commit",
+	"tag",
 }
 
 func (v *CloneTarget) UnmarshalJSON(src []byte) error {
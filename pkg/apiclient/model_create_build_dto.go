@@ -23,7 +23,9 @@ var _ MappedNullable = &CreateBuildDTO{}
 type CreateBuildDTO struct {
 	Branch                string            `json:"branch"`
 	EnvVars               map[string]string `json:"envVars"`
+	PrNumber              *int32            `json:"prNumber,omitempty"`
 	PrebuildId            *string           `json:"prebuildId,omitempty"`
+	Tag                   *string           `json:"tag,omitempty"`
 	WorkspaceTemplateName string            `json:"workspaceTemplateName"`
 }
 
@@ -97,6 +99,38 @@ func (o *CreateBuildDTO) SetEnvVars(v map[string]string) {
 	o.EnvVars = v
 }
 
+// GetPrNumber returns the PrNumber field value if set, zero value otherwise.
+func (o *CreateBuildDTO) GetPrNumber() int32 {
+	if o == nil || IsNil(o.PrNumber) {
+		var ret int32
+		return ret
+	}
+	return *o.PrNumber
+}
+
+// GetPrNumberOk returns a tuple with the PrNumber field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateBuildDTO) GetPrNumberOk() (*int32, bool) {
+	if o == nil || IsNil(o.PrNumber) {
+		return nil, false
+	}
+	return o.PrNumber, true
+}
+
+// HasPrNumber returns a boolean if a field has been set.
+func (o *CreateBuildDTO) HasPrNumber() bool {
+	if o != nil && !IsNil(o.PrNumber) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrNumber gets a reference to the given int32 and assigns it to the PrNumber field.
+func (o *CreateBuildDTO) SetPrNumber(v int32) {
+	o.PrNumber = &v
+}
+
 // GetPrebuildId returns the PrebuildId field value if set, zero value otherwise.
 func (o *CreateBuildDTO) GetPrebuildId() string {
 	if o == nil || IsNil(o.PrebuildId) {
@@ -129,6 +163,38 @@ func (o *CreateBuildDTO) SetPrebuildId(v string) {
 	o.PrebuildId = &v
 }
 
+// GetTag returns the Tag field value if set, zero value otherwise.
+func (o *CreateBuildDTO) GetTag() string {
+	if o == nil || IsNil(o.Tag) {
+		var ret string
+		return ret
+	}
+	return *o.Tag
+}
+
+// GetTagOk returns a tuple with the Tag field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateBuildDTO) GetTagOk() (*string, bool) {
+	if o == nil || IsNil(o.Tag) {
+		return nil, false
+	}
+	return o.Tag, true
+}
+
+// HasTag returns a boolean if a field has been set.
+func (o *CreateBuildDTO) HasTag() bool {
+	if o != nil && !IsNil(o.Tag) {
+		return true
+	}
+
+	return false
+}
+
+// SetTag gets a reference to the given string and assigns it to the Tag field.
+func (o *CreateBuildDTO) SetTag(v string) {
+	o.Tag = &v
+}
+
 // GetWorkspaceTemplateName returns the WorkspaceTemplateName field value
 func (o *CreateBuildDTO) GetWorkspaceTemplateName() string {
 	if o == nil {
@@ -165,9 +231,15 @@ func (o CreateBuildDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["branch"] = o.Branch
 	toSerialize["envVars"] = o.EnvVars
+	if !IsNil(o.PrNumber) {
+		toSerialize["prNumber"] = o.PrNumber
+	}
 	if !IsNil(o.PrebuildId) {
 		toSerialize["prebuildId"] = o.PrebuildId
 	}
+	if !IsNil(o.Tag) {
+		toSerialize["tag"] = o.Tag
+	}
 	toSerialize["workspaceTemplateName"] = o.WorkspaceTemplateName
 	return toSerialize, nil
 }
@@ -21,7 +21,8 @@ var _ MappedNullable = &CreateWorkspaceSourceDTO{}
 
 // CreateWorkspaceSourceDTO struct for CreateWorkspaceSourceDTO
 type CreateWorkspaceSourceDTO struct {
-	Repository GitRepository `json:"repository"`
+	AdditionalRepositories []WorkspaceRepository `json:"additionalRepositories,omitempty"`
+	Repository             GitRepository         `json:"repository"`
 }
 
 type _CreateWorkspaceSourceDTO CreateWorkspaceSourceDTO
@@ -44,6 +45,38 @@ func NewCreateWorkspaceSourceDTOWithDefaults() *CreateWorkspaceSourceDTO {
 	return &this
 }
 
+// GetAdditionalRepositories returns the AdditionalRepositories field value if set, zero value otherwise.
+func (o *CreateWorkspaceSourceDTO) GetAdditionalRepositories() []WorkspaceRepository {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		var ret []WorkspaceRepository
+		return ret
+	}
+	return o.AdditionalRepositories
+}
+
+// GetAdditionalRepositoriesOk returns a tuple with the AdditionalRepositories field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceSourceDTO) GetAdditionalRepositoriesOk() ([]WorkspaceRepository, bool) {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		return nil, false
+	}
+	return o.AdditionalRepositories, true
+}
+
+// HasAdditionalRepositories returns a boolean if a field has been set.
+func (o *CreateWorkspaceSourceDTO) HasAdditionalRepositories() bool {
+	if o != nil && !IsNil(o.AdditionalRepositories) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdditionalRepositories gets a reference to the given []WorkspaceRepository and assigns it to the AdditionalRepositories field.
+func (o *CreateWorkspaceSourceDTO) SetAdditionalRepositories(v []WorkspaceRepository) {
+	o.AdditionalRepositories = v
+}
+
 // GetRepository returns the Repository field value
 func (o *CreateWorkspaceSourceDTO) GetRepository() GitRepository {
 	if o == nil {
@@ -78,6 +111,9 @@ func (o CreateWorkspaceSourceDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateWorkspaceSourceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AdditionalRepositories) {
+		toSerialize["additionalRepositories"] = o.AdditionalRepositories
+	}
 	toSerialize["repository"] = o.Repository
 	return toSerialize, nil
 }
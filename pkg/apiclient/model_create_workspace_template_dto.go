@@ -25,8 +25,10 @@ type CreateWorkspaceTemplateDTO struct {
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               *string           `json:"image,omitempty"`
+	Lfs                 *GitLfsConfig     `json:"lfs,omitempty"`
 	Name                string            `json:"name"`
 	RepositoryUrl       string            `json:"repositoryUrl"`
+	SparsePaths         []string          `json:"sparsePaths,omitempty"`
 	User                *string           `json:"user,omitempty"`
 }
 
@@ -172,6 +174,38 @@ func (o *CreateWorkspaceTemplateDTO) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetLfs returns the Lfs field value if set, zero value otherwise.
+func (o *CreateWorkspaceTemplateDTO) GetLfs() GitLfsConfig {
+	if o == nil || IsNil(o.Lfs) {
+		var ret GitLfsConfig
+		return ret
+	}
+	return *o.Lfs
+}
+
+// GetLfsOk returns a tuple with the Lfs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceTemplateDTO) GetLfsOk() (*GitLfsConfig, bool) {
+	if o == nil || IsNil(o.Lfs) {
+		return nil, false
+	}
+	return o.Lfs, true
+}
+
+// HasLfs returns a boolean if a field has been set.
+func (o *CreateWorkspaceTemplateDTO) HasLfs() bool {
+	if o != nil && !IsNil(o.Lfs) {
+		return true
+	}
+
+	return false
+}
+
+// SetLfs gets a reference to the given GitLfsConfig and assigns it to the Lfs field.
+func (o *CreateWorkspaceTemplateDTO) SetLfs(v GitLfsConfig) {
+	o.Lfs = &v
+}
+
 // GetName returns the Name field value
 func (o *CreateWorkspaceTemplateDTO) GetName() string {
 	if o == nil {
@@ -220,6 +254,38 @@ func (o *CreateWorkspaceTemplateDTO) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetSparsePaths returns the SparsePaths field value if set, zero value otherwise.
+func (o *CreateWorkspaceTemplateDTO) GetSparsePaths() []string {
+	if o == nil || IsNil(o.SparsePaths) {
+		var ret []string
+		return ret
+	}
+	return o.SparsePaths
+}
+
+// GetSparsePathsOk returns a tuple with the SparsePaths field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceTemplateDTO) GetSparsePathsOk() ([]string, bool) {
+	if o == nil || IsNil(o.SparsePaths) {
+		return nil, false
+	}
+	return o.SparsePaths, true
+}
+
+// HasSparsePaths returns a boolean if a field has been set.
+func (o *CreateWorkspaceTemplateDTO) HasSparsePaths() bool {
+	if o != nil && !IsNil(o.SparsePaths) {
+		return true
+	}
+
+	return false
+}
+
+// SetSparsePaths gets a reference to the given []string and assigns it to the SparsePaths field.
+func (o *CreateWorkspaceTemplateDTO) SetSparsePaths(v []string) {
+	o.SparsePaths = v
+}
+
 // GetUser returns the User field value if set, zero value otherwise.
 func (o *CreateWorkspaceTemplateDTO) GetUser() string {
 	if o == nil || IsNil(o.User) {
@@ -272,8 +338,14 @@ func (o CreateWorkspaceTemplateDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.Lfs) {
+		toSerialize["lfs"] = o.Lfs
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.SparsePaths) {
+		toSerialize["sparsePaths"] = o.SparsePaths
+	}
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
 	}
@@ -21,14 +21,19 @@ var _ MappedNullable = &GitProvider{}
 
 // GitProvider struct for GitProvider
 type GitProvider struct {
-	Alias         string         `json:"alias"`
-	BaseApiUrl    *string        `json:"baseApiUrl,omitempty"`
-	Id            string         `json:"id"`
-	ProviderId    string         `json:"providerId"`
-	SigningKey    *string        `json:"signingKey,omitempty"`
-	SigningMethod *SigningMethod `json:"signingMethod,omitempty"`
-	Token         string         `json:"token"`
-	Username      string         `json:"username"`
+	Alias      string  `json:"alias"`
+	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id         string  `json:"id"`
+	// Set if the token was obtained with the OAuth device flow
+	OauthClientId  *string        `json:"oauthClientId,omitempty"`
+	ProviderId     string         `json:"providerId"`
+	SigningKey     *string        `json:"signingKey,omitempty"`
+	SigningMethod  *SigningMethod `json:"signingMethod,omitempty"`
+	Token          string         `json:"token"`
+	TokenExpiresAt *string        `json:"tokenExpiresAt,omitempty"`
+	// Result of the last token health check
+	TokenStatus *GitProviderTokenStatus `json:"tokenStatus,omitempty"`
+	Username    string                  `json:"username"`
 }
 
 type _GitProvider GitProvider
@@ -135,6 +140,38 @@ func (o *GitProvider) SetId(v string) {
 	o.Id = v
 }
 
+// GetOauthClientId returns the OauthClientId field value if set, zero value otherwise.
+func (o *GitProvider) GetOauthClientId() string {
+	if o == nil || IsNil(o.OauthClientId) {
+		var ret string
+		return ret
+	}
+	return *o.OauthClientId
+}
+
+// GetOauthClientIdOk returns a tuple with the OauthClientId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetOauthClientIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthClientId) {
+		return nil, false
+	}
+	return o.OauthClientId, true
+}
+
+// HasOauthClientId returns a boolean if a field has been set.
+func (o *GitProvider) HasOauthClientId() bool {
+	if o != nil && !IsNil(o.OauthClientId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthClientId gets a reference to the given string and assigns it to the OauthClientId field.
+func (o *GitProvider) SetOauthClientId(v string) {
+	o.OauthClientId = &v
+}
+
 // GetProviderId returns the ProviderId field value
 func (o *GitProvider) GetProviderId() string {
 	if o == nil {
@@ -247,6 +284,70 @@ func (o *GitProvider) SetToken(v string) {
 	o.Token = v
 }
 
+// GetTokenExpiresAt returns the TokenExpiresAt field value if set, zero value otherwise.
+func (o *GitProvider) GetTokenExpiresAt() string {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.TokenExpiresAt
+}
+
+// GetTokenExpiresAtOk returns a tuple with the TokenExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetTokenExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		return nil, false
+	}
+	return o.TokenExpiresAt, true
+}
+
+// HasTokenExpiresAt returns a boolean if a field has been set.
+func (o *GitProvider) HasTokenExpiresAt() bool {
+	if o != nil && !IsNil(o.TokenExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenExpiresAt gets a reference to the given string and assigns it to the TokenExpiresAt field.
+func (o *GitProvider) SetTokenExpiresAt(v string) {
+	o.TokenExpiresAt = &v
+}
+
+// GetTokenStatus returns the TokenStatus field value if set, zero value otherwise.
+func (o *GitProvider) GetTokenStatus() GitProviderTokenStatus {
+	if o == nil || IsNil(o.TokenStatus) {
+		var ret GitProviderTokenStatus
+		return ret
+	}
+	return *o.TokenStatus
+}
+
+// GetTokenStatusOk returns a tuple with the TokenStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetTokenStatusOk() (*GitProviderTokenStatus, bool) {
+	if o == nil || IsNil(o.TokenStatus) {
+		return nil, false
+	}
+	return o.TokenStatus, true
+}
+
+// HasTokenStatus returns a boolean if a field has been set.
+func (o *GitProvider) HasTokenStatus() bool {
+	if o != nil && !IsNil(o.TokenStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenStatus gets a reference to the given GitProviderTokenStatus and assigns it to the TokenStatus field.
+func (o *GitProvider) SetTokenStatus(v GitProviderTokenStatus) {
+	o.TokenStatus = &v
+}
+
 // GetUsername returns the Username field value
 func (o *GitProvider) GetUsername() string {
 	if o == nil {
@@ -286,6 +387,9 @@ func (o GitProvider) ToMap() (map[string]interface{}, error) {
 		toSerialize["baseApiUrl"] = o.BaseApiUrl
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.OauthClientId) {
+		toSerialize["oauthClientId"] = o.OauthClientId
+	}
 	toSerialize["providerId"] = o.ProviderId
 	if !IsNil(o.SigningKey) {
 		toSerialize["signingKey"] = o.SigningKey
@@ -294,6 +398,12 @@ func (o GitProvider) ToMap() (map[string]interface{}, error) {
 		toSerialize["signingMethod"] = o.SigningMethod
 	}
 	toSerialize["token"] = o.Token
+	if !IsNil(o.TokenExpiresAt) {
+		toSerialize["tokenExpiresAt"] = o.TokenExpiresAt
+	}
+	if !IsNil(o.TokenStatus) {
+		toSerialize["tokenStatus"] = o.TokenStatus
+	}
 	toSerialize["username"] = o.Username
 	return toSerialize, nil
 }
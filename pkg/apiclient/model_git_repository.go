@@ -24,13 +24,19 @@ type GitRepository struct {
 	Branch      string       `json:"branch"`
 	CloneTarget *CloneTarget `json:"cloneTarget,omitempty"`
 	Id          string       `json:"id"`
-	Name        string       `json:"name"`
-	Owner       string       `json:"owner"`
-	Path        *string      `json:"path,omitempty"`
-	PrNumber    *int32       `json:"prNumber,omitempty"`
-	Sha         string       `json:"sha"`
-	Source      string       `json:"source"`
-	Url         string       `json:"url"`
+	// Git LFS objects are only fetched if set
+	Lfs      *GitLfsConfig `json:"lfs,omitempty"`
+	Name     string        `json:"name"`
+	Owner    string        `json:"owner"`
+	Path     *string       `json:"path,omitempty"`
+	PrNumber *int32        `json:"prNumber,omitempty"`
+	// Set if the repository context was created from a pull request
+	PullRequest *PullRequestRefs `json:"pullRequest,omitempty"`
+	Sha         string           `json:"sha"`
+	Source      string           `json:"source"`
+	// Directories checked out in cone mode, the whole repository is checked out if empty
+	SparsePaths []string `json:"sparsePaths,omitempty"`
+	Url         string   `json:"url"`
 }
 
 type _GitRepository GitRepository
@@ -139,6 +145,38 @@ func (o *GitRepository) SetId(v string) {
 	o.Id = v
 }
 
+// GetLfs returns the Lfs field value if set, zero value otherwise.
+func (o *GitRepository) GetLfs() GitLfsConfig {
+	if o == nil || IsNil(o.Lfs) {
+		var ret GitLfsConfig
+		return ret
+	}
+	return *o.Lfs
+}
+
+// GetLfsOk returns a tuple with the Lfs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetLfsOk() (*GitLfsConfig, bool) {
+	if o == nil || IsNil(o.Lfs) {
+		return nil, false
+	}
+	return o.Lfs, true
+}
+
+// HasLfs returns a boolean if a field has been set.
+func (o *GitRepository) HasLfs() bool {
+	if o != nil && !IsNil(o.Lfs) {
+		return true
+	}
+
+	return false
+}
+
+// SetLfs gets a reference to the given GitLfsConfig and assigns it to the Lfs field.
+func (o *GitRepository) SetLfs(v GitLfsConfig) {
+	o.Lfs = &v
+}
+
 // GetName returns the Name field value
 func (o *GitRepository) GetName() string {
 	if o == nil {
@@ -251,6 +289,38 @@ func (o *GitRepository) SetPrNumber(v int32) {
 	o.PrNumber = &v
 }
 
+// GetPullRequest returns the PullRequest field value if set, zero value otherwise.
+func (o *GitRepository) GetPullRequest() PullRequestRefs {
+	if o == nil || IsNil(o.PullRequest) {
+		var ret PullRequestRefs
+		return ret
+	}
+	return *o.PullRequest
+}
+
+// GetPullRequestOk returns a tuple with the PullRequest field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetPullRequestOk() (*PullRequestRefs, bool) {
+	if o == nil || IsNil(o.PullRequest) {
+		return nil, false
+	}
+	return o.PullRequest, true
+}
+
+// HasPullRequest returns a boolean if a field has been set.
+func (o *GitRepository) HasPullRequest() bool {
+	if o != nil && !IsNil(o.PullRequest) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequest gets a reference to the given PullRequestRefs and assigns it to the PullRequest field.
+func (o *GitRepository) SetPullRequest(v PullRequestRefs) {
+	o.PullRequest = &v
+}
+
 // GetSha returns the Sha field value
 func (o *GitRepository) GetSha() string {
 	if o == nil {
@@ -299,6 +369,38 @@ func (o *GitRepository) SetSource(v string) {
 	o.Source = v
 }
 
+// GetSparsePaths returns the SparsePaths field value if set, zero value otherwise.
+func (o *GitRepository) GetSparsePaths() []string {
+	if o == nil || IsNil(o.SparsePaths) {
+		var ret []string
+		return ret
+	}
+	return o.SparsePaths
+}
+
+// GetSparsePathsOk returns a tuple with the SparsePaths field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetSparsePathsOk() ([]string, bool) {
+	if o == nil || IsNil(o.SparsePaths) {
+		return nil, false
+	}
+	return o.SparsePaths, true
+}
+
+// HasSparsePaths returns a boolean if a field has been set.
+func (o *GitRepository) HasSparsePaths() bool {
+	if o != nil && !IsNil(o.SparsePaths) {
+		return true
+	}
+
+	return false
+}
+
+// SetSparsePaths gets a reference to the given []string and assigns it to the SparsePaths field.
+func (o *GitRepository) SetSparsePaths(v []string) {
+	o.SparsePaths = v
+}
+
 // GetUrl returns the Url field value
 func (o *GitRepository) GetUrl() string {
 	if o == nil {
@@ -338,6 +440,9 @@ func (o GitRepository) ToMap() (map[string]interface{}, error) {
 		toSerialize["cloneTarget"] = o.CloneTarget
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.Lfs) {
+		toSerialize["lfs"] = o.Lfs
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["owner"] = o.Owner
 	if !IsNil(o.Path) {
@@ -346,8 +451,14 @@ func (o GitRepository) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.PrNumber) {
 		toSerialize["prNumber"] = o.PrNumber
 	}
+	if !IsNil(o.PullRequest) {
+		toSerialize["pullRequest"] = o.PullRequest
+	}
 	toSerialize["sha"] = o.Sha
 	toSerialize["source"] = o.Source
+	if !IsNil(o.SparsePaths) {
+		toSerialize["sparsePaths"] = o.SparsePaths
+	}
 	toSerialize["url"] = o.Url
 	return toSerialize, nil
 }
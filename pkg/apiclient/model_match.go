@@ -21,9 +21,13 @@ var _ MappedNullable = &Match{}
 
 // Match struct for Match
 type Match struct {
-	Content string `json:"content"`
-	File    string `json:"file"`
-	Line    int32  `json:"line"`
+	// Lines following the match when context lines are requested
+	After []string `json:"after,omitempty"`
+	// Lines preceding the match when context lines are requested
+	Before  []string `json:"before,omitempty"`
+	Content string   `json:"content"`
+	File    string   `json:"file"`
+	Line    int32    `json:"line"`
 }
 
 type _Match Match
@@ -48,6 +52,70 @@ func NewMatchWithDefaults() *Match {
 	return &this
 }
 
+// GetAfter returns the After field value if set, zero value otherwise.
+func (o *Match) GetAfter() []string {
+	if o == nil || IsNil(o.After) {
+		var ret []string
+		return ret
+	}
+	return o.After
+}
+
+// GetAfterOk returns a tuple with the After field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Match) GetAfterOk() ([]string, bool) {
+	if o == nil || IsNil(o.After) {
+		return nil, false
+	}
+	return o.After, true
+}
+
+// HasAfter returns a boolean if a field has been set.
+func (o *Match) HasAfter() bool {
+	if o != nil && !IsNil(o.After) {
+		return true
+	}
+
+	return false
+}
+
+// SetAfter gets a reference to the given []string and assigns it to the After field.
+func (o *Match) SetAfter(v []string) {
+	o.After = v
+}
+
+// GetBefore returns the Before field value if set, zero value otherwise.
+func (o *Match) GetBefore() []string {
+	if o == nil || IsNil(o.Before) {
+		var ret []string
+		return ret
+	}
+	return o.Before
+}
+
+// GetBeforeOk returns a tuple with the Before field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Match) GetBeforeOk() ([]string, bool) {
+	if o == nil || IsNil(o.Before) {
+		return nil, false
+	}
+	return o.Before, true
+}
+
+// HasBefore returns a boolean if a field has been set.
+func (o *Match) HasBefore() bool {
+	if o != nil && !IsNil(o.Before) {
+		return true
+	}
+
+	return false
+}
+
+// SetBefore gets a reference to the given []string and assigns it to the Before field.
+func (o *Match) SetBefore(v []string) {
+	o.Before = v
+}
+
 // GetContent returns the Content field value
 func (o *Match) GetContent() string {
 	if o == nil {
@@ -130,6 +198,12 @@ func (o Match) MarshalJSON() ([]byte, error) {
 
 func (o Match) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.After) {
+		toSerialize["after"] = o.After
+	}
+	if !IsNil(o.Before) {
+		toSerialize["before"] = o.Before
+	}
 	toSerialize["content"] = o.Content
 	toSerialize["file"] = o.File
 	toSerialize["line"] = o.Line
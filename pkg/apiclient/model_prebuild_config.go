@@ -21,11 +21,14 @@ var _ MappedNullable = &PrebuildConfig{}
 
 // PrebuildConfig struct for PrebuildConfig
 type PrebuildConfig struct {
-	Branch         string   `json:"branch"`
-	CommitInterval *int32   `json:"commitInterval,omitempty"`
-	Id             string   `json:"id"`
-	Retention      int32    `json:"retention"`
-	TriggerFiles   []string `json:"triggerFiles"`
+	Branch             string   `json:"branch"`
+	CommitInterval     *int32   `json:"commitInterval,omitempty"`
+	Id                 string   `json:"id"`
+	PullRequestTargets []string `json:"pullRequestTargets,omitempty"`
+	Retention          int32    `json:"retention"`
+	Schedule           *string  `json:"schedule,omitempty"`
+	TagPatterns        []string `json:"tagPatterns,omitempty"`
+	TriggerFiles       []string `json:"triggerFiles"`
 }
 
 type _PrebuildConfig PrebuildConfig
@@ -131,6 +134,38 @@ func (o *PrebuildConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetPullRequestTargets returns the PullRequestTargets field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetPullRequestTargets() []string {
+	if o == nil || IsNil(o.PullRequestTargets) {
+		var ret []string
+		return ret
+	}
+	return o.PullRequestTargets
+}
+
+// GetPullRequestTargetsOk returns a tuple with the PullRequestTargets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetPullRequestTargetsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PullRequestTargets) {
+		return nil, false
+	}
+	return o.PullRequestTargets, true
+}
+
+// HasPullRequestTargets returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasPullRequestTargets() bool {
+	if o != nil && !IsNil(o.PullRequestTargets) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequestTargets gets a reference to the given []string and assigns it to the PullRequestTargets field.
+func (o *PrebuildConfig) SetPullRequestTargets(v []string) {
+	o.PullRequestTargets = v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildConfig) GetRetention() int32 {
 	if o == nil {
@@ -155,6 +190,70 @@ func (o *PrebuildConfig) SetRetention(v int32) {
 	o.Retention = v
 }
 
+// GetSchedule returns the Schedule field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetSchedule() string {
+	if o == nil || IsNil(o.Schedule) {
+		var ret string
+		return ret
+	}
+	return *o.Schedule
+}
+
+// GetScheduleOk returns a tuple with the Schedule field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetScheduleOk() (*string, bool) {
+	if o == nil || IsNil(o.Schedule) {
+		return nil, false
+	}
+	return o.Schedule, true
+}
+
+// HasSchedule returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasSchedule() bool {
+	if o != nil && !IsNil(o.Schedule) {
+		return true
+	}
+
+	return false
+}
+
+// SetSchedule gets a reference to the given string and assigns it to the Schedule field.
+func (o *PrebuildConfig) SetSchedule(v string) {
+	o.Schedule = &v
+}
+
+// GetTagPatterns returns the TagPatterns field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetTagPatterns() []string {
+	if o == nil || IsNil(o.TagPatterns) {
+		var ret []string
+		return ret
+	}
+	return o.TagPatterns
+}
+
+// GetTagPatternsOk returns a tuple with the TagPatterns field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetTagPatternsOk() ([]string, bool) {
+	if o == nil || IsNil(o.TagPatterns) {
+		return nil, false
+	}
+	return o.TagPatterns, true
+}
+
+// HasTagPatterns returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasTagPatterns() bool {
+	if o != nil && !IsNil(o.TagPatterns) {
+		return true
+	}
+
+	return false
+}
+
+// SetTagPatterns gets a reference to the given []string and assigns it to the TagPatterns field.
+func (o *PrebuildConfig) SetTagPatterns(v []string) {
+	o.TagPatterns = v
+}
+
 // GetTriggerFiles returns the TriggerFiles field value
 func (o *PrebuildConfig) GetTriggerFiles() []string {
 	if o == nil {
@@ -194,7 +293,16 @@ func (o PrebuildConfig) ToMap() (map[string]interface{}, error) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.PullRequestTargets) {
+		toSerialize["pullRequestTargets"] = o.PullRequestTargets
+	}
 	toSerialize["retention"] = o.Retention
+	if !IsNil(o.Schedule) {
+		toSerialize["schedule"] = o.Schedule
+	}
+	if !IsNil(o.TagPatterns) {
+		toSerialize["tagPatterns"] = o.TagPatterns
+	}
 	toSerialize["triggerFiles"] = o.TriggerFiles
 	return toSerialize, nil
 }
@@ -24,7 +24,10 @@ type PrebuildDTO struct {
 	Branch                string   `json:"branch"`
 	CommitInterval        *int32   `json:"commitInterval,omitempty"`
 	Id                    string   `json:"id"`
+	PullRequestTargets    []string `json:"pullRequestTargets,omitempty"`
 	Retention             int32    `json:"retention"`
+	Schedule              *string  `json:"schedule,omitempty"`
+	TagPatterns           []string `json:"tagPatterns,omitempty"`
 	TriggerFiles          []string `json:"triggerFiles,omitempty"`
 	WorkspaceTemplateName string   `json:"workspaceTemplateName"`
 }
@@ -132,6 +135,38 @@ func (o *PrebuildDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetPullRequestTargets returns the PullRequestTargets field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetPullRequestTargets() []string {
+	if o == nil || IsNil(o.PullRequestTargets) {
+		var ret []string
+		return ret
+	}
+	return o.PullRequestTargets
+}
+
+// GetPullRequestTargetsOk returns a tuple with the PullRequestTargets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetPullRequestTargetsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PullRequestTargets) {
+		return nil, false
+	}
+	return o.PullRequestTargets, true
+}
+
+// HasPullRequestTargets returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasPullRequestTargets() bool {
+	if o != nil && !IsNil(o.PullRequestTargets) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequestTargets gets a reference to the given []string and assigns it to the PullRequestTargets field.
+func (o *PrebuildDTO) SetPullRequestTargets(v []string) {
+	o.PullRequestTargets = v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildDTO) GetRetention() int32 {
 	if o == nil {
@@ -156,6 +191,70 @@ func (o *PrebuildDTO) SetRetention(v int32) {
 	o.Retention = v
 }
 
+// GetSchedule returns the Schedule field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetSchedule() string {
+	if o == nil || IsNil(o.Schedule) {
+		var ret string
+		return ret
+	}
+	return *o.Schedule
+}
+
+// GetScheduleOk returns a tuple with the Schedule field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetScheduleOk() (*string, bool) {
+	if o == nil || IsNil(o.Schedule) {
+		return nil, false
+	}
+	return o.Schedule, true
+}
+
+// HasSchedule returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasSchedule() bool {
+	if o != nil && !IsNil(o.Schedule) {
+		return true
+	}
+
+	return false
+}
+
+// SetSchedule gets a reference to the given string and assigns it to the Schedule field.
+func (o *PrebuildDTO) SetSchedule(v string) {
+	o.Schedule = &v
+}
+
+// GetTagPatterns returns the TagPatterns field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetTagPatterns() []string {
+	if o == nil || IsNil(o.TagPatterns) {
+		var ret []string
+		return ret
+	}
+	return o.TagPatterns
+}
+
+// GetTagPatternsOk returns a tuple with the TagPatterns field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetTagPatternsOk() ([]string, bool) {
+	if o == nil || IsNil(o.TagPatterns) {
+		return nil, false
+	}
+	return o.TagPatterns, true
+}
+
+// HasTagPatterns returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasTagPatterns() bool {
+	if o != nil && !IsNil(o.TagPatterns) {
+		return true
+	}
+
+	return false
+}
+
+// SetTagPatterns gets a reference to the given []string and assigns it to the TagPatterns field.
+func (o *PrebuildDTO) SetTagPatterns(v []string) {
+	o.TagPatterns = v
+}
+
 // GetTriggerFiles returns the TriggerFiles field value if set, zero value otherwise.
 func (o *PrebuildDTO) GetTriggerFiles() []string {
 	if o == nil || IsNil(o.TriggerFiles) {
@@ -227,7 +326,16 @@ func (o PrebuildDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.PullRequestTargets) {
+		toSerialize["pullRequestTargets"] = o.PullRequestTargets
+	}
 	toSerialize["retention"] = o.Retention
+	if !IsNil(o.Schedule) {
+		toSerialize["schedule"] = o.Schedule
+	}
+	if !IsNil(o.TagPatterns) {
+		toSerialize["tagPatterns"] = o.TagPatterns
+	}
 	if !IsNil(o.TriggerFiles) {
 		toSerialize["triggerFiles"] = o.TriggerFiles
 	}
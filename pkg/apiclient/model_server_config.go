@@ -21,9 +21,11 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                   int32         `json:"apiPort"`
-	BinariesPath              string        `json:"binariesPath"`
-	BuildImageNamespace       *string       `json:"buildImageNamespace,omitempty"`
+	ApiPort             int32   `json:"apiPort"`
+	BinariesPath        string  `json:"binariesPath"`
+	BuildImageNamespace *string `json:"buildImageNamespace,omitempty"`
+	// URL of the page that shows a build, {buildId} is replaced with the ID of the build. Commit statuses of prebuilds link to it.
+	BuildLinkTemplate         *string       `json:"buildLinkTemplate,omitempty"`
 	BuilderImage              string        `json:"builderImage"`
 	BuilderRegistryServer     string        `json:"builderRegistryServer"`
 	DefaultWorkspaceImage     string        `json:"defaultWorkspaceImage"`
@@ -35,9 +37,13 @@ type ServerConfig struct {
 	LocalBuilderRegistryPort  int32         `json:"localBuilderRegistryPort"`
 	LocalRunnerDisabled       *bool         `json:"localRunnerDisabled,omitempty"`
 	LogFile                   LogFileConfig `json:"logFile"`
-	RegistryUrl               string        `json:"registryUrl"`
-	SamplesIndexUrl           *string       `json:"samplesIndexUrl,omitempty"`
-	ServerDownloadUrl         string        `json:"serverDownloadUrl"`
+	// Comments a workspace creation link on pull requests of repositories with prebuilds
+	PrBotEnabled *bool `json:"prBotEnabled,omitempty"`
+	// URL of the page that creates a workspace from the pull request, {url} is replaced with the escaped URL of the pull request branch
+	PrBotLinkTemplate *string `json:"prBotLinkTemplate,omitempty"`
+	RegistryUrl       string  `json:"registryUrl"`
+	SamplesIndexUrl   *string `json:"samplesIndexUrl,omitempty"`
+	ServerDownloadUrl string  `json:"serverDownloadUrl"`
 }
 
 type _ServerConfig ServerConfig
@@ -152,6 +158,38 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildLinkTemplate returns the BuildLinkTemplate field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildLinkTemplate() string {
+	if o == nil || IsNil(o.BuildLinkTemplate) {
+		var ret string
+		return ret
+	}
+	return *o.BuildLinkTemplate
+}
+
+// GetBuildLinkTemplateOk returns a tuple with the BuildLinkTemplate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildLinkTemplateOk() (*string, bool) {
+	if o == nil || IsNil(o.BuildLinkTemplate) {
+		return nil, false
+	}
+	return o.BuildLinkTemplate, true
+}
+
+// HasBuildLinkTemplate returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildLinkTemplate() bool {
+	if o != nil && !IsNil(o.BuildLinkTemplate) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildLinkTemplate gets a reference to the given string and assigns it to the BuildLinkTemplate field.
+func (o *ServerConfig) SetBuildLinkTemplate(v string) {
+	o.BuildLinkTemplate = &v
+}
+
 // GetBuilderImage returns the BuilderImage field value
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil {
@@ -432,6 +470,70 @@ func (o *ServerConfig) SetLogFile(v LogFileConfig) {
 	o.LogFile = v
 }
 
+// GetPrBotEnabled returns the PrBotEnabled field value if set, zero value otherwise.
+func (o *ServerConfig) GetPrBotEnabled() bool {
+	if o == nil || IsNil(o.PrBotEnabled) {
+		var ret bool
+		return ret
+	}
+	return *o.PrBotEnabled
+}
+
+// GetPrBotEnabledOk returns a tuple with the PrBotEnabled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPrBotEnabledOk() (*bool, bool) {
+	if o == nil || IsNil(o.PrBotEnabled) {
+		return nil, false
+	}
+	return o.PrBotEnabled, true
+}
+
+// HasPrBotEnabled returns a boolean if a field has been set.
+func (o *ServerConfig) HasPrBotEnabled() bool {
+	if o != nil && !IsNil(o.PrBotEnabled) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrBotEnabled gets a reference to the given bool and assigns it to the PrBotEnabled field.
+func (o *ServerConfig) SetPrBotEnabled(v bool) {
+	o.PrBotEnabled = &v
+}
+
+// GetPrBotLinkTemplate returns the PrBotLinkTemplate field value if set, zero value otherwise.
+func (o *ServerConfig) GetPrBotLinkTemplate() string {
+	if o == nil || IsNil(o.PrBotLinkTemplate) {
+		var ret string
+		return ret
+	}
+	return *o.PrBotLinkTemplate
+}
+
+// GetPrBotLinkTemplateOk returns a tuple with the PrBotLinkTemplate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPrBotLinkTemplateOk() (*string, bool) {
+	if o == nil || IsNil(o.PrBotLinkTemplate) {
+		return nil, false
+	}
+	return o.PrBotLinkTemplate, true
+}
+
+// HasPrBotLinkTemplate returns a boolean if a field has been set.
+func (o *ServerConfig) HasPrBotLinkTemplate() bool {
+	if o != nil && !IsNil(o.PrBotLinkTemplate) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrBotLinkTemplate gets a reference to the given string and assigns it to the PrBotLinkTemplate field.
+func (o *ServerConfig) SetPrBotLinkTemplate(v string) {
+	o.PrBotLinkTemplate = &v
+}
+
 // GetRegistryUrl returns the RegistryUrl field value
 func (o *ServerConfig) GetRegistryUrl() string {
 	if o == nil {
@@ -527,6 +629,9 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	if !IsNil(o.BuildLinkTemplate) {
+		toSerialize["buildLinkTemplate"] = o.BuildLinkTemplate
+	}
 	toSerialize["builderImage"] = o.BuilderImage
 	toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
 	toSerialize["defaultWorkspaceImage"] = o.DefaultWorkspaceImage
@@ -542,6 +647,12 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 		toSerialize["localRunnerDisabled"] = o.LocalRunnerDisabled
 	}
 	toSerialize["logFile"] = o.LogFile
+	if !IsNil(o.PrBotEnabled) {
+		toSerialize["prBotEnabled"] = o.PrBotEnabled
+	}
+	if !IsNil(o.PrBotLinkTemplate) {
+		toSerialize["prBotLinkTemplate"] = o.PrBotLinkTemplate
+	}
 	toSerialize["registryUrl"] = o.RegistryUrl
 	if !IsNil(o.SamplesIndexUrl) {
 		toSerialize["samplesIndexUrl"] = o.SamplesIndexUrl
@@ -21,14 +21,18 @@ var _ MappedNullable = &SetGitProviderConfig{}
 
 // SetGitProviderConfig struct for SetGitProviderConfig
 type SetGitProviderConfig struct {
-	Alias         *string        `json:"alias,omitempty"`
-	BaseApiUrl    *string        `json:"baseApiUrl,omitempty"`
-	Id            *string        `json:"id,omitempty"`
-	ProviderId    string         `json:"providerId"`
-	SigningKey    *string        `json:"signingKey,omitempty"`
-	SigningMethod *SigningMethod `json:"signingMethod,omitempty"`
-	Token         string         `json:"token"`
-	Username      *string        `json:"username,omitempty"`
+	Alias      *string `json:"alias,omitempty"`
+	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	// OAuth client the token and refresh token were issued to
+	OauthClientId  *string        `json:"oauthClientId,omitempty"`
+	ProviderId     string         `json:"providerId"`
+	RefreshToken   *string        `json:"refreshToken,omitempty"`
+	SigningKey     *string        `json:"signingKey,omitempty"`
+	SigningMethod  *SigningMethod `json:"signingMethod,omitempty"`
+	Token          string         `json:"token"`
+	TokenExpiresAt *string        `json:"tokenExpiresAt,omitempty"`
+	Username       *string        `json:"username,omitempty"`
 }
 
 type _SetGitProviderConfig SetGitProviderConfig
@@ -148,6 +152,38 @@ func (o *SetGitProviderConfig) SetId(v string) {
 	o.Id = &v
 }
 
+// GetOauthClientId returns the OauthClientId field value if set, zero value otherwise.
+func (o *SetGitProviderConfig) GetOauthClientId() string {
+	if o == nil || IsNil(o.OauthClientId) {
+		var ret string
+		return ret
+	}
+	return *o.OauthClientId
+}
+
+// GetOauthClientIdOk returns a tuple with the OauthClientId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetGitProviderConfig) GetOauthClientIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OauthClientId) {
+		return nil, false
+	}
+	return o.OauthClientId, true
+}
+
+// HasOauthClientId returns a boolean if a field has been set.
+func (o *SetGitProviderConfig) HasOauthClientId() bool {
+	if o != nil && !IsNil(o.OauthClientId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOauthClientId gets a reference to the given string and assigns it to the OauthClientId field.
+func (o *SetGitProviderConfig) SetOauthClientId(v string) {
+	o.OauthClientId = &v
+}
+
 // GetProviderId returns the ProviderId field value
 func (o *SetGitProviderConfig) GetProviderId() string {
 	if o == nil {
@@ -172,6 +208,38 @@ func (o *SetGitProviderConfig) SetProviderId(v string) {
 	o.ProviderId = v
 }
 
+// GetRefreshToken returns the RefreshToken field value if set, zero value otherwise.
+func (o *SetGitProviderConfig) GetRefreshToken() string {
+	if o == nil || IsNil(o.RefreshToken) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshToken
+}
+
+// GetRefreshTokenOk returns a tuple with the RefreshToken field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetGitProviderConfig) GetRefreshTokenOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshToken) {
+		return nil, false
+	}
+	return o.RefreshToken, true
+}
+
+// HasRefreshToken returns a boolean if a field has been set.
+func (o *SetGitProviderConfig) HasRefreshToken() bool {
+	if o != nil && !IsNil(o.RefreshToken) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshToken gets a reference to the given string and assigns it to the RefreshToken field.
+func (o *SetGitProviderConfig) SetRefreshToken(v string) {
+	o.RefreshToken = &v
+}
+
 // GetSigningKey returns the SigningKey field value if set, zero value otherwise.
 func (o *SetGitProviderConfig) GetSigningKey() string {
 	if o == nil || IsNil(o.SigningKey) {
@@ -260,6 +328,38 @@ func (o *SetGitProviderConfig) SetToken(v string) {
 	o.Token = v
 }
 
+// GetTokenExpiresAt returns the TokenExpiresAt field value if set, zero value otherwise.
+func (o *SetGitProviderConfig) GetTokenExpiresAt() string {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.TokenExpiresAt
+}
+
+// GetTokenExpiresAtOk returns a tuple with the TokenExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetGitProviderConfig) GetTokenExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenExpiresAt) {
+		return nil, false
+	}
+	return o.TokenExpiresAt, true
+}
+
+// HasTokenExpiresAt returns a boolean if a field has been set.
+func (o *SetGitProviderConfig) HasTokenExpiresAt() bool {
+	if o != nil && !IsNil(o.TokenExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenExpiresAt gets a reference to the given string and assigns it to the TokenExpiresAt field.
+func (o *SetGitProviderConfig) SetTokenExpiresAt(v string) {
+	o.TokenExpiresAt = &v
+}
+
 // GetUsername returns the Username field value if set, zero value otherwise.
 func (o *SetGitProviderConfig) GetUsername() string {
 	if o == nil || IsNil(o.Username) {
@@ -311,7 +411,13 @@ func (o SetGitProviderConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.OauthClientId) {
+		toSerialize["oauthClientId"] = o.OauthClientId
+	}
 	toSerialize["providerId"] = o.ProviderId
+	if !IsNil(o.RefreshToken) {
+		toSerialize["refreshToken"] = o.RefreshToken
+	}
 	if !IsNil(o.SigningKey) {
 		toSerialize["signingKey"] = o.SigningKey
 	}
@@ -319,6 +425,9 @@ func (o SetGitProviderConfig) ToMap() (map[string]interface{}, error) {
 		toSerialize["signingMethod"] = o.SigningMethod
 	}
 	toSerialize["token"] = o.Token
+	if !IsNil(o.TokenExpiresAt) {
+		toSerialize["tokenExpiresAt"] = o.TokenExpiresAt
+	}
 	if !IsNil(o.Username) {
 		toSerialize["username"] = o.Username
 	}
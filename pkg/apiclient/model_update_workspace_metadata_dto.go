@@ -21,8 +21,12 @@ var _ MappedNullable = &UpdateWorkspaceMetadataDTO{}
 
 // UpdateWorkspaceMetadataDTO struct for UpdateWorkspaceMetadataDTO
 type UpdateWorkspaceMetadataDTO struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    int32      `json:"uptime"`
+	// Git status of each additional repository keyed by its clone path
+	AdditionalGitStatuses map[string]GitStatus  `json:"additionalGitStatuses,omitempty"`
+	ForwardPorts          []WorkspacePort       `json:"forwardPorts,omitempty"`
+	GitStatus             *GitStatus            `json:"gitStatus,omitempty"`
+	LifecycleHooks        []LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
+	Uptime                int32                 `json:"uptime"`
 }
 
 type _UpdateWorkspaceMetadataDTO UpdateWorkspaceMetadataDTO
@@ -45,6 +49,70 @@ func NewUpdateWorkspaceMetadataDTOWithDefaults() *UpdateWorkspaceMetadataDTO {
 	return &this
 }
 
+// GetAdditionalGitStatuses returns the AdditionalGitStatuses field value if set, zero value otherwise.
+func (o *UpdateWorkspaceMetadataDTO) GetAdditionalGitStatuses() map[string]GitStatus {
+	if o == nil || IsNil(o.AdditionalGitStatuses) {
+		var ret map[string]GitStatus
+		return ret
+	}
+	return o.AdditionalGitStatuses
+}
+
+// GetAdditionalGitStatusesOk returns a tuple with the AdditionalGitStatuses field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdateWorkspaceMetadataDTO) GetAdditionalGitStatusesOk() (map[string]GitStatus, bool) {
+	if o == nil || IsNil(o.AdditionalGitStatuses) {
+		return nil, false
+	}
+	return o.AdditionalGitStatuses, true
+}
+
+// HasAdditionalGitStatuses returns a boolean if a field has been set.
+func (o *UpdateWorkspaceMetadataDTO) HasAdditionalGitStatuses() bool {
+	if o != nil && !IsNil(o.AdditionalGitStatuses) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdditionalGitStatuses gets a reference to the given map[string]GitStatus and assigns it to the AdditionalGitStatuses field.
+func (o *UpdateWorkspaceMetadataDTO) SetAdditionalGitStatuses(v map[string]GitStatus) {
+	o.AdditionalGitStatuses = v
+}
+
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *UpdateWorkspaceMetadataDTO) GetForwardPorts() []WorkspacePort {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []WorkspacePort
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdateWorkspaceMetadataDTO) GetForwardPortsOk() ([]WorkspacePort, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *UpdateWorkspaceMetadataDTO) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []WorkspacePort and assigns it to the ForwardPorts field.
+func (o *UpdateWorkspaceMetadataDTO) SetForwardPorts(v []WorkspacePort) {
+	o.ForwardPorts = v
+}
+
 // GetGitStatus returns the GitStatus field value if set, zero value otherwise.
 func (o *UpdateWorkspaceMetadataDTO) GetGitStatus() GitStatus {
 	if o == nil || IsNil(o.GitStatus) {
@@ -77,6 +145,38 @@ func (o *UpdateWorkspaceMetadataDTO) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLifecycleHooks returns the LifecycleHooks field value if set, zero value otherwise.
+func (o *UpdateWorkspaceMetadataDTO) GetLifecycleHooks() []LifecycleHookStatus {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		var ret []LifecycleHookStatus
+		return ret
+	}
+	return o.LifecycleHooks
+}
+
+// GetLifecycleHooksOk returns a tuple with the LifecycleHooks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdateWorkspaceMetadataDTO) GetLifecycleHooksOk() ([]LifecycleHookStatus, bool) {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		return nil, false
+	}
+	return o.LifecycleHooks, true
+}
+
+// HasLifecycleHooks returns a boolean if a field has been set.
+func (o *UpdateWorkspaceMetadataDTO) HasLifecycleHooks() bool {
+	if o != nil && !IsNil(o.LifecycleHooks) {
+		return true
+	}
+
+	return false
+}
+
+// SetLifecycleHooks gets a reference to the given []LifecycleHookStatus and assigns it to the LifecycleHooks field.
+func (o *UpdateWorkspaceMetadataDTO) SetLifecycleHooks(v []LifecycleHookStatus) {
+	o.LifecycleHooks = v
+}
+
 // GetUptime returns the Uptime field value
 func (o *UpdateWorkspaceMetadataDTO) GetUptime() int32 {
 	if o == nil {
@@ -111,9 +211,18 @@ func (o UpdateWorkspaceMetadataDTO) MarshalJSON() ([]byte, error) {
 
 func (o UpdateWorkspaceMetadataDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AdditionalGitStatuses) {
+		toSerialize["additionalGitStatuses"] = o.AdditionalGitStatuses
+	}
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LifecycleHooks) {
+		toSerialize["lifecycleHooks"] = o.LifecycleHooks
+	}
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
 }
@@ -21,22 +21,24 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	ApiKey              string             `json:"apiKey"`
-	BuildConfig         *BuildConfig       `json:"buildConfig,omitempty"`
-	EnvVars             map[string]string  `json:"envVars"`
-	GitProviderConfigId *string            `json:"gitProviderConfigId,omitempty"`
-	Id                  string             `json:"id"`
-	Image               string             `json:"image"`
-	Labels              map[string]string  `json:"labels"`
-	LastJob             *Job               `json:"lastJob,omitempty"`
-	LastJobId           *string            `json:"lastJobId,omitempty"`
-	Metadata            *WorkspaceMetadata `json:"metadata,omitempty"`
-	Name                string             `json:"name"`
-	ProviderMetadata    *string            `json:"providerMetadata,omitempty"`
-	Repository          GitRepository      `json:"repository"`
-	Target              Target             `json:"target"`
-	TargetId            string             `json:"targetId"`
-	User                string             `json:"user"`
+	// Repositories cloned next to the main repository of the workspace
+	AdditionalRepositories []WorkspaceRepository `json:"additionalRepositories,omitempty"`
+	ApiKey                 string                `json:"apiKey"`
+	BuildConfig            *BuildConfig          `json:"buildConfig,omitempty"`
+	EnvVars                map[string]string     `json:"envVars"`
+	GitProviderConfigId    *string               `json:"gitProviderConfigId,omitempty"`
+	Id                     string                `json:"id"`
+	Image                  string                `json:"image"`
+	Labels                 map[string]string     `json:"labels"`
+	LastJob                *Job                  `json:"lastJob,omitempty"`
+	LastJobId              *string               `json:"lastJobId,omitempty"`
+	Metadata               *WorkspaceMetadata    `json:"metadata,omitempty"`
+	Name                   string                `json:"name"`
+	ProviderMetadata       *string               `json:"providerMetadata,omitempty"`
+	Repository             GitRepository         `json:"repository"`
+	Target                 Target                `json:"target"`
+	TargetId               string                `json:"targetId"`
+	User                   string                `json:"user"`
 }
 
 type _Workspace Workspace
@@ -68,6 +70,38 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetAdditionalRepositories returns the AdditionalRepositories field value if set, zero value otherwise.
+func (o *Workspace) GetAdditionalRepositories() []WorkspaceRepository {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		var ret []WorkspaceRepository
+		return ret
+	}
+	return o.AdditionalRepositories
+}
+
+// GetAdditionalRepositoriesOk returns a tuple with the AdditionalRepositories field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetAdditionalRepositoriesOk() ([]WorkspaceRepository, bool) {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		return nil, false
+	}
+	return o.AdditionalRepositories, true
+}
+
+// HasAdditionalRepositories returns a boolean if a field has been set.
+func (o *Workspace) HasAdditionalRepositories() bool {
+	if o != nil && !IsNil(o.AdditionalRepositories) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdditionalRepositories gets a reference to the given []WorkspaceRepository and assigns it to the AdditionalRepositories field.
+func (o *Workspace) SetAdditionalRepositories(v []WorkspaceRepository) {
+	o.AdditionalRepositories = v
+}
+
 // GetApiKey returns the ApiKey field value
 func (o *Workspace) GetApiKey() string {
 	if o == nil {
@@ -510,6 +544,9 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AdditionalRepositories) {
+		toSerialize["additionalRepositories"] = o.AdditionalRepositories
+	}
 	toSerialize["apiKey"] = o.ApiKey
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
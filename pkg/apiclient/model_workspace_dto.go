@@ -21,23 +21,25 @@ var _ MappedNullable = &WorkspaceDTO{}
 
 // WorkspaceDTO struct for WorkspaceDTO
 type WorkspaceDTO struct {
-	ApiKey              string             `json:"apiKey"`
-	BuildConfig         *BuildConfig       `json:"buildConfig,omitempty"`
-	EnvVars             map[string]string  `json:"envVars"`
-	GitProviderConfigId *string            `json:"gitProviderConfigId,omitempty"`
-	Id                  string             `json:"id"`
-	Image               string             `json:"image"`
-	Labels              map[string]string  `json:"labels"`
-	LastJob             *Job               `json:"lastJob,omitempty"`
-	LastJobId           *string            `json:"lastJobId,omitempty"`
-	Metadata            *WorkspaceMetadata `json:"metadata,omitempty"`
-	Name                string             `json:"name"`
-	ProviderMetadata    *string            `json:"providerMetadata,omitempty"`
-	Repository          GitRepository      `json:"repository"`
-	State               ResourceState      `json:"state"`
-	Target              Target             `json:"target"`
-	TargetId            string             `json:"targetId"`
-	User                string             `json:"user"`
+	// Repositories cloned next to the main repository of the workspace
+	AdditionalRepositories []WorkspaceRepository `json:"additionalRepositories,omitempty"`
+	ApiKey                 string                `json:"apiKey"`
+	BuildConfig            *BuildConfig          `json:"buildConfig,omitempty"`
+	EnvVars                map[string]string     `json:"envVars"`
+	GitProviderConfigId    *string               `json:"gitProviderConfigId,omitempty"`
+	Id                     string                `json:"id"`
+	Image                  string                `json:"image"`
+	Labels                 map[string]string     `json:"labels"`
+	LastJob                *Job                  `json:"lastJob,omitempty"`
+	LastJobId              *string               `json:"lastJobId,omitempty"`
+	Metadata               *WorkspaceMetadata    `json:"metadata,omitempty"`
+	Name                   string                `json:"name"`
+	ProviderMetadata       *string               `json:"providerMetadata,omitempty"`
+	Repository             GitRepository         `json:"repository"`
+	State                  ResourceState         `json:"state"`
+	Target                 Target                `json:"target"`
+	TargetId               string                `json:"targetId"`
+	User                   string                `json:"user"`
 }
 
 type _WorkspaceDTO WorkspaceDTO
@@ -70,6 +72,38 @@ func NewWorkspaceDTOWithDefaults() *WorkspaceDTO {
 	return &this
 }
 
+// GetAdditionalRepositories returns the AdditionalRepositories field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetAdditionalRepositories() []WorkspaceRepository {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		var ret []WorkspaceRepository
+		return ret
+	}
+	return o.AdditionalRepositories
+}
+
+// GetAdditionalRepositoriesOk returns a tuple with the AdditionalRepositories field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetAdditionalRepositoriesOk() ([]WorkspaceRepository, bool) {
+	if o == nil || IsNil(o.AdditionalRepositories) {
+		return nil, false
+	}
+	return o.AdditionalRepositories, true
+}
+
+// HasAdditionalRepositories returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasAdditionalRepositories() bool {
+	if o != nil && !IsNil(o.AdditionalRepositories) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdditionalRepositories gets a reference to the given []WorkspaceRepository and assigns it to the AdditionalRepositories field.
+func (o *WorkspaceDTO) SetAdditionalRepositories(v []WorkspaceRepository) {
+	o.AdditionalRepositories = v
+}
+
 // GetApiKey returns the ApiKey field value
 func (o *WorkspaceDTO) GetApiKey() string {
 	if o == nil {
@@ -536,6 +570,9 @@ func (o WorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o WorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AdditionalRepositories) {
+		toSerialize["additionalRepositories"] = o.AdditionalRepositories
+	}
 	toSerialize["apiKey"] = o.ApiKey
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
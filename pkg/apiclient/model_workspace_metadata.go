@@ -21,10 +21,15 @@ var _ MappedNullable = &WorkspaceMetadata{}
 
 // WorkspaceMetadata struct for WorkspaceMetadata
 type WorkspaceMetadata struct {
-	GitStatus   *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt   string     `json:"updatedAt"`
-	Uptime      int32      `json:"uptime"`
-	WorkspaceId string     `json:"workspaceId"`
+	// Git status of each additional repository keyed by its clone path
+	AdditionalGitStatuses map[string]GitStatus  `json:"additionalGitStatuses,omitempty"`
+	ForwardPorts          []WorkspacePort       `json:"forwardPorts,omitempty"`
+	GitStatus             *GitStatus            `json:"gitStatus,omitempty"`
+	LifecycleHooks        []LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
+	Services              []WorkspaceService    `json:"services,omitempty"`
+	UpdatedAt             string                `json:"updatedAt"`
+	Uptime                int32                 `json:"uptime"`
+	WorkspaceId           string                `json:"workspaceId"`
 }
 
 type _WorkspaceMetadata WorkspaceMetadata
@@ -49,6 +54,70 @@ func NewWorkspaceMetadataWithDefaults() *WorkspaceMetadata {
 	return &this
 }
 
+// GetAdditionalGitStatuses returns the AdditionalGitStatuses field value if set, zero value otherwise.
+func (o *WorkspaceMetadata) GetAdditionalGitStatuses() map[string]GitStatus {
+	if o == nil || IsNil(o.AdditionalGitStatuses) {
+		var ret map[string]GitStatus
+		return ret
+	}
+	return o.AdditionalGitStatuses
+}
+
+// GetAdditionalGitStatusesOk returns a tuple with the AdditionalGitStatuses field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceMetadata) GetAdditionalGitStatusesOk() (map[string]GitStatus, bool) {
+	if o == nil || IsNil(o.AdditionalGitStatuses) {
+		return nil, false
+	}
+	return o.AdditionalGitStatuses, true
+}
+
+// HasAdditionalGitStatuses returns a boolean if a field has been set.
+func (o *WorkspaceMetadata) HasAdditionalGitStatuses() bool {
+	if o != nil && !IsNil(o.AdditionalGitStatuses) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdditionalGitStatuses gets a reference to the given map[string]GitStatus and assigns it to the AdditionalGitStatuses field.
+func (o *WorkspaceMetadata) SetAdditionalGitStatuses(v map[string]GitStatus) {
+	o.AdditionalGitStatuses = v
+}
+
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *WorkspaceMetadata) GetForwardPorts() []WorkspacePort {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []WorkspacePort
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceMetadata) GetForwardPortsOk() ([]WorkspacePort, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *WorkspaceMetadata) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []WorkspacePort and assigns it to the ForwardPorts field.
+func (o *WorkspaceMetadata) SetForwardPorts(v []WorkspacePort) {
+	o.ForwardPorts = v
+}
+
 // GetGitStatus returns the GitStatus field value if set, zero value otherwise.
 func (o *WorkspaceMetadata) GetGitStatus() GitStatus {
 	if o == nil || IsNil(o.GitStatus) {
@@ -81,6 +150,70 @@ func (o *WorkspaceMetadata) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLifecycleHooks returns the LifecycleHooks field value if set, zero value otherwise.
+func (o *WorkspaceMetadata) GetLifecycleHooks() []LifecycleHookStatus {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		var ret []LifecycleHookStatus
+		return ret
+	}
+	return o.LifecycleHooks
+}
+
+// GetLifecycleHooksOk returns a tuple with the LifecycleHooks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceMetadata) GetLifecycleHooksOk() ([]LifecycleHookStatus, bool) {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		return nil, false
+	}
+	return o.LifecycleHooks, true
+}
+
+// HasLifecycleHooks returns a boolean if a field has been set.
+func (o *WorkspaceMetadata) HasLifecycleHooks() bool {
+	if o != nil && !IsNil(o.LifecycleHooks) {
+		return true
+	}
+
+	return false
+}
+
+// SetLifecycleHooks gets a reference to the given []LifecycleHookStatus and assigns it to the LifecycleHooks field.
+func (o *WorkspaceMetadata) SetLifecycleHooks(v []LifecycleHookStatus) {
+	o.LifecycleHooks = v
+}
+
+// GetServices returns the Services field value if set, zero value otherwise.
+func (o *WorkspaceMetadata) GetServices() []WorkspaceService {
+	if o == nil || IsNil(o.Services) {
+		var ret []WorkspaceService
+		return ret
+	}
+	return o.Services
+}
+
+// GetServicesOk returns a tuple with the Services field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceMetadata) GetServicesOk() ([]WorkspaceService, bool) {
+	if o == nil || IsNil(o.Services) {
+		return nil, false
+	}
+	return o.Services, true
+}
+
+// HasServices returns a boolean if a field has been set.
+func (o *WorkspaceMetadata) HasServices() bool {
+	if o != nil && !IsNil(o.Services) {
+		return true
+	}
+
+	return false
+}
+
+// SetServices gets a reference to the given []WorkspaceService and assigns it to the Services field.
+func (o *WorkspaceMetadata) SetServices(v []WorkspaceService) {
+	o.Services = v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *WorkspaceMetadata) GetUpdatedAt() string {
 	if o == nil {
@@ -163,9 +296,21 @@ func (o WorkspaceMetadata) MarshalJSON() ([]byte, error) {
 
 func (o WorkspaceMetadata) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AdditionalGitStatuses) {
+		toSerialize["additionalGitStatuses"] = o.AdditionalGitStatuses
+	}
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LifecycleHooks) {
+		toSerialize["lifecycleHooks"] = o.LifecycleHooks
+	}
+	if !IsNil(o.Services) {
+		toSerialize["services"] = o.Services
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
 	toSerialize["workspaceId"] = o.WorkspaceId
@@ -27,10 +27,14 @@ type WorkspaceTemplate struct {
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               string            `json:"image"`
 	Labels              map[string]string `json:"labels"`
-	Name                string            `json:"name"`
-	Prebuilds           []PrebuildConfig  `json:"prebuilds,omitempty"`
-	RepositoryUrl       string            `json:"repositoryUrl"`
-	User                string            `json:"user"`
+	// Git LFS objects are only fetched if set
+	Lfs           *GitLfsConfig    `json:"lfs,omitempty"`
+	Name          string           `json:"name"`
+	Prebuilds     []PrebuildConfig `json:"prebuilds,omitempty"`
+	RepositoryUrl string           `json:"repositoryUrl"`
+	// Directories checked out in cone mode, the whole repository is checked out if empty
+	SparsePaths []string `json:"sparsePaths,omitempty"`
+	User        string   `json:"user"`
 }
 
 type _WorkspaceTemplate WorkspaceTemplate
@@ -219,6 +223,38 @@ func (o *WorkspaceTemplate) SetLabels(v map[string]string) {
 	o.Labels = v
 }
 
+// GetLfs returns the Lfs field value if set, zero value otherwise.
+func (o *WorkspaceTemplate) GetLfs() GitLfsConfig {
+	if o == nil || IsNil(o.Lfs) {
+		var ret GitLfsConfig
+		return ret
+	}
+	return *o.Lfs
+}
+
+// GetLfsOk returns a tuple with the Lfs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceTemplate) GetLfsOk() (*GitLfsConfig, bool) {
+	if o == nil || IsNil(o.Lfs) {
+		return nil, false
+	}
+	return o.Lfs, true
+}
+
+// HasLfs returns a boolean if a field has been set.
+func (o *WorkspaceTemplate) HasLfs() bool {
+	if o != nil && !IsNil(o.Lfs) {
+		return true
+	}
+
+	return false
+}
+
+// SetLfs gets a reference to the given GitLfsConfig and assigns it to the Lfs field.
+func (o *WorkspaceTemplate) SetLfs(v GitLfsConfig) {
+	o.Lfs = &v
+}
+
 // GetName returns the Name field value
 func (o *WorkspaceTemplate) GetName() string {
 	if o == nil {
@@ -299,6 +335,38 @@ func (o *WorkspaceTemplate) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetSparsePaths returns the SparsePaths field value if set, zero value otherwise.
+func (o *WorkspaceTemplate) GetSparsePaths() []string {
+	if o == nil || IsNil(o.SparsePaths) {
+		var ret []string
+		return ret
+	}
+	return o.SparsePaths
+}
+
+// GetSparsePathsOk returns a tuple with the SparsePaths field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceTemplate) GetSparsePathsOk() ([]string, bool) {
+	if o == nil || IsNil(o.SparsePaths) {
+		return nil, false
+	}
+	return o.SparsePaths, true
+}
+
+// HasSparsePaths returns a boolean if a field has been set.
+func (o *WorkspaceTemplate) HasSparsePaths() bool {
+	if o != nil && !IsNil(o.SparsePaths) {
+		return true
+	}
+
+	return false
+}
+
+// SetSparsePaths gets a reference to the given []string and assigns it to the SparsePaths field.
+func (o *WorkspaceTemplate) SetSparsePaths(v []string) {
+	o.SparsePaths = v
+}
+
 // GetUser returns the User field value
 func (o *WorkspaceTemplate) GetUser() string {
 	if o == nil {
@@ -343,11 +411,17 @@ func (o WorkspaceTemplate) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["image"] = o.Image
 	toSerialize["labels"] = o.Labels
+	if !IsNil(o.Lfs) {
+		toSerialize["lfs"] = o.Lfs
+	}
 	toSerialize["name"] = o.Name
 	if !IsNil(o.Prebuilds) {
 		toSerialize["prebuilds"] = o.Prebuilds
 	}
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.SparsePaths) {
+		toSerialize["sparsePaths"] = o.SparsePaths
+	}
 	toSerialize["user"] = o.User
 	return toSerialize, nil
 }
@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type Format string
+
+const (
+	FormatTar Format = "tar"
+	FormatZip Format = "zip"
+)
+
+type Compression string
+
+const (
+	CompressionNone Compression = "none"
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic  = []byte{'P', 'K', 0x03, 0x04}
+)
+
+func ParseFormat(value string) (Format, error) {
+	switch Format(value) {
+	case "", FormatTar:
+		return FormatTar, nil
+	case FormatZip:
+		return FormatZip, nil
+	}
+	return "", fmt.Errorf("unsupported archive format: %s", value)
+}
+
+func ParseCompression(value string) (Compression, error) {
+	switch Compression(value) {
+	case "", CompressionNone:
+		return CompressionNone, nil
+	case CompressionGzip:
+		return CompressionGzip, nil
+	case CompressionZstd:
+		return CompressionZstd, nil
+	}
+	return "", fmt.Errorf("unsupported compression: %s", value)
+}
+
+// Extension returns the conventional file extension for an archive with the given format and compression
+func Extension(format Format, compression Compression) string {
+	if format == FormatZip {
+		return ".zip"
+	}
+
+	switch compression {
+	case CompressionGzip:
+		return ".tar.gz"
+	case CompressionZstd:
+		return ".tar.zst"
+	}
+	return ".tar"
+}
+
+// decompress detects the compression of the stream from its magic bytes and returns a reader of the decompressed data
+func decompress(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	header, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(header, zstdMagic):
+		decoder, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	}
+
+	return io.NopCloser(br), nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+func compress(w io.Writer, compression Compression) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	}
+	return nopWriteCloser{w}, nil
+}
+
+// MatchesAny reports whether the relative path, its base name or any of its
+// parent directories match one of the glob patterns
+func MatchesAny(patterns []string, relPath string) bool {
+	relPath = filepath.ToSlash(relPath)
+	segments := strings.Split(relPath, "/")
+
+	for _, pattern := range patterns {
+		pattern = filepath.ToSlash(strings.TrimSuffix(pattern, "/"))
+
+		if matched, _ := filepath.Match(pattern, relPath); matched {
+			return true
+		}
+
+		for i := range segments {
+			if matched, _ := filepath.Match(pattern, segments[i]); matched {
+				return true
+			}
+			if matched, _ := filepath.Match(pattern, strings.Join(segments[:i+1], "/")); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ArchiveTestSuite struct {
+	suite.Suite
+	srcDir string
+}
+
+func TestArchive(t *testing.T) {
+	suite.Run(t, new(ArchiveTestSuite))
+}
+
+func (s *ArchiveTestSuite) SetupTest() {
+	s.srcDir = s.T().TempDir()
+
+	files := map[string]string{
+		"README.md":           "readme",
+		"src/main.go":         "package main",
+		"src/lib/util.go":     "package lib",
+		"node_modules/pkg/a":  "excluded",
+		"build/output.tmp":    "excluded",
+		"build/output.binary": "binary",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(s.srcDir, name)
+		require.NoError(s.T(), os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(s.T(), os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func (s *ArchiveTestSuite) TestRoundTrip() {
+	cases := []CreateOptions{
+		{Format: FormatTar, Compression: CompressionNone},
+		{Format: FormatTar, Compression: CompressionGzip},
+		{Format: FormatTar, Compression: CompressionZstd},
+		{Format: FormatZip},
+	}
+
+	for _, opts := range cases {
+		s.Run(string(opts.Format)+"/"+string(opts.Compression), func() {
+			opts.Exclude = []string{"node_modules", "*.tmp"}
+
+			var buf bytes.Buffer
+			require.NoError(s.T(), Create(&buf, s.srcDir, opts))
+
+			dest := s.T().TempDir()
+			require.NoError(s.T(), Extract(&buf, dest))
+
+			s.assertFile(filepath.Join(dest, "README.md"), "readme")
+			s.assertFile(filepath.Join(dest, "src/main.go"), "package main")
+			s.assertFile(filepath.Join(dest, "src/lib/util.go"), "package lib")
+			s.assertFile(filepath.Join(dest, "build/output.binary"), "binary")
+
+			s.NoFileExists(filepath.Join(dest, "build/output.tmp"))
+			s.NoDirExists(filepath.Join(dest, "node_modules"))
+		})
+	}
+}
+
+func (s *ArchiveTestSuite) TestSingleFile() {
+	var buf bytes.Buffer
+	require.NoError(s.T(), Create(&buf, filepath.Join(s.srcDir, "README.md"), CreateOptions{Format: FormatTar}))
+
+	dest := s.T().TempDir()
+	require.NoError(s.T(), Extract(&buf, dest))
+
+	s.assertFile(filepath.Join(dest, "README.md"), "readme")
+}
+
+func (s *ArchiveTestSuite) TestExtractRejectsPathTraversal() {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "../escaped", Mode: 0644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), tw.Close())
+
+	parent := s.T().TempDir()
+	dest := filepath.Join(parent, "dest")
+
+	s.Error(Extract(&buf, dest))
+	s.NoFileExists(filepath.Join(parent, "escaped"))
+}
+
+func (s *ArchiveTestSuite) TestExtractRejectsSymlinkTraversal() {
+	outside := s.T().TempDir()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "link", Linkname: outside, Typeflag: tar.TypeSymlink}))
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "link/escaped", Mode: 0644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), tw.Close())
+
+	s.Error(Extract(&buf, s.T().TempDir()))
+	s.NoFileExists(filepath.Join(outside, "escaped"))
+}
+
+func (s *ArchiveTestSuite) TestExtractDoesNotWriteThroughSymlinks() {
+	outside := filepath.Join(s.T().TempDir(), "outside")
+	require.NoError(s.T(), os.WriteFile(outside, []byte("original"), 0644))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "a", Linkname: outside, Typeflag: tar.TypeSymlink}))
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "a", Mode: 0644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), tw.Close())
+
+	s.Error(Extract(&buf, s.T().TempDir()))
+	s.assertFile(outside, "original")
+
+	// Symlinks inside of the destination are replaced by later entries with the same name
+	buf.Reset()
+	tw = tar.NewWriter(&buf)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "b", Mode: 0644, Size: 8, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("original"))
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "a", Linkname: "b", Typeflag: tar.TypeSymlink}))
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "a", Mode: 0644, Size: 3, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("new"))
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), tw.Close())
+
+	dest := s.T().TempDir()
+	require.NoError(s.T(), Extract(&buf, dest))
+	s.assertFile(filepath.Join(dest, "a"), "new")
+	s.assertFile(filepath.Join(dest, "b"), "original")
+}
+
+func (s *ArchiveTestSuite) TestExtractRejectsSymlinksResolvingOutside() {
+	parent := s.T().TempDir()
+	require.NoError(s.T(), os.Mkdir(filepath.Join(parent, "outside"), 0755))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "self", Linkname: ".", Typeflag: tar.TypeSymlink}))
+	require.NoError(s.T(), tw.WriteHeader(&tar.Header{Name: "link", Linkname: "self/../outside", Typeflag: tar.TypeSymlink}))
+	require.NoError(s.T(), tw.Close())
+
+	dest := filepath.Join(parent, "dest")
+
+	s.Error(Extract(&buf, dest))
+	s.NoFileExists(filepath.Join(dest, "link"))
+}
+
+func (s *ArchiveTestSuite) assertFile(path, content string) {
+	data, err := os.ReadFile(path)
+	require.NoError(s.T(), err)
+	s.Equal(content, string(data))
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type CreateOptions struct {
+	Format      Format
+	Compression Compression
+	// Exclude contains glob patterns matched against paths relative to the archived directory
+	Exclude []string
+}
+
+// Create writes an archive of the given path to w.
+// If the path is a directory, its contents are archived relative to it, otherwise the archive contains only the file.
+func Create(w io.Writer, path string, opts CreateOptions) error {
+	if opts.Format == FormatZip {
+		return createZip(w, path, opts.Exclude)
+	}
+
+	cw, err := compress(w, opts.Compression)
+	if err != nil {
+		return err
+	}
+
+	err = createTar(cw, path, opts.Exclude)
+	if err != nil {
+		cw.Close()
+		return err
+	}
+
+	return cw.Close()
+}
+
+func createTar(w io.Writer, root string, exclude []string) error {
+	tw := tar.NewWriter(w)
+
+	err := walk(root, exclude, func(path, name string, info os.FileInfo) error {
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			var err error
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(tw, path)
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func createZip(w io.Writer, root string, exclude []string) error {
+	zw := zip.NewWriter(w)
+
+	err := walk(root, exclude, func(path, name string, info os.FileInfo) error {
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if info.IsDir() {
+			header.Name += "/"
+		} else {
+			header.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			_, err = fw.Write([]byte(link))
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(fw, path)
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// walk calls fn for every entry that should be archived with its path on disk and its name inside the archive
+func walk(root string, exclude []string, fn func(path, name string, info os.FileInfo) error) error {
+	rootInfo, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+
+	if !rootInfo.IsDir() {
+		return fn(root, filepath.Base(root), rootInfo)
+	}
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		if MatchesAny(exclude, relPath) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		return fn(path, filepath.ToSlash(relPath), info)
+	})
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
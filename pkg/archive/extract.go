@@ -0,0 +1,271 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Extract extracts a tar or zip archive read from r into dest.
+// The archive format and compression (gzip or zstd) are detected from the content.
+func Extract(r io.Reader, dest string) error {
+	dest, err := filepath.Abs(dest)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dest, 0755)
+	if err != nil {
+		return err
+	}
+
+	dr, err := decompress(r)
+	if err != nil {
+		return err
+	}
+	defer dr.Close()
+
+	br := bufio.NewReader(dr)
+	header, err := br.Peek(len(zipMagic))
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	e := &extractor{dest: dest}
+	if bytes.Equal(header, zipMagic) {
+		err = e.extractZip(br)
+	} else {
+		err = e.extractTar(br)
+	}
+	if err != nil {
+		return err
+	}
+
+	return e.verifySymlinks()
+}
+
+// extractor keeps track of the symlinks created while extracting an archive into dest
+type extractor struct {
+	dest     string
+	symlinks []string
+}
+
+func (e *extractor) extractTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := safeJoin(e.dest, header.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, mode|0700)
+		case tar.TypeReg:
+			err = writeFile(target, tr, mode)
+		case tar.TypeSymlink:
+			err = e.writeSymlink(target, header.Linkname)
+		default:
+			// Other entry types (devices, fifos, hard links) are not supported
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractZip buffers the archive to a temporary file because zip archives can not be read sequentially
+func (e *extractor) extractZip(r io.Reader) error {
+	tmp, err := os.CreateTemp("", "daytona-archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		target, err := safeJoin(e.dest, f.Name)
+		if err != nil {
+			return err
+		}
+
+		err = e.extractZipEntry(f, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *extractor) extractZipEntry(f *zip.File, target string) error {
+	mode := f.Mode()
+
+	if mode.IsDir() {
+		return os.MkdirAll(target, mode.Perm()|0700)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if mode&os.ModeSymlink != 0 {
+		link, err := io.ReadAll(rc)
+		if err != nil {
+			return err
+		}
+		return e.writeSymlink(target, string(link))
+	}
+
+	if !mode.IsRegular() {
+		return nil
+	}
+
+	return writeFile(target, rc, mode.Perm())
+}
+
+func writeFile(target string, r io.Reader, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Replace symlinks created by earlier entries instead of writing through them
+	info, err := os.Lstat(target)
+	if err == nil && info.Mode()&os.ModeSymlink != 0 {
+		err = os.Remove(target)
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+func (e *extractor) writeSymlink(target, link string) error {
+	linkTarget := filepath.FromSlash(link)
+	if !filepath.IsAbs(linkTarget) {
+		linkTarget = filepath.Join(filepath.Dir(target), linkTarget)
+	}
+	if !isWithin(e.dest, filepath.Clean(linkTarget)) {
+		return fmt.Errorf("symlink %s points outside of the destination directory", target)
+	}
+
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(target)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	err = os.Symlink(link, target)
+	if err != nil {
+		return err
+	}
+
+	e.symlinks = append(e.symlinks, target)
+	return nil
+}
+
+// verifySymlinks makes sure the extracted symlinks don't resolve outside dest through other symlinks of the archive.
+// Symlinks that do are removed.
+func (e *extractor) verifySymlinks() error {
+	resolvedDest, err := filepath.EvalSymlinks(e.dest)
+	if err != nil {
+		return err
+	}
+
+	for _, symlink := range e.symlinks {
+		resolved, err := filepath.EvalSymlinks(symlink)
+		if err != nil {
+			// Dangling symlinks were already checked when they were created
+			continue
+		}
+
+		if !isWithin(resolvedDest, resolved) {
+			_ = os.Remove(symlink)
+			return fmt.Errorf("symlink %s points outside of the destination directory", symlink)
+		}
+	}
+
+	return nil
+}
+
+// safeJoin joins the entry name to dest and makes sure the result,
+// including any symlinks created by earlier entries, does not escape dest
+func safeJoin(dest, name string) (string, error) {
+	target := filepath.Join(dest, filepath.FromSlash(name))
+	if !isWithin(dest, target) {
+		return "", fmt.Errorf("archive entry %s is outside of the destination directory", name)
+	}
+
+	resolvedDest, err := filepath.EvalSymlinks(dest)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the closest existing parent and make sure it resolves inside dest
+	parent := filepath.Dir(target)
+	for {
+		resolvedParent, err := filepath.EvalSymlinks(parent)
+		if err == nil {
+			if !isWithin(resolvedDest, resolvedParent) {
+				return "", fmt.Errorf("archive entry %s is outside of the destination directory", name)
+			}
+			break
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		parent = filepath.Dir(parent)
+	}
+
+	return target, nil
+}
+
+func isWithin(dir, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
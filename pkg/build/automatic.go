@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+// AutomaticBuilder is used for builds without an explicit build configuration.
+// The builder type is detected from the repository once it is cloned.
+type AutomaticBuilder struct {
+	*Builder
+	factory *BuilderFactory
+	// Builder of the detected type, set once Build is called
+	builder IBuilder
+}
+
+func (b *AutomaticBuilder) Build(build models.Build) (string, string, error) {
+	builderType, err := detect.DetectWorkspaceBuilderType(build.BuildConfig, b.workspaceDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	var builder IBuilder
+
+	switch builderType {
+	case detect.BuilderTypeDevcontainer:
+		builder, err = b.factory.newDevcontainerBuilder(b.workspaceDir)
+	case detect.BuilderTypeDockerfile:
+		builder, err = b.factory.newDockerfileBuilder(b.workspaceDir)
+	case detect.BuilderTypeNix:
+		builder, err = b.factory.newNixBuilder(b.workspaceDir)
+	case detect.BuilderTypeBuildpacks:
+		builder, err = b.factory.newBuildpacksBuilder(b.workspaceDir)
+	default:
+		return "", "", errors.New("failed to detect a devcontainer config, a Dockerfile, a Nix file or a buildpacks project")
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	b.builder = builder
+
+	return builder.Build(build)
+}
+
+func (b *AutomaticBuilder) Publish(build models.Build) error {
+	if b.builder == nil {
+		return b.Builder.Publish(build)
+	}
+
+	return b.builder.Publish(build)
+}
+
+func (b *AutomaticBuilder) GetCacheStats() *models.BuildCacheStats {
+	if b.builder == nil {
+		return nil
+	}
+
+	return b.builder.GetCacheStats()
+}
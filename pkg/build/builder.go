@@ -6,11 +6,16 @@ package build
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/client"
 )
 
 type IBuilder interface {
@@ -18,6 +23,7 @@ type IBuilder interface {
 	CleanUp() error
 	Publish(build models.Build) error
 	GetImageName(build models.Build) (string, error)
+	GetCacheStats() *models.BuildCacheStats
 }
 
 type Builder struct {
@@ -31,6 +37,11 @@ type Builder struct {
 	loggerFactory         logs.ILoggerFactory
 	defaultWorkspaceImage string
 	defaultWorkspaceUser  string
+
+	// Set by the builders that record layer cache usage
+	cacheStats *models.BuildCacheStats
+	// Image holding the layer cache of the build, published along with the build image
+	cacheImage string
 }
 
 func (b *Builder) GetImageName(build models.Build) (string, error) {
@@ -49,3 +60,81 @@ func (b *Builder) GetImageName(build models.Build) (string, error) {
 
 	return imageName, nil
 }
+
+// getBaseImage returns the image and user extended by the builders that do not define their own base image
+func (b *Builder) getBaseImage(build models.Build) (string, string) {
+	if build.ContainerConfig.Image == "" || build.ContainerConfig.User == "" {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser
+	}
+
+	return build.ContainerConfig.Image, build.ContainerConfig.User
+}
+
+// buildFallbackImage tags the base image with the build image name so the build can
+// still be published when the repository can not be built
+func (b *Builder) buildFallbackImage(dockerClient docker.IDockerClient, imageName, baseImage string, logWriter io.Writer) (string, error) {
+	return dockerClient.BuildImage(docker.BuildImageOptions{
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: "Dockerfile",
+		},
+		DockerfileContent:   fmt.Sprintf("FROM %s\n", baseImage),
+		ImageName:           imageName,
+		ContainerRegistries: b.containerRegistries,
+		LogWriter:           logWriter,
+	})
+}
+
+func (b *Builder) CleanUp() error {
+	return os.RemoveAll(b.workspaceDir)
+}
+
+func (b *Builder) Publish(build models.Build) error {
+	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
+	if err != nil {
+		return err
+	}
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	if build.Image == nil {
+		return errors.New("build image is nil")
+	}
+
+	err = dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
+	if err != nil {
+		return err
+	}
+
+	if b.cacheImage != "" {
+		// The build image is already published, a missing cache only slows down the next build
+		err = dockerClient.PushImage(b.cacheImage, b.buildImageContainerRegistry, buildLogger)
+		if err != nil {
+			buildLogger.Write([]byte(fmt.Sprintf("Failed to publish the build cache: %s\n", err)))
+		}
+	}
+
+	return nil
+}
+
+func (b *Builder) GetCacheStats() *models.BuildCacheStats {
+	return b.cacheStats
+}
+
+// setCacheStats records the layer cache usage counted by the build output writer
+func (b *Builder) setCacheStats(cacheFrom []string, writer *docker.BuildCacheStatsWriter) {
+	cachedSteps, totalSteps := writer.Stats()
+
+	b.cacheStats = &models.BuildCacheStats{
+		CacheFrom:   cacheFrom,
+		CachedSteps: cachedSteps,
+		TotalSteps:  totalSteps,
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/client"
+)
+
+type BuildpacksBuilder struct {
+	*Builder
+}
+
+func (b *BuildpacksBuilder) Build(build models.Build) (string, string, error) {
+	builderType, err := detect.DetectWorkspaceBuilderType(build.BuildConfig, b.workspaceDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeBuildpacks {
+		return "", "", errors.New("repository has a build configuration, buildpacks are not used")
+	}
+
+	return b.buildBuildpacks(build)
+}
+
+// buildBuildpacks builds the repository with buildpacks and adds the workspace user to the image.
+// If buildpacks can not build the repository, the base image is published under the build image name instead.
+func (b *BuildpacksBuilder) buildBuildpacks(build models.Build) (string, string, error) {
+	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	baseImage, user := b.getBaseImage(build)
+
+	cacheFrom := ""
+	if build.BuildConfig.CachedBuild != nil {
+		cacheFrom = build.BuildConfig.CachedBuild.Image
+	}
+
+	err = dockerClient.BuildFromBuildpacks(docker.BuildpacksOptions{
+		WorkspaceDir:        b.workspaceDir,
+		Buildpacks:          build.BuildConfig.Buildpacks,
+		ImageName:           imageName,
+		User:                user,
+		CacheFrom:           cacheFrom,
+		ContainerRegistries: b.containerRegistries,
+		Labels: map[string]string{
+			"daytona.build.id": build.Id,
+		},
+		LogWriter: buildLogger,
+	})
+	if err == nil {
+		return imageName, user, nil
+	}
+
+	buildLogger.Write([]byte(fmt.Sprintf("Failed to build the repository with buildpacks: %v. Falling back to %s\n", err, baseImage)))
+
+	user, err = b.buildFallbackImage(dockerClient, imageName, baseImage, buildLogger)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	return imageName, user, nil
+}
@@ -16,9 +16,32 @@ type BuilderType string
 
 var (
 	BuilderTypeDevcontainer BuilderType = "devcontainer"
+	BuilderTypeDockerfile   BuilderType = "dockerfile"
+	BuilderTypeNix          BuilderType = "nix"
+	BuilderTypeBuildpacks   BuilderType = "buildpacks"
 	BuilderTypeImage        BuilderType = "image"
 )
 
+var dockerfileNames = []string{"Dockerfile", "Containerfile"}
+
+var nixFileNames = []string{"flake.nix", "devenv.nix", "shell.nix"}
+
+// Files that are detected by the default buildpacks builder
+var buildpacksFileNames = []string{
+	"project.toml",
+	"package.json",
+	"go.mod",
+	"requirements.txt",
+	"pyproject.toml",
+	"Pipfile",
+	"setup.py",
+	"pom.xml",
+	"build.gradle",
+	"build.gradle.kts",
+	"Gemfile",
+	"composer.json",
+}
+
 func DetectWorkspaceBuilderType(buildConfig *models.BuildConfig, workspaceDir string, sshClient *ssh.Client) (BuilderType, error) {
 	if buildConfig == nil {
 		return BuilderTypeImage, nil
@@ -28,6 +51,18 @@ func DetectWorkspaceBuilderType(buildConfig *models.BuildConfig, workspaceDir st
 		return BuilderTypeDevcontainer, nil
 	}
 
+	if buildConfig.Dockerfile != nil {
+		return BuilderTypeDockerfile, nil
+	}
+
+	if buildConfig.Nix != nil {
+		return BuilderTypeNix, nil
+	}
+
+	if buildConfig.Buildpacks != nil {
+		return BuilderTypeBuildpacks, nil
+	}
+
 	if sshClient != nil {
 		if _, err := sshClient.ReadFile(path.Join(workspaceDir, ".devcontainer/devcontainer.json")); err == nil {
 			buildConfig.Devcontainer = &models.DevcontainerConfig{
@@ -41,6 +76,28 @@ func DetectWorkspaceBuilderType(buildConfig *models.BuildConfig, workspaceDir st
 			}
 			return BuilderTypeDevcontainer, nil
 		}
+		for _, dockerfileName := range dockerfileNames {
+			if _, err := sshClient.ReadFile(path.Join(workspaceDir, dockerfileName)); err == nil {
+				buildConfig.Dockerfile = &models.DockerfileConfig{
+					FilePath: dockerfileName,
+				}
+				return BuilderTypeDockerfile, nil
+			}
+		}
+		for _, nixFileName := range nixFileNames {
+			if _, err := sshClient.ReadFile(path.Join(workspaceDir, nixFileName)); err == nil {
+				buildConfig.Nix = &models.NixConfig{
+					FilePath: nixFileName,
+				}
+				return BuilderTypeNix, nil
+			}
+		}
+		for _, buildpacksFileName := range buildpacksFileNames {
+			if _, err := sshClient.ReadFile(path.Join(workspaceDir, buildpacksFileName)); err == nil {
+				buildConfig.Buildpacks = &models.BuildpacksConfig{}
+				return BuilderTypeBuildpacks, nil
+			}
+		}
 	} else {
 		if devcontainerFilePath, pathError := findDevcontainerConfigFilePath(workspaceDir); pathError == nil {
 			buildConfig.Devcontainer = &models.DevcontainerConfig{
@@ -49,11 +106,54 @@ func DetectWorkspaceBuilderType(buildConfig *models.BuildConfig, workspaceDir st
 
 			return BuilderTypeDevcontainer, nil
 		}
+
+		for _, dockerfileName := range dockerfileNames {
+			if exists, err := fileExists(filepath.Join(workspaceDir, dockerfileName)); err == nil && exists {
+				buildConfig.Dockerfile = &models.DockerfileConfig{
+					FilePath: dockerfileName,
+				}
+				return BuilderTypeDockerfile, nil
+			}
+		}
+
+		for _, nixFileName := range nixFileNames {
+			if exists, err := fileExists(filepath.Join(workspaceDir, nixFileName)); err == nil && exists {
+				buildConfig.Nix = &models.NixConfig{
+					FilePath: nixFileName,
+				}
+				return BuilderTypeNix, nil
+			}
+		}
+
+		for _, buildpacksFileName := range buildpacksFileNames {
+			if exists, err := fileExists(filepath.Join(workspaceDir, buildpacksFileName)); err == nil && exists {
+				buildConfig.Buildpacks = &models.BuildpacksConfig{}
+				return BuilderTypeBuildpacks, nil
+			}
+		}
 	}
 
 	return BuilderTypeImage, nil
 }
 
+// GetDevcontainerConfigFilePath returns the path of the devcontainer config a workspace is built from.
+// Automatic build configs are resolved the same way the builders resolve them. The result is false
+// if the workspace is not built from a devcontainer config.
+func GetDevcontainerConfigFilePath(buildConfig *models.BuildConfig, workspaceDir string) (string, bool) {
+	if buildConfig == nil {
+		return "", false
+	}
+
+	// The detection fills in the config of the detected builder, which must not leak into the workspace
+	detectedConfig := *buildConfig
+	builderType, err := DetectWorkspaceBuilderType(&detectedConfig, workspaceDir, nil)
+	if err != nil || builderType != BuilderTypeDevcontainer {
+		return "", false
+	}
+
+	return detectedConfig.Devcontainer.FilePath, true
+}
+
 func findDevcontainerConfigFilePath(workspaceDir string) (string, error) {
 	devcontainerPath := ".devcontainer/devcontainer.json"
 	isDevcontainer, err := fileExists(filepath.Join(workspaceDir, devcontainerPath))
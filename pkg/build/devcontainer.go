@@ -6,7 +6,7 @@ package build
 import (
 	"context"
 	"errors"
-	"os"
+	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/docker"
@@ -41,33 +41,6 @@ func (b *DevcontainerBuilder) Build(build models.Build) (string, string, error)
 	return b.buildDevcontainer(build)
 }
 
-func (b *DevcontainerBuilder) CleanUp() error {
-	return os.RemoveAll(b.workspaceDir)
-}
-
-func (b *DevcontainerBuilder) Publish(build models.Build) error {
-	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
-	if err != nil {
-		return err
-	}
-	defer buildLogger.Close()
-
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		return err
-	}
-
-	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
-		ApiClient: cli,
-	})
-
-	if build.Image == nil {
-		return errors.New("build image is nil")
-	}
-
-	return dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
-}
-
 func (b *DevcontainerBuilder) buildDevcontainer(build models.Build) (string, string, error) {
 	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
 	if err != nil {
@@ -90,6 +63,15 @@ func (b *DevcontainerBuilder) buildDevcontainer(build models.Build) (string, str
 		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
 	}
 
+	// The committed image of the cached build is used by CreateFromDevcontainer, its
+	// cache image additionally holds the layer cache of the devcontainer image build
+	cacheFrom := []string{}
+	if build.BuildConfig.CachedBuild != nil {
+		cacheFrom = append(cacheFrom, models.GetBuildCacheImage(build.BuildConfig.CachedBuild.Image))
+	}
+
+	cacheStatsWriter := docker.NewBuildCacheStatsWriter(buildLogger)
+
 	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(docker.CreateDevcontainerOptions{
 		BuildConfig:         build.BuildConfig,
 		WorkspaceFolderName: build.Id,
@@ -100,8 +82,11 @@ func (b *DevcontainerBuilder) buildDevcontainer(build models.Build) (string, str
 			"daytona.build.id": build.Id,
 		},
 		WorkspaceDir: b.workspaceDir,
-		LogWriter:    buildLogger,
+		LogWriter:    cacheStatsWriter,
 		EnvVars:      build.EnvVars,
+		CacheFrom:    cacheFrom,
+		// Inline cache metadata is stored in the image so it works with the default BuildKit driver
+		CacheTo: "type=inline",
 	})
 	if err != nil {
 		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
@@ -109,17 +94,37 @@ func (b *DevcontainerBuilder) buildDevcontainer(build models.Build) (string, str
 
 	defer dockerClient.RemoveContainer(containerId) // nolint: errcheck
 
+	if build.BuildConfig.CachedBuild != nil {
+		cacheFrom = append([]string{build.BuildConfig.CachedBuild.Image}, cacheFrom...)
+	}
+	b.setCacheStats(cacheFrom, cacheStatsWriter)
+
 	imageName, err := b.GetImageName(build)
 	if err != nil {
 		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
 	}
 
-	_, err = cli.ContainerCommit(context.Background(), containerId, container.CommitOptions{
+	ctx := context.Background()
+
+	_, err = cli.ContainerCommit(ctx, containerId, container.CommitOptions{
 		Reference: imageName,
 	})
 	if err != nil {
 		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
 	}
 
-	return imageName, string(remoteUser), err
+	// The committed image loses the inline cache, so the devcontainer image is published as the cache of the build
+	c, err := cli.ContainerInspect(ctx, containerId)
+	if err == nil {
+		cacheImage := models.GetBuildCacheImage(imageName)
+		err = cli.ImageTag(ctx, c.Image, cacheImage)
+		if err == nil {
+			b.cacheImage = cacheImage
+		}
+	}
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Failed to tag the build cache image: %s\n", err)))
+	}
+
+	return imageName, string(remoteUser), nil
 }
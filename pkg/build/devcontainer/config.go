@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tailscale/hujson"
+)
+
+// ForwardPort is an entry of forwardPorts, either a port number or a "host:port" string
+type ForwardPort struct {
+	Host string
+	Port int
+}
+
+func (p *ForwardPort) UnmarshalJSON(data []byte) error {
+	var port int
+	if err := json.Unmarshal(data, &port); err == nil {
+		p.Host = ""
+		p.Port = port
+		return nil
+	}
+
+	var hostPort string
+	err := json.Unmarshal(data, &hostPort)
+	if err != nil {
+		return fmt.Errorf("invalid forward port %s", string(data))
+	}
+
+	host, portString, found := strings.Cut(hostPort, ":")
+	if !found {
+		host, portString = "", hostPort
+	}
+
+	port, err = strconv.Atoi(portString)
+	if err != nil {
+		return fmt.Errorf("invalid forward port %s", hostPort)
+	}
+
+	p.Host = host
+	p.Port = port
+
+	return nil
+}
+
+func (p ForwardPort) MarshalJSON() ([]byte, error) {
+	if p.Host == "" {
+		return json.Marshal(p.Port)
+	}
+
+	return json.Marshal(fmt.Sprintf("%s:%d", p.Host, p.Port))
+}
+
+// IsLocal reports whether the port is exposed by the workspace container rather than another service
+func (p ForwardPort) IsLocal() bool {
+	return p.Host == "" || p.Host == "localhost" || p.Host == "127.0.0.1"
+}
+
+// ReadConfiguration reads a devcontainer.json file, which may contain comments and trailing commas
+func ReadConfiguration(path string) (*Configuration, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read devcontainer config: %w", err)
+	}
+
+	content, err = hujson.Standardize(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse devcontainer config: %w", err)
+	}
+
+	var config Configuration
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse devcontainer config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// GetPortAttributes returns the portsAttributes entry matching the port, keys are either
+// a port number or a port range, e.g. "3000" or "40000-55000"
+func (c *Configuration) GetPortAttributes(port int) *PortAttributes {
+	if attributes, ok := c.PortsAttributes[strconv.Itoa(port)]; ok {
+		return &attributes
+	}
+
+	// Overlapping ranges are resolved by key order
+	keys := make([]string, 0, len(c.PortsAttributes))
+	for key := range c.PortsAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attributes := c.PortsAttributes[key]
+		start, end, found := strings.Cut(key, "-")
+		if !found {
+			continue
+		}
+
+		startPort, err := strconv.Atoi(strings.TrimSpace(start))
+		if err != nil {
+			continue
+		}
+
+		endPort, err := strconv.Atoi(strings.TrimSpace(end))
+		if err != nil {
+			continue
+		}
+
+		if port >= startPort && port <= endPort {
+			return &attributes
+		}
+	}
+
+	return nil
+}
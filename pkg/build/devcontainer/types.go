@@ -4,21 +4,22 @@
 package devcontainer
 
 type Configuration struct {
-	Name                 string                 `json:"name"`
-	DockerFile           string                 `json:"dockerFile"`
-	RunArgs              []string               `json:"runArgs"`
-	InitializeCommand    Command                `json:"initializeCommand"`
-	OnCreateCommand      Command                `json:"onCreateCommand"`
-	UpdateContentCommand Command                `json:"updateContentCommand"`
-	PostCreateCommand    Command                `json:"postCreateCommand"`
-	PostStartCommand     Command                `json:"postStartCommand"`
-	PostAttachCommand    Command                `json:"postAttachCommand"`
-	WaitFor              WaitFor                `json:"waitFor"`
-	RemoteUser           string                 `json:"remoteUser"`
-	Features             map[string]interface{} `json:"features"`
-	ForwardPorts         []int                  `json:"forwardPorts"`
-	Customizations       map[string]interface{} `json:"customizations"`
-	ConfigFilePath       ConfigFilePath         `json:"configFilePath"`
+	Name                 string                    `json:"name"`
+	DockerFile           string                    `json:"dockerFile"`
+	RunArgs              []string                  `json:"runArgs"`
+	InitializeCommand    Command                   `json:"initializeCommand"`
+	OnCreateCommand      Command                   `json:"onCreateCommand"`
+	UpdateContentCommand Command                   `json:"updateContentCommand"`
+	PostCreateCommand    Command                   `json:"postCreateCommand"`
+	PostStartCommand     Command                   `json:"postStartCommand"`
+	PostAttachCommand    Command                   `json:"postAttachCommand"`
+	WaitFor              WaitFor                   `json:"waitFor"`
+	RemoteUser           string                    `json:"remoteUser"`
+	Features             map[string]interface{}    `json:"features"`
+	ForwardPorts         []ForwardPort             `json:"forwardPorts"`
+	PortsAttributes      map[string]PortAttributes `json:"portsAttributes"`
+	Customizations       map[string]interface{}    `json:"customizations"`
+	ConfigFilePath       ConfigFilePath            `json:"configFilePath"`
 }
 
 type Command interface{}
@@ -109,7 +110,7 @@ type MergedConfiguration struct {
 	RunArgs         []string                  `json:"runArgs"`
 	RemoteUser      string                    `json:"remoteUser"`
 	Features        map[string]interface{}    `json:"features"`
-	ForwardPorts    []int                     `json:"forwardPorts"`
+	ForwardPorts    []ForwardPort             `json:"forwardPorts"`
 	ConfigFilePath  ConfigFilePath            `json:"configFilePath"`
 	Init            bool                      `json:"init"`
 	Privileged      bool                      `json:"privileged"`
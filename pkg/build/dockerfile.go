@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/client"
+)
+
+type DockerfileBuilder struct {
+	*Builder
+}
+
+func (b *DockerfileBuilder) Build(build models.Build) (string, string, error) {
+	builderType, err := detect.DetectWorkspaceBuilderType(build.BuildConfig, b.workspaceDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeDockerfile {
+		return "", "", errors.New("failed to detect Dockerfile")
+	}
+
+	return b.buildDockerfile(build)
+}
+
+func (b *DockerfileBuilder) buildDockerfile(build models.Build) (string, string, error) {
+	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	cacheFrom := []string{}
+	if build.BuildConfig.CachedBuild != nil {
+		cacheFrom = append(cacheFrom, build.BuildConfig.CachedBuild.Image)
+	}
+
+	cacheStatsWriter := docker.NewBuildCacheStatsWriter(buildLogger)
+
+	user, err := dockerClient.BuildImage(docker.BuildImageOptions{
+		WorkspaceDir:        b.workspaceDir,
+		Dockerfile:          build.BuildConfig.Dockerfile,
+		ImageName:           imageName,
+		CacheFrom:           cacheFrom,
+		ContainerRegistries: b.containerRegistries,
+		Labels: map[string]string{
+			"daytona.build.id": build.Id,
+		},
+		LogWriter: cacheStatsWriter,
+	})
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	b.setCacheStats(cacheFrom, cacheStatsWriter)
+
+	return imageName, user, nil
+}
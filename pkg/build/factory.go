@@ -50,8 +50,26 @@ func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
 }
 
 func (f *BuilderFactory) Create(build models.Build, workspaceDir string) (IBuilder, error) {
-	// TODO: Implement factory logic after adding prebuilds and other builder types
-	return f.newDevcontainerBuilder(workspaceDir)
+	if build.BuildConfig != nil && build.BuildConfig.Dockerfile != nil {
+		return f.newDockerfileBuilder(workspaceDir)
+	}
+
+	if build.BuildConfig != nil && build.BuildConfig.Nix != nil {
+		return f.newNixBuilder(workspaceDir)
+	}
+
+	if build.BuildConfig != nil && build.BuildConfig.Buildpacks != nil {
+		return f.newBuildpacksBuilder(workspaceDir)
+	}
+
+	if build.BuildConfig != nil && build.BuildConfig.Devcontainer != nil {
+		return f.newDevcontainerBuilder(workspaceDir)
+	}
+
+	return &AutomaticBuilder{
+		Builder: f.newBuilder("automatic-builder", workspaceDir),
+		factory: f,
+	}, nil
 }
 
 func (f *BuilderFactory) newDevcontainerBuilder(workspaceDir string) (*DevcontainerBuilder, error) {
@@ -60,22 +78,44 @@ func (f *BuilderFactory) newDevcontainerBuilder(workspaceDir string) (*Devcontai
 		return nil, err
 	}
 
-	id := stringid.GenerateRandomID()
-	id = stringid.TruncateID(id)
-	id = fmt.Sprintf("%s-%s", "devcontainer-builder", id)
-
 	return &DevcontainerBuilder{
-		Builder: &Builder{
-			id:                          id,
-			workspaceDir:                workspaceDir,
-			image:                       f.image,
-			containerRegistries:         f.containerRegistries,
-			buildImageContainerRegistry: f.buildImageContainerRegistry,
-			buildImageNamespace:         f.buildImageNamespace,
-			loggerFactory:               f.loggerFactory,
-			defaultWorkspaceImage:       f.defaultWorkspaceImage,
-			defaultWorkspaceUser:        f.defaultWorkspaceUser,
-		},
+		Builder:           f.newBuilder("devcontainer-builder", workspaceDir),
 		builderDockerPort: builderDockerPort,
 	}, nil
 }
+
+func (f *BuilderFactory) newDockerfileBuilder(workspaceDir string) (*DockerfileBuilder, error) {
+	return &DockerfileBuilder{
+		Builder: f.newBuilder("dockerfile-builder", workspaceDir),
+	}, nil
+}
+
+func (f *BuilderFactory) newNixBuilder(workspaceDir string) (*NixBuilder, error) {
+	return &NixBuilder{
+		Builder: f.newBuilder("nix-builder", workspaceDir),
+	}, nil
+}
+
+func (f *BuilderFactory) newBuildpacksBuilder(workspaceDir string) (*BuildpacksBuilder, error) {
+	return &BuildpacksBuilder{
+		Builder: f.newBuilder("buildpacks-builder", workspaceDir),
+	}, nil
+}
+
+func (f *BuilderFactory) newBuilder(idPrefix, workspaceDir string) *Builder {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+	id = fmt.Sprintf("%s-%s", idPrefix, id)
+
+	return &Builder{
+		id:                          id,
+		workspaceDir:                workspaceDir,
+		image:                       f.image,
+		containerRegistries:         f.containerRegistries,
+		buildImageContainerRegistry: f.buildImageContainerRegistry,
+		buildImageNamespace:         f.buildImageNamespace,
+		loggerFactory:               f.loggerFactory,
+		defaultWorkspaceImage:       f.defaultWorkspaceImage,
+		defaultWorkspaceUser:        f.defaultWorkspaceUser,
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/client"
+)
+
+type NixBuilder struct {
+	*Builder
+}
+
+func (b *NixBuilder) Build(build models.Build) (string, string, error) {
+	builderType, err := detect.DetectWorkspaceBuilderType(build.BuildConfig, b.workspaceDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeNix {
+		return "", "", errors.New("failed to detect Nix file")
+	}
+
+	return b.buildNix(build)
+}
+
+// buildNix bakes the Nix dev shell into the workspace image.
+// If the dev shell can not be built, the base image is published under the build image name instead.
+func (b *NixBuilder) buildNix(build models.Build) (string, string, error) {
+	buildLogger, err := b.loggerFactory.CreateLogger(build.Id, build.Id, logs.LogSourceBuilder)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	baseImage, baseUser := b.getBaseImage(build)
+
+	dockerfile, err := docker.GetNixDockerfile(build.BuildConfig.Nix, baseImage, baseUser)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	buildImageOptions := docker.BuildImageOptions{
+		WorkspaceDir: b.workspaceDir,
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: docker.NIX_DOCKERFILE_PATH,
+		},
+		DockerfileContent:   dockerfile,
+		ImageName:           imageName,
+		ContainerRegistries: b.containerRegistries,
+		Labels: map[string]string{
+			"daytona.build.id": build.Id,
+		},
+		LogWriter: buildLogger,
+	}
+
+	if build.BuildConfig.CachedBuild != nil {
+		buildImageOptions.CacheFrom = []string{build.BuildConfig.CachedBuild.Image}
+	}
+
+	user, err := dockerClient.BuildImage(buildImageOptions)
+	if err == nil {
+		return imageName, user, nil
+	}
+
+	buildLogger.Write([]byte(fmt.Sprintf("Failed to build the Nix dev shell: %v. Falling back to %s\n", err, baseImage)))
+
+	user, err = b.buildFallbackImage(dockerClient, imageName, baseImage, buildLogger)
+	if err != nil {
+		return b.defaultWorkspaceImage, b.defaultWorkspaceUser, err
+	}
+
+	return imageName, user, nil
+}
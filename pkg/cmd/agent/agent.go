@@ -15,6 +15,7 @@ import (
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	"github.com/daytonaio/daytona/pkg/agent"
 	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agent/lifecycle"
 	"github.com/daytonaio/daytona/pkg/agent/ssh"
 	"github.com/daytonaio/daytona/pkg/agent/tailscale"
 	"github.com/daytonaio/daytona/pkg/agent/toolbox"
@@ -22,6 +23,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/models"
 	log "github.com/sirupsen/logrus"
 
@@ -90,6 +92,17 @@ var AgentCmd = &cobra.Command{
 			agentLogWriter = logFile
 		}
 
+		additionalGit := map[string]git.IGitService{}
+		if ws != nil {
+			for _, additionalRepository := range ws.AdditionalRepositories {
+				additionalGit[additionalRepository.ClonePath] = &git.Service{
+					WorkspaceDir:      filepath.Join(filepath.Dir(c.WorkspaceDir), filepath.FromSlash(additionalRepository.ClonePath)),
+					GitConfigFileName: filepath.Join(os.Getenv("HOME"), ".gitconfig"),
+					LogWriter:         gitLogWriter,
+				}
+			}
+		}
+
 		git := &git.Service{
 			WorkspaceDir:      c.WorkspaceDir,
 			GitConfigFileName: filepath.Join(os.Getenv("HOME"), ".gitconfig"),
@@ -112,9 +125,29 @@ var AgentCmd = &cobra.Command{
 			tailscaleHostname = common.GetTailscaleHostname(ws.Id)
 		}
 
+		err = os.MkdirAll(configDir, 0755)
+		if err != nil {
+			return err
+		}
+
+		auditLogFile, err := os.OpenFile(filepath.Join(configDir, "toolbox-audit.log"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer auditLogFile.Close()
+
+		auditLogWriters := []io.Writer{auditLogFile}
+		if agentMode == agent_config.ModeWorkspace {
+			remoteAuditLogWriter := logs.NewRemoteLogWriter(c.Server.ApiUrl, c.Server.ApiKey, logs.ApiBasePathToolboxAudit, ws.Id)
+			defer remoteAuditLogWriter.Close()
+			auditLogWriters = append(auditLogWriters, remoteAuditLogWriter)
+		}
+
 		toolBoxServer := &toolbox.Server{
-			WorkspaceDir: c.WorkspaceDir,
-			ConfigDir:    configDir,
+			WorkspaceDir:    c.WorkspaceDir,
+			ConfigDir:       configDir,
+			ApiKey:          c.Server.ApiKey,
+			AuditLogWriters: auditLogWriters,
 		}
 
 		tailscaleServer := &tailscale.Server{
@@ -124,13 +157,46 @@ var AgentCmd = &cobra.Command{
 			ClientId:         c.ClientId,
 		}
 
+		var lifecycleHooks agent.LifecycleHookRunner
+		if ws != nil && ws.BuildConfig != nil {
+			lifecycleLogWriters := []io.Writer{os.Stdout}
+			if agentLogWriter != nil {
+				lifecycleLogWriters = append(lifecycleLogWriters, agentLogWriter)
+			}
+
+			loggerFactory := logs.NewLoggerFactory(logs.LoggerFactoryConfig{
+				LogsDir:     filepath.Join(configDir, "logs"),
+				ApiUrl:      &c.Server.ApiUrl,
+				ApiKey:      &c.Server.ApiKey,
+				ApiBasePath: &logs.ApiBasePathWorkspace,
+			})
+
+			// Lifecycle command output is streamed to the workspace log
+			workspaceLogger, err := loggerFactory.CreateLogger(ws.Id, ws.Name, logs.LogSourceAgent)
+			if err != nil {
+				log.Errorf("Failed to create workspace logger: %s", err)
+			} else {
+				defer workspaceLogger.Close()
+				lifecycleLogWriters = append(lifecycleLogWriters, workspaceLogger)
+			}
+
+			lifecycleHooks = &lifecycle.HookRunner{
+				WorkspaceDir: c.WorkspaceDir,
+				BuildConfig:  ws.BuildConfig,
+				StateDir:     filepath.Join(configDir, "lifecycle"),
+				LogWriter:    io.MultiWriter(lifecycleLogWriters...),
+			}
+		}
+
 		agent := agent.Agent{
 			Config:           c,
 			Git:              git,
+			AdditionalGit:    additionalGit,
 			DockerCredHelper: dockerCredHelper,
 			Ssh:              sshServer,
 			Toolbox:          toolBoxServer,
 			Tailscale:        tailscaleServer,
+			LifecycleHooks:   lifecycleHooks,
 			LogWriter:        agentLogWriter,
 			TelemetryEnabled: telemetryEnabled,
 			Workspace:        ws,
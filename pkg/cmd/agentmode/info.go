@@ -48,7 +48,7 @@ func runWorkspaceInfo() error {
 		return nil
 	}
 
-	workspaces_views.Render(workspace, "", false)
+	workspaces_views.Render(workspace, "", false, false)
 
 	return nil
 }
@@ -80,6 +80,7 @@ func GetLocalRunner(params LocalRunnerParams) (runner.IRunner, error) {
 	}
 
 	runnerService := server.GetInstance(nil).RunnerService
+	workspaceService := server.GetInstance(nil).WorkspaceService
 
 	workspaceJobFactory, err := getLocalWorkspaceJobFactory(jobFactoryParams)
 	if err != nil {
@@ -135,10 +136,23 @@ func GetLocalRunner(params LocalRunnerParams) (runner.IRunner, error) {
 				RunningJobs: metadata.RunningJobs,
 			})
 		},
-		WorkspaceJobFactory: workspaceJobFactory,
-		TargetJobFactory:    targetJobFactory,
-		BuildJobFactory:     buildJobFactory,
-		RunnerJobFactory:    runnerJobFactory,
+		ListWorkspaces: func(ctx context.Context) ([]*models.Workspace, error) {
+			workspaceDtos, err := workspaceService.List(ctx, services.WorkspaceRetrievalParams{})
+			if err != nil {
+				return nil, err
+			}
+
+			var workspaces []*models.Workspace
+			for _, workspaceDto := range workspaceDtos {
+				workspaces = append(workspaces, &workspaceDto.Workspace)
+			}
+			return workspaces, nil
+		},
+		UpdateWorkspaceProviderMetadata: workspaceService.UpdateProviderMetadata,
+		WorkspaceJobFactory:             workspaceJobFactory,
+		TargetJobFactory:                targetJobFactory,
+		BuildJobFactory:                 buildJobFactory,
+		RunnerJobFactory:                runnerJobFactory,
 	}), nil
 }
 
@@ -263,6 +277,7 @@ func getLocalBuildJobFactory(params LocalJobFactoryParams) (jobs_build.IBuildJob
 				},
 			})
 		},
+		UpdateBuildResult: buildService.UpdateResult,
 		ListSuccessfulBuilds: func(ctx context.Context, repoUrl string) ([]*models.Build, error) {
 			buildDtos, err := buildService.List(ctx, &services.BuildFilter{
 				StateNames: &[]models.ResourceStateName{models.ResourceStateNameRunSuccessful},
@@ -182,6 +182,28 @@ func GetRemoteRunner(params RemoteRunnerParams) (runner.IRunner, error) {
 			_, err := params.ApiClient.RunnerAPI.UpdateRunnerMetadata(ctx, runnerId).RunnerMetadata(runnerMetadata).Execute()
 			return err
 		},
+		ListWorkspaces: func(ctx context.Context) ([]*models.Workspace, error) {
+			workspaceDtos, _, err := params.ApiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			var workspaces []*models.Workspace
+			for _, workspaceDto := range workspaceDtos {
+				w, err := conversion.Convert[apiclient.WorkspaceDTO, models.Workspace](&workspaceDto)
+				if err != nil {
+					return nil, err
+				}
+				workspaces = append(workspaces, w)
+			}
+			return workspaces, nil
+		},
+		UpdateWorkspaceProviderMetadata: func(ctx context.Context, workspaceId, metadata string) error {
+			_, err := params.ApiClient.WorkspaceAPI.UpdateWorkspaceProviderMetadata(ctx, workspaceId).Metadata(apiclient.UpdateWorkspaceProviderMetadataDTO{
+				Metadata: metadata,
+			}).Execute()
+			return err
+		},
 		WorkspaceJobFactory: workspaceJobFactory,
 		TargetJobFactory:    targetJobFactory,
 		BuildJobFactory:     buildJobFactory,
@@ -415,6 +437,15 @@ func getRemoteBuildJobFactory(params RemoteJobFactoryParams) (jobs_build.IBuildJ
 
 			return conversion.Convert[apiclient.BuildDTO, services.BuildDTO](build)
 		},
+		UpdateBuildResult: func(ctx context.Context, buildId string, result services.UpdateBuildResultDTO) error {
+			updateBuildResultDto, err := conversion.Convert[services.UpdateBuildResultDTO, apiclient.UpdateBuildResultDTO](&result)
+			if err != nil {
+				return err
+			}
+
+			_, err = params.ApiClient.RunnerAPI.UpdateBuildResult(ctx, params.RunnerConfig.Id, buildId).Result(*updateBuildResultDto).Execute()
+			return err
+		},
 		ListSuccessfulBuilds: func(ctx context.Context, repoUrl string) ([]*models.Build, error) {
 			apiclientBuildDtos, _, err := params.ApiClient.BuildAPI.ListSuccessfulBuilds(ctx, url.QueryEscape(repoUrl)).Execute()
 			if err != nil {
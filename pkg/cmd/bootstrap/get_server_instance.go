@@ -114,8 +114,26 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		return nil, err
 	}
 
+	prBotEnabled := c.PrBotEnabled != nil && *c.PrBotEnabled
+	prBotLinkTemplate := ""
+	if c.PrBotLinkTemplate != nil {
+		prBotLinkTemplate = *c.PrBotLinkTemplate
+	}
+	if prBotEnabled && prBotLinkTemplate == "" {
+		log.Warn("Pull request bot is disabled because no workspace link template is configured")
+		prBotEnabled = false
+	}
+
+	buildLinkTemplate := ""
+	if c.BuildLinkTemplate != nil {
+		buildLinkTemplate = *c.BuildLinkTemplate
+	}
+
 	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
-		ConfigStore: gitProviderConfigStore,
+		ConfigStore:       gitProviderConfigStore,
+		PrBotEnabled:      prBotEnabled,
+		PrBotLinkTemplate: prBotLinkTemplate,
+		BuildLinkTemplate: buildLinkTemplate,
 		DetachWorkspaceTemplates: func(ctx context.Context, gitProviderConfigId string) error {
 			workspaceTemplates, err := workspaceTemplateStore.List(ctx, &stores.WorkspaceTemplateFilter{
 				GitProviderConfigId: &gitProviderConfigId,
@@ -140,6 +158,11 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		},
 	})
 
+	err = gitProviderService.StartTokenHealthPoller(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
 	jobService := jobs.NewJobService(jobs.JobServiceConfig{
 		JobStore: jobStore,
 		TrackTelemetryEvent: func(event telemetry.Event, clientId string) error {
@@ -160,6 +183,33 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 
 			return buildService.UpdateLastJob(ctx, buildId, jobId)
 		},
+		HandleBuildJobStateUpdate: func(ctx context.Context, job *models.Job) {
+			if job.Action != models.JobActionRun {
+				return
+			}
+
+			build, err := buildStore.Find(ctx, &stores.BuildFilter{
+				Id: &job.ResourceId,
+			})
+			if err != nil {
+				log.Error(err)
+				return
+			}
+
+			if build.PrebuildId == nil || *build.PrebuildId == "" {
+				return
+			}
+
+			err = gitProviderService.SetPrebuildCommitStatus(ctx, build.Repository, build.Id, job.State)
+			if err != nil {
+				log.Error(err)
+			}
+
+			err = gitProviderService.UpdatePrBotComment(ctx, build.Repository, job.State)
+			if err != nil {
+				log.Error(err)
+			}
+		},
 	})
 
 	buildService := builds.NewBuildService(builds.BuildServiceConfig{
@@ -169,15 +219,21 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 				Name: &name,
 			})
 		},
-		GetRepositoryContext: func(ctx context.Context, url, branch string) (*gitprovider.GitRepository, error) {
+		GetRepositoryContext: func(ctx context.Context, url, branch string, prNumber *uint32) (*gitprovider.GitRepository, error) {
 			gitProvider, _, err := gitProviderService.GetGitProviderForUrl(ctx, url)
 			if err != nil {
 				return nil, err
 			}
 
-			repo, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
-				Url: url,
-			})
+			repoContext := gitprovider.GetRepositoryContext{
+				Url:      url,
+				PrNumber: prNumber,
+			}
+			if branch != "" {
+				repoContext.Branch = &branch
+			}
+
+			repo, err := gitProvider.GetRepositoryContext(repoContext)
 
 			return repo, err
 		},
@@ -189,6 +245,73 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 				State:        models.JobStatePending,
 			})
 		},
+		ListWorkspaceImages: func(ctx context.Context) ([]string, error) {
+			workspaceDtos, err := server.GetInstance(nil).WorkspaceService.List(ctx, services.WorkspaceRetrievalParams{})
+			if err != nil {
+				return nil, err
+			}
+
+			var images []string
+			for _, w := range workspaceDtos {
+				images = append(images, w.Image)
+			}
+
+			return images, nil
+		},
+		ListPrebuildRetentions: func(ctx context.Context) (map[string]int, error) {
+			prebuilds, err := server.GetInstance(nil).WorkspaceTemplateService.ListPrebuilds(ctx, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			retentions := map[string]int{}
+			for _, prebuild := range prebuilds {
+				retentions[prebuild.Id] = prebuild.Retention
+			}
+
+			return retentions, nil
+		},
+		PruneRegistry: func(ctx context.Context, referencedImages []string, dryRun bool) (*services.BuildPruneResult, error) {
+			localContainerRegistry := server.GetInstance(nil).LocalContainerRegistry
+
+			pruneConfig := registry.PruneConfig{
+				Namespace:        c.BuildImageNamespace,
+				ReferencedImages: referencedImages,
+				DryRun:           dryRun,
+			}
+
+			if localContainerRegistry != nil {
+				pruneConfig.RegistryUrl = fmt.Sprintf("http://localhost:%d", c.LocalBuilderRegistryPort)
+			} else {
+				envVars, err := server.GetInstance(nil).EnvironmentVariableService.Map(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				cr := envVars.FindContainerRegistry(c.BuilderRegistryServer)
+				if cr == nil {
+					return nil, fmt.Errorf("failed to find container registry credentials for builder registry server %s", c.BuilderRegistryServer)
+				}
+
+				pruneConfig.RegistryUrl = fmt.Sprintf("https://%s", cr.Server)
+				pruneConfig.Username = cr.Username
+				pruneConfig.Password = cr.Password
+			}
+
+			result, err := registry.PruneBuildImages(pruneConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			if !dryRun && localContainerRegistry != nil && len(result.Images) > 0 {
+				err = localContainerRegistry.GarbageCollect()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return result, nil
+		},
 		LoggerFactory: logs.NewLoggerFactory(logs.LoggerFactoryConfig{LogsDir: server.GetBuildLogsDir(configDir)}),
 		TrackTelemetryEvent: func(event telemetry.Event, clientId string) error {
 			return telemetryService.Track(event, clientId)
@@ -200,10 +323,11 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	workspaceTemplateService := workspacetemplates.NewWorkspaceTemplateService(workspacetemplates.WorkspaceTemplateServiceConfig{
 		PrebuildWebhookEndpoint: prebuildWebhookEndpoint,
 		ConfigStore:             workspaceTemplateStore,
-		FindNewestBuild: func(ctx context.Context, prebuildId string) (*services.BuildDTO, error) {
+		FindNewestBuild: func(ctx context.Context, prebuildId, branch string) (*services.BuildDTO, error) {
 			return buildService.Find(ctx, &services.BuildFilter{
 				StoreFilter: stores.BuildFilter{
 					PrebuildIds: &[]string{prebuildId},
+					Branch:      &branch,
 					GetNewest:   util.Pointer(true),
 				},
 			})
@@ -217,10 +341,15 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 			createBuildDto := services.CreateBuildDTO{
 				WorkspaceTemplateName: workspaceTemplate.Name,
 				Branch:                repo.Branch,
+				PrNumber:              repo.PrNumber,
 				PrebuildId:            &prebuildId,
 				EnvVars:               workspaceTemplate.EnvVars,
 			}
 
+			if repo.Target == gitprovider.CloneTargetTag {
+				createBuildDto.Tag = &repo.Branch
+			}
+
 			_, err := buildService.Create(ctx, createBuildDto)
 			return err
 		},
@@ -266,6 +395,22 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 
 			return gitProvider.GetCommitsRange(repo, initialSha, currentSha)
 		},
+		GetLastCommitSha: func(ctx context.Context, repo *gitprovider.GitRepository) (string, error) {
+			gitProvider, _, err := gitProviderService.GetGitProviderForUrl(ctx, repo.Url)
+			if err != nil {
+				return "", err
+			}
+
+			return gitProvider.GetLastCommitSha(&gitprovider.StaticGitContext{
+				Id:     repo.Id,
+				Url:    repo.Url,
+				Name:   repo.Name,
+				Branch: &repo.Branch,
+				Owner:  repo.Owner,
+				Source: repo.Source,
+				Path:   repo.Path,
+			})
+		},
 		TrackTelemetryEvent: func(event telemetry.Event, clientId string) error {
 			return telemetryService.Track(event, clientId)
 		},
@@ -276,6 +421,18 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		return nil, err
 	}
 
+	err = workspaceTemplateService.StartSchedulePoller(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := workspaceTemplateService.MigratePrebuildWebhooks(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
 	var localContainerRegistry server.ILocalContainerRegistry
 
 	if c.BuilderRegistryServer != "local" {
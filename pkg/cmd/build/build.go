@@ -22,4 +22,5 @@ func init() {
 	BuildCmd.AddCommand(runCmd)
 	BuildCmd.AddCommand(deleteCmd)
 	BuildCmd.AddCommand(logsCmd)
+	BuildCmd.AddCommand(pruneCmd)
 }
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove unreferenced build images from the builder registry",
+	Long:  "Remove the build images that are not used by any workspace or kept by the retention of their prebuild from the builder registry",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		result, res, err := apiClient.BuildAPI.PruneBuilds(ctx).DryRun(dryRunFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if len(result.Images) == 0 {
+			views.RenderInfoMessage("No unreferenced build images found")
+			return nil
+		}
+
+		for _, image := range result.Images {
+			views.RenderListLine(fmt.Sprintf("%s (%s)", image.Image, units.HumanSize(float64(image.Size))))
+		}
+
+		reclaimedSpace := units.HumanSize(float64(result.ReclaimedSpace))
+
+		if result.DryRun {
+			views.RenderInfoMessage(fmt.Sprintf("Would remove %d build images and reclaim %s", len(result.Images), reclaimedSpace))
+			return nil
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Removed %d build images and reclaimed %s", len(result.Images), reclaimedSpace))
+		return nil
+	},
+}
+
+var dryRunFlag bool
+
+func init() {
+	pruneCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "Show the build images that would be removed and the space that would be reclaimed")
+}
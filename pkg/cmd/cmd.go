@@ -20,6 +20,7 @@ import (
 	. "github.com/daytonaio/daytona/pkg/cmd/env"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
+	. "github.com/daytonaio/daytona/pkg/cmd/pr"
 	. "github.com/daytonaio/daytona/pkg/cmd/prebuild"
 	. "github.com/daytonaio/daytona/pkg/cmd/profile"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
@@ -80,7 +81,9 @@ func Execute() error {
 	rootCmd.AddCommand(RestartCmd)
 	rootCmd.AddCommand(LogsCmd)
 	rootCmd.AddCommand(InfoCmd)
+	rootCmd.AddCommand(CpCmd)
 	rootCmd.AddCommand(PrebuildCmd)
+	rootCmd.AddCommand(PrCmd)
 	rootCmd.AddCommand(BuildCmd)
 	rootCmd.AddCommand(PortForwardCmd)
 	rootCmd.AddCommand(EnvCmd)
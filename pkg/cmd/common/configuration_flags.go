@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,10 @@ type WorkspaceConfigurationFlags struct {
 	Manual            *bool
 	GitProviderConfig *string
 	Labels            *[]string
+	SparsePaths       *[]string
+	Lfs               *bool
+	LfsInclude        *[]string
+	LfsExclude        *[]string
 }
 
 func AddWorkspaceConfigurationFlags(cmd *cobra.Command, flags WorkspaceConfigurationFlags, multiWorkspaceFlagException bool) {
@@ -32,6 +37,10 @@ func AddWorkspaceConfigurationFlags(cmd *cobra.Command, flags WorkspaceConfigura
 	cmd.Flags().BoolVar(flags.Manual, "manual", false, "Manually enter the Git repository")
 	cmd.Flags().StringVar(flags.GitProviderConfig, "git-provider-config", "", "Specify the Git provider configuration ID or alias")
 	cmd.Flags().StringArrayVar(flags.Labels, "label", []string{}, "Specify labels (e.g. --label 'label.key1=VALUE1' --label 'label.key2=VALUE2' ...)")
+	cmd.Flags().StringSliceVar(flags.SparsePaths, "sparse", []string{}, "Check out only the specified directories of the repository with a sparse, partial clone (e.g. --sparse services/api,libs)")
+	cmd.Flags().BoolVar(flags.Lfs, "lfs", false, "Fetch the Git LFS objects of the repository")
+	cmd.Flags().StringSliceVar(flags.LfsInclude, "lfs-include", []string{}, "Fetch only the Git LFS objects matching the specified paths (e.g. --lfs-include 'assets/**,*.png')")
+	cmd.Flags().StringSliceVar(flags.LfsExclude, "lfs-exclude", []string{}, "Skip the Git LFS objects matching the specified paths (e.g. --lfs-exclude 'videos/**')")
 
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image")
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image-user")
@@ -49,7 +58,19 @@ func AddWorkspaceConfigurationFlags(cmd *cobra.Command, flags WorkspaceConfigura
 }
 
 func CheckAnyWorkspaceConfigurationFlagSet(flags WorkspaceConfigurationFlags) bool {
-	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0 || len(*flags.Labels) > 0
+	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0 || len(*flags.Labels) > 0 || len(*flags.SparsePaths) > 0 || GetLfsConfig(flags) != nil
+}
+
+// GetLfsConfig returns the Git LFS configuration set by the flags or nil if LFS objects should not be fetched
+func GetLfsConfig(flags WorkspaceConfigurationFlags) *apiclient.GitLfsConfig {
+	if !*flags.Lfs && len(*flags.LfsInclude) == 0 && len(*flags.LfsExclude) == 0 {
+		return nil
+	}
+
+	return &apiclient.GitLfsConfig{
+		Include: *flags.LfsInclude,
+		Exclude: *flags.LfsExclude,
+	}
 }
 
 func MapKeyValue(arr []string) (map[string]string, error) {
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ForwardWorkspacePorts forwards the ports listed in the workspace's devcontainer forwardPorts
+// to the local machine. The forwards live until the process exits.
+// Returns the number of ports that are being forwarded.
+func ForwardWorkspacePorts(activeProfile config.Profile, workspace *apiclient.WorkspaceDTO) int {
+	if workspace.Metadata == nil {
+		return 0
+	}
+
+	forwarded := 0
+	for _, workspacePort := range workspace.Metadata.ForwardPorts {
+		onAutoForward := workspacePort.GetOnAutoForward()
+		if onAutoForward == models.PortOnAutoForwardIgnore {
+			continue
+		}
+
+		port := uint16(workspacePort.Port)
+		name := fmt.Sprint(port)
+		if workspacePort.Label != nil && *workspacePort.Label != "" {
+			name = fmt.Sprintf("%d (%s)", port, *workspacePort.Label)
+		}
+
+		if workspacePort.GetRequireLocalPort() && !ports.IsPortAvailable(port) {
+			views.RenderInfoMessage(fmt.Sprintf("Port %s not forwarded: local port %d is already in use", name, port))
+			continue
+		}
+
+		hostPort, errChan := tailscale.ForwardPort(workspace.Id, port, activeProfile)
+		if hostPort == nil {
+			log.Errorf("Failed to forward port %s: %s", name, <-errChan)
+			continue
+		}
+
+		message := fmt.Sprintf("Port %s available at http://localhost:%d", name, *hostPort)
+		if onAutoForward == models.PortOnAutoForwardSilent {
+			log.Debug(message)
+		} else {
+			views.RenderInfoMessage(message)
+		}
+
+		go func() {
+			for err := range errChan {
+				// Connection errors to the forwarded port should not exit the process
+				log.Debug(err)
+			}
+		}()
+
+		forwarded++
+	}
+
+	return forwarded
+}
@@ -14,6 +14,7 @@ import (
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/common"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/views"
 	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
 	"github.com/spf13/cobra"
@@ -63,12 +64,13 @@ var GitProviderCreateCmd = &cobra.Command{
 
 		if len(args) == 0 {
 			flags := map[string]string{
-				"alias":          aliasFlag,
-				"token":          tokenFlag,
-				"base-api-url":   baseApiUrlFlag,
-				"username":       usernameFlag,
-				"signing-method": signingMethodFlag,
-				"signing-key":    signingKeyFlag,
+				"alias":           aliasFlag,
+				"token":           tokenFlag,
+				"base-api-url":    baseApiUrlFlag,
+				"username":        usernameFlag,
+				"signing-method":  signingMethodFlag,
+				"signing-key":     signingKeyFlag,
+				"oauth-client-id": oauthClientIdFlag,
 			}
 			err = gitprovider_view.GitProviderCreationView(ctx, apiClient, &setGitProviderConfig, existingAliases, flags)
 			if err != nil {
@@ -90,8 +92,8 @@ var GitProviderCreateCmd = &cobra.Command{
 				return fmt.Errorf("'%s' is invalid or not a supported git provider.\nSupported providers are: %s", args[0], supportedProvidersStr)
 			}
 
-			if tokenFlag == "" {
-				return fmt.Errorf("token is required")
+			if oauthClientIdFlag == "" && tokenFlag == "" {
+				return fmt.Errorf("token or OAuth client ID is required")
 			}
 
 			if gitprovider_view.ProviderRequiresUsername(providerId) {
@@ -116,6 +118,14 @@ var GitProviderCreateCmd = &cobra.Command{
 				}
 			}
 
+			if oauthClientIdFlag != "" {
+				_, err := gitprovider.GetOAuthEndpoints(providerId, setGitProviderConfig.BaseApiUrl)
+				if err != nil {
+					return fmt.Errorf("can not use OAuth device flow for '%s' provider: %w", providerId, err)
+				}
+				setGitProviderConfig.OauthClientId = &oauthClientIdFlag
+			}
+
 			if signingMethodFlag != "" || signingKeyFlag != "" {
 				err = gitprovider_view.ValidateSigningMethodAndKey(signingMethodFlag, signingKeyFlag, providerId)
 				if err != nil {
@@ -131,6 +141,13 @@ var GitProviderCreateCmd = &cobra.Command{
 			return nil
 		}
 
+		if setGitProviderConfig.Token == "" && setGitProviderConfig.OauthClientId != nil {
+			err = authorizeWithDeviceFlow(ctx, &setGitProviderConfig)
+			if err != nil {
+				return err
+			}
+		}
+
 		res, err = apiClient.GitProviderAPI.SaveGitProvider(ctx).GitProviderConfig(setGitProviderConfig).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -147,6 +164,7 @@ var baseApiUrlFlag string
 var tokenFlag string
 var signingMethodFlag string
 var signingKeyFlag string
+var oauthClientIdFlag string
 
 func init() {
 	GitProviderCreateCmd.Flags().StringVarP(&aliasFlag, "alias", "a", "", "Alias")
@@ -155,5 +173,7 @@ func init() {
 	GitProviderCreateCmd.Flags().StringVarP(&tokenFlag, "token", "t", "", "Personal Access Token")
 	GitProviderCreateCmd.Flags().StringVarP(&signingMethodFlag, "signing-method", "s", "", "Signing Method (ssh, gpg)")
 	GitProviderCreateCmd.Flags().StringVarP(&signingKeyFlag, "signing-key", "k", "", "Signing Key")
+	GitProviderCreateCmd.Flags().StringVar(&oauthClientIdFlag, "oauth-client-id", "", "Client ID of the OAuth application used to authorize with the device flow instead of a token")
 	GitProviderCreateCmd.MarkFlagsRequiredTogether("signing-method", "signing-key")
+	GitProviderCreateCmd.MarkFlagsMutuallyExclusive("token", "oauth-client-id")
 }
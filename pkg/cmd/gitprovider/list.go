@@ -52,6 +52,12 @@ var listCmd = &cobra.Command{
 						gitProviderView.SigningMethod = string(*gitProvider.SigningMethod)
 					}
 
+					gitProviderView.TokenStatus = gitprovider_view.GetTokenStatusLabel(gitProvider.TokenStatus)
+					if gitProvider.TokenStatus != nil {
+						gitProviderView.TokenError = gitProvider.TokenStatus.GetError()
+						gitProviderView.TokenScopes = gitProvider.TokenStatus.Scopes
+					}
+
 					gitProviderViewList = append(gitProviderViewList, gitProviderView)
 				}
 			}
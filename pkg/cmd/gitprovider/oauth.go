@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/pkg/browser"
+)
+
+// Obtains the token of the git provider config with the OAuth device flow
+func authorizeWithDeviceFlow(ctx context.Context, gitProviderConfig *apiclient.SetGitProviderConfig) error {
+	endpoints, err := gitprovider.GetOAuthEndpoints(gitProviderConfig.ProviderId, gitProviderConfig.BaseApiUrl)
+	if err != nil {
+		return err
+	}
+
+	clientId := gitProviderConfig.GetOauthClientId()
+
+	authorization, err := gitprovider.RequestDeviceAuthorization(ctx, endpoints, clientId, config.GetOAuthScopesFromGitProviderId(gitProviderConfig.ProviderId))
+	if err != nil {
+		return err
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Open %s and enter the code %s to authorize Daytona", authorization.VerificationUri, authorization.UserCode))
+	_ = browser.OpenURL(authorization.VerificationUri)
+
+	var token *gitprovider.OAuthToken
+	err = views_util.WithInlineSpinner("Waiting for authorization", func() error {
+		token, err = gitprovider.PollDeviceAccessToken(ctx, endpoints, clientId, authorization)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	gitProviderConfig.Token = token.AccessToken
+	gitProviderConfig.RefreshToken = token.RefreshToken
+	if token.ExpiresAt != nil {
+		gitProviderConfig.SetTokenExpiresAt(token.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
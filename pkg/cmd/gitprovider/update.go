@@ -61,6 +61,7 @@ var updateCmd = &cobra.Command{
 			Alias:         &selectedGitProvider.Alias,
 			SigningMethod: selectedGitProvider.SigningMethod,
 			SigningKey:    selectedGitProvider.SigningKey,
+			OauthClientId: selectedGitProvider.OauthClientId,
 		}
 
 		flags := map[string]string{}
@@ -69,6 +70,13 @@ var updateCmd = &cobra.Command{
 			return err
 		}
 
+		if setGitProviderConfig.Token == "" && setGitProviderConfig.OauthClientId != nil {
+			err = authorizeWithDeviceFlow(ctx, &setGitProviderConfig)
+			if err != nil {
+				return err
+			}
+		}
+
 		res, err = apiClient.GitProviderAPI.SaveGitProvider(ctx).GitProviderConfig(setGitProviderConfig).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pr
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var PrCmd = &cobra.Command{
+	Use:     "pr",
+	Short:   "Manage workspaces created from pull requests",
+	Args:    cobra.NoArgs,
+	GroupID: util.TARGET_GROUP,
+}
+
+func init() {
+	PrCmd.AddCommand(syncCmd)
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pr
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/common"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+// Fetching and rebasing can take a while for large pull requests, the toolbox default of 10 seconds is not enough
+const (
+	fetchTimeout  = 300
+	rebaseTimeout = 300
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync WORKSPACE",
+	Short: "Rebase a workspace onto the latest head of its pull request",
+	Long: `Fetch the latest head of the pull request the workspace was created from and rebase the workspace branch onto it.
+If the rebase fails, it is aborted and the workspace is left unchanged.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ws, _, err := apiclient_util.GetWorkspace(args[0])
+		if err != nil {
+			return err
+		}
+
+		pullRequest := ws.Repository.PullRequest
+		if pullRequest == nil {
+			return fmt.Errorf("workspace %s was not created from a pull request", ws.Name)
+		}
+
+		err = views_util.WithSpinner("Fetching the pull request head", func() error {
+			return executeCommand(ctx, apiClient, ws.Id, fmt.Sprintf("git fetch %s %s", ws.Repository.Url, pullRequest.HeadBranch), fetchTimeout)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch branch %s: %s", pullRequest.HeadBranch, err.Error())
+		}
+
+		err = executeCommand(ctx, apiClient, ws.Id, "git rebase FETCH_HEAD", rebaseTimeout)
+		if err != nil {
+			// Leave the workspace as it was before the sync
+			_ = executeCommand(ctx, apiClient, ws.Id, "git rebase --abort", rebaseTimeout)
+			return fmt.Errorf("failed to rebase onto branch %s, make sure the workspace has no uncommitted changes and no conflicting commits: %s", pullRequest.HeadBranch, err.Error())
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s rebased onto the latest head of pull request #%d", ws.Name, ws.Repository.GetPrNumber()))
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return common.GetWorkspaceNameCompletions()
+	},
+}
+
+func executeCommand(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, command string, timeout int32) error {
+	request := apiclient.ExecuteRequest{
+		Command: command,
+	}
+	if timeout > 0 {
+		request.Timeout = &timeout
+	}
+
+	response, res, err := apiClient.WorkspaceToolboxAPI.ProcessExecuteCommand(ctx, workspaceId).Params(request).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if response.Code != 0 {
+		return fmt.Errorf("exit code %d: %s", response.Code, response.Result)
+	}
+
+	return nil
+}
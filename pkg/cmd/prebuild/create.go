@@ -48,7 +48,7 @@ var addCmd = &cobra.Command{
 
 		// If no arguments and no flags are provided, run the interactive CLI
 		if len(args) == 0 && branchFlag == "" && retentionFlag == 0 &&
-			commitIntervalFlag == 0 && triggerFilesFlag == nil {
+			commitIntervalFlag == 0 && triggerFilesFlag == nil && pullRequestTargetsFlag == nil && tagPatternsFlag == nil && scheduleFlag == "" {
 			// Interactive CLI logic
 
 			workspaceTemplateList, res, err := apiClient.WorkspaceTemplateAPI.ListWorkspaceTemplates(ctx).Execute()
@@ -125,6 +125,9 @@ var addCmd = &cobra.Command{
 			}
 
 			prebuildAddView.TriggerFiles = triggerFilesFlag
+			prebuildAddView.PullRequestTargets = pullRequestTargetsFlag
+			prebuildAddView.TagPatterns = tagPatternsFlag
+			prebuildAddView.Schedule = scheduleFlag
 			prebuildAddView.RunBuildOnAdd = runFlag
 		}
 
@@ -158,6 +161,18 @@ var addCmd = &cobra.Command{
 			newPrebuild.TriggerFiles = prebuildAddView.TriggerFiles
 		}
 
+		if len(prebuildAddView.PullRequestTargets) > 0 {
+			newPrebuild.PullRequestTargets = prebuildAddView.PullRequestTargets
+		}
+
+		if len(prebuildAddView.TagPatterns) > 0 {
+			newPrebuild.TagPatterns = prebuildAddView.TagPatterns
+		}
+
+		if prebuildAddView.Schedule != "" {
+			newPrebuild.Schedule = &prebuildAddView.Schedule
+		}
+
 		prebuildId, res, err := apiClient.PrebuildAPI.SavePrebuild(ctx, prebuildAddView.WorkspaceTemplateName).Prebuild(newPrebuild).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -180,8 +195,11 @@ var addCmd = &cobra.Command{
 
 func init() {
 	addCmd.Flags().BoolVar(&runFlag, "run", false, "Run the prebuild once after adding it")
-	addCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Git branch for the prebuild")
+	addCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Git branch or branch glob pattern for the prebuild")
 	addCmd.Flags().IntVarP(&retentionFlag, "retention", "r", 0, "Maximum number of resulting builds stored at a time")
 	addCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	addCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
+	addCmd.Flags().StringSliceVar(&pullRequestTargetsFlag, "pr-targets", nil, "Branch patterns - pull requests opened against matching branches trigger a prebuild")
+	addCmd.Flags().StringSliceVar(&tagPatternsFlag, "tag-patterns", nil, "Tag patterns - pushing a matching tag triggers a prebuild")
+	addCmd.Flags().StringVar(&scheduleFlag, "schedule", "", "Cron expression on which the branch is rebuilt, e.g. \"0 3 * * *\"")
 }
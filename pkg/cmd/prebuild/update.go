@@ -49,7 +49,7 @@ var updateCmd = &cobra.Command{
 		}
 
 		// Determine the mode of operation: interactive or non-interactive
-		if len(args) == 2 || (branchFlag != "" || retentionFlag != 0 || commitIntervalFlag != 0 || len(triggerFilesFlag) > 0) {
+		if len(args) == 2 || (branchFlag != "" || retentionFlag != 0 || commitIntervalFlag != 0 || len(triggerFilesFlag) > 0 || len(pullRequestTargetsFlag) > 0 || len(tagPatternsFlag) > 0 || scheduleFlag != "") {
 			// Non-interactive mode: use provided arguments and flags
 			if len(args) < 2 {
 				return errors.New("Both workspace template name and prebuild ID must be specified when using flags")
@@ -79,11 +79,28 @@ var updateCmd = &cobra.Command{
 			if len(triggerFilesFlag) > 0 {
 				prebuild.TriggerFiles = triggerFilesFlag
 			}
+
+			if len(pullRequestTargetsFlag) > 0 {
+				prebuild.PullRequestTargets = pullRequestTargetsFlag
+			}
+
+			if len(tagPatternsFlag) > 0 {
+				prebuild.TagPatterns = tagPatternsFlag
+			}
+
+			if scheduleFlag != "" {
+				prebuild.Schedule = &scheduleFlag
+			}
 			prebuildAddView.Branch = prebuild.Branch
 			prebuildAddView.Retention = strconv.Itoa(int(prebuild.Retention))
 			prebuildAddView.WorkspaceTemplateName = workspaceTemplateRecieved
 			prebuildAddView.TriggerFiles = prebuild.TriggerFiles
-			prebuildAddView.CommitInterval = strconv.Itoa(int(*prebuild.CommitInterval))
+			prebuildAddView.PullRequestTargets = prebuild.PullRequestTargets
+			prebuildAddView.TagPatterns = prebuild.TagPatterns
+			prebuildAddView.Schedule = prebuild.GetSchedule()
+			if prebuild.CommitInterval != nil {
+				prebuildAddView.CommitInterval = strconv.Itoa(int(*prebuild.CommitInterval))
+			}
 			retention = int(prebuild.Retention)
 		} else {
 			// Interactive mode: Prompt for details
@@ -130,6 +147,9 @@ var updateCmd = &cobra.Command{
 			if len(prebuild.TriggerFiles) > 0 {
 				prebuildAddView.TriggerFiles = prebuild.TriggerFiles
 			}
+			prebuildAddView.PullRequestTargets = prebuild.PullRequestTargets
+			prebuildAddView.TagPatterns = prebuild.TagPatterns
+			prebuildAddView.Schedule = prebuild.GetSchedule()
 			create.PrebuildCreationView(&prebuildAddView, false)
 		}
 
@@ -157,6 +177,18 @@ var updateCmd = &cobra.Command{
 			newPrebuild.TriggerFiles = prebuildAddView.TriggerFiles
 		}
 
+		if len(prebuildAddView.PullRequestTargets) > 0 {
+			newPrebuild.PullRequestTargets = prebuildAddView.PullRequestTargets
+		}
+
+		if len(prebuildAddView.TagPatterns) > 0 {
+			newPrebuild.TagPatterns = prebuildAddView.TagPatterns
+		}
+
+		if prebuildAddView.Schedule != "" {
+			newPrebuild.Schedule = &prebuildAddView.Schedule
+		}
+
 		prebuildId, res, err := apiClient.PrebuildAPI.SavePrebuild(ctx, prebuildAddView.WorkspaceTemplateName).Prebuild(newPrebuild).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -183,17 +215,23 @@ var updateCmd = &cobra.Command{
 }
 
 var (
-	branchFlag         string
-	retentionFlag      int
-	commitIntervalFlag int
-	triggerFilesFlag   []string
-	runFlag            bool
+	branchFlag             string
+	retentionFlag          int
+	commitIntervalFlag     int
+	triggerFilesFlag       []string
+	pullRequestTargetsFlag []string
+	tagPatternsFlag        []string
+	scheduleFlag           string
+	runFlag                bool
 )
 
 func init() {
-	updateCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Git branch for the prebuild")
+	updateCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Git branch or branch glob pattern for the prebuild")
 	updateCmd.Flags().IntVarP(&retentionFlag, "retention", "r", 0, "Maximum number of resulting builds stored at a time")
 	updateCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	updateCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
+	updateCmd.Flags().StringSliceVar(&pullRequestTargetsFlag, "pr-targets", nil, "Branch patterns - pull requests opened against matching branches trigger a prebuild")
+	updateCmd.Flags().StringSliceVar(&tagPatternsFlag, "tag-patterns", nil, "Tag patterns - pushing a matching tag triggers a prebuild")
+	updateCmd.Flags().StringVar(&scheduleFlag, "schedule", "", "Cron expression on which the branch is rebuilt, e.g. \"0 3 * * *\"")
 	updateCmd.Flags().BoolVar(&runFlag, "run", false, "Run the prebuild once after updating it")
 }
@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
@@ -17,6 +20,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/common"
 	"github.com/daytonaio/daytona/pkg/cmd/workspace/create"
+	"github.com/daytonaio/daytona/pkg/views"
 	ide_views "github.com/daytonaio/daytona/pkg/views/ide"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 
@@ -24,6 +28,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var forwardPortsFlag bool
+
 var CodeCmd = &cobra.Command{
 	Use:     "code [WORKSPACE]",
 	Short:   "Open a workspace in your preferred IDE",
@@ -102,7 +108,26 @@ var CodeCmd = &cobra.Command{
 		yesFlag, _ := cmd.Flags().GetBool("yes")
 		ideList := config.GetIdeList()
 		ide_views.RenderIdeOpeningMessage(ws.TargetId, ws.Name, ideId, ideList)
-		return common.OpenIDE(ideId, activeProfile, ws.Id, ws.Repository.Name, providerMetadata, yesFlag, gpgKey)
+
+		// The SSH "IDE" keeps the process alive so the ports are forwarded for the session.
+		// Other IDEs return after launching, so forwarding has to be requested explicitly.
+		forwardedPorts := 0
+		if forwardPortsFlag || ideId == "ssh" {
+			forwardedPorts = common.ForwardWorkspacePorts(activeProfile, ws)
+		}
+
+		err = common.OpenIDE(ideId, activeProfile, ws.Id, ws.Repository.Name, providerMetadata, yesFlag, gpgKey)
+		if err != nil || forwardedPorts == 0 || ideId == "ssh" {
+			return err
+		}
+
+		// Keep the forwarded ports open after the IDE has been launched
+		views.RenderInfoMessage("Forwarding workspace ports. Press Ctrl+C to stop.")
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		<-sigChan
+
+		return nil
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return common.GetWorkspaceNameCompletions()
@@ -119,6 +144,7 @@ func init() {
 	CodeCmd.Flags().StringVarP(&create.IdeFlag, "ide", "i", "", fmt.Sprintf("Specify the IDE (%s)", ideListStr))
 
 	CodeCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	CodeCmd.Flags().BoolVar(&forwardPortsFlag, "forward-ports", false, "Forward the devcontainer forwardPorts and keep running until interrupted")
 
 }
 
@@ -0,0 +1,219 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/archive"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var cpExcludeFlag []string
+
+var CpCmd = &cobra.Command{
+	Use:   "cp SOURCE DESTINATION",
+	Short: "Copy files between the local filesystem and a workspace",
+	Long: `Copy files or directories between the local filesystem and a workspace.
+Workspace paths are written as WORKSPACE:PATH. Relative workspace paths are resolved against the workspace directory.
+
+Examples:
+  daytona cp ./dist my-workspace:/home/daytona/app
+  daytona cp my-workspace:logs ./logs --exclude "*.tmp"`,
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.TARGET_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		srcWorkspace, srcPath := parseCpPath(args[0])
+		dstWorkspace, dstPath := parseCpPath(args[1])
+
+		if (srcWorkspace == "") == (dstWorkspace == "") {
+			return errors.New("exactly one of the source and destination must be a workspace path (WORKSPACE:PATH)")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspaceNameOrId := srcWorkspace
+		if workspaceNameOrId == "" {
+			workspaceNameOrId = dstWorkspace
+		}
+
+		ws, _, err := apiclient_util.GetWorkspace(workspaceNameOrId)
+		if err != nil {
+			return err
+		}
+
+		if srcWorkspace != "" {
+			remotePath, err := resolveWorkspacePath(ctx, apiClient, ws.Id, srcPath)
+			if err != nil {
+				return err
+			}
+
+			err = copyFromWorkspace(ctx, apiClient, ws.Id, remotePath, dstPath)
+			if err != nil {
+				return err
+			}
+		} else {
+			remotePath, err := resolveWorkspacePath(ctx, apiClient, ws.Id, dstPath)
+			if err != nil {
+				return err
+			}
+
+			err = copyToWorkspace(ctx, apiClient, ws.Id, srcPath, remotePath)
+			if err != nil {
+				return err
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Copied %s to %s", args[0], args[1]))
+		return nil
+	},
+}
+
+func init() {
+	CpCmd.Flags().StringSliceVarP(&cpExcludeFlag, "exclude", "e", nil, "Glob patterns of files and directories to exclude when copying a directory")
+}
+
+// parseCpPath splits a WORKSPACE:PATH argument. Local paths return an empty workspace.
+func parseCpPath(arg string) (string, string) {
+	idx := strings.Index(arg, ":")
+	// A single letter before the colon is treated as a Windows drive letter
+	if idx <= 1 || strings.ContainsAny(arg[:idx], `/\`) {
+		return "", arg
+	}
+	return arg[:idx], arg[idx+1:]
+}
+
+func resolveWorkspacePath(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, remotePath string) (string, error) {
+	if path.IsAbs(remotePath) {
+		return remotePath, nil
+	}
+
+	workspaceDir, res, err := apiClient.WorkspaceToolboxAPI.GetWorkspaceDir(ctx, workspaceId).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return path.Join(workspaceDir.GetDir(), remotePath), nil
+}
+
+func copyToWorkspace(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, localPath, remotePath string) error {
+	localInfo, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	// Copying into an existing directory keeps the source name
+	remoteInfo, res, err := apiClient.WorkspaceToolboxAPI.FsGetFileDetails(ctx, workspaceId).Path(remotePath).Execute()
+	if err == nil && remoteInfo.IsDir {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	} else if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if !localInfo.IsDir() {
+		file, err := os.Open(localPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		res, err := apiClient.WorkspaceToolboxAPI.FsUploadFile(ctx, workspaceId).Path(remotePath).File(file).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		return nil
+	}
+
+	archiveFile, err := os.CreateTemp("", "daytona-cp-*"+archive.Extension(archive.FormatTar, archive.CompressionGzip))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archiveFile.Name())
+	defer archiveFile.Close()
+
+	err = archive.Create(archiveFile, localPath, archive.CreateOptions{
+		Format:      archive.FormatTar,
+		Compression: archive.CompressionGzip,
+		Exclude:     cpExcludeFlag,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = archiveFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	res, err = apiClient.WorkspaceToolboxAPI.FsUploadArchive(ctx, workspaceId).Path(remotePath).File(archiveFile).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func copyFromWorkspace(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, remotePath, localPath string) error {
+	remoteInfo, res, err := apiClient.WorkspaceToolboxAPI.FsGetFileDetails(ctx, workspaceId).Path(remotePath).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	// Copying into an existing directory keeps the source name
+	localInfo, err := os.Stat(localPath)
+	if err == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if remoteInfo.IsDir {
+		archiveFile, res, err := apiClient.WorkspaceToolboxAPI.FsDownloadArchive(ctx, workspaceId).Path(remotePath).Exclude(strings.Join(cpExcludeFlag, ",")).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		defer os.Remove(archiveFile.Name())
+		defer archiveFile.Close()
+
+		return archive.Extract(archiveFile, localPath)
+	}
+
+	downloadedFile, res, err := apiClient.WorkspaceToolboxAPI.FsDownloadFile(ctx, workspaceId).Path(remotePath).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+	defer os.Remove(downloadedFile.Name())
+	defer downloadedFile.Close()
+
+	err = os.MkdirAll(filepath.Dir(localPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(localPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(0644))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, downloadedFile)
+	return err
+}
@@ -43,6 +43,8 @@ func AddWorkspaceFromTemplate(ctx context.Context, params AddWorkspaceFromTempla
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
+	templateRepo.SparsePaths = params.WorkspaceTemplate.SparsePaths
+	templateRepo.Lfs = params.WorkspaceTemplate.Lfs
 
 	workspace := &apiclient.CreateWorkspaceDTO{
 		Name:                params.WorkspaceTemplate.Name,
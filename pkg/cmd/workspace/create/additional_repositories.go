@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// addAdditionalRepositories resolves the repositories passed with the additional repository flag
+// and adds them to every workspace that is being created
+func addAdditionalRepositories(ctx context.Context, apiClient *apiclient.APIClient, createWorkspaceDtos []apiclient.CreateWorkspaceDTO, additionalRepoFlags []string) error {
+	if len(additionalRepoFlags) == 0 {
+		return nil
+	}
+
+	additionalRepositories := []apiclient.WorkspaceRepository{}
+
+	for _, additionalRepoFlag := range additionalRepoFlags {
+		repoUrl, branch, clonePath, err := parseAdditionalRepoFlag(additionalRepoFlag)
+		if err != nil {
+			return err
+		}
+
+		repo, res, err := apiClient.GitProviderAPI.GetGitContext(ctx).Repository(apiclient.GetRepositoryContext{
+			Url:    repoUrl,
+			Branch: branch,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if clonePath == "" {
+			clonePath = repo.Name
+		}
+
+		additionalRepositories = append(additionalRepositories, apiclient.WorkspaceRepository{
+			ClonePath:  clonePath,
+			Repository: *repo,
+		})
+	}
+
+	for i := range createWorkspaceDtos {
+		createWorkspaceDtos[i].Source.AdditionalRepositories = additionalRepositories
+	}
+
+	return nil
+}
+
+// parseAdditionalRepoFlag parses the 'URL[,branch=BRANCH][,path=CLONE_PATH]' format of the additional repository flag
+func parseAdditionalRepoFlag(value string) (string, *string, string, error) {
+	parts := strings.Split(value, ",")
+
+	repoUrl := strings.TrimSpace(parts[0])
+	if repoUrl == "" {
+		return "", nil, "", fmt.Errorf("invalid additional repository: %s", value)
+	}
+
+	var branch *string
+	clonePath := ""
+
+	for _, option := range parts[1:] {
+		key, optionValue, ok := strings.Cut(option, "=")
+		if !ok {
+			return "", nil, "", fmt.Errorf("invalid option %s of additional repository %s", option, repoUrl)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "branch":
+			branch = &optionValue
+		case "path":
+			clonePath = optionValue
+		default:
+			return "", nil, "", fmt.Errorf("unknown option %s of additional repository %s", key, repoUrl)
+		}
+	}
+
+	return repoUrl, branch, clonePath, nil
+}
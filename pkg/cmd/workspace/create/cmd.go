@@ -114,6 +114,16 @@ var CreateCmd = &cobra.Command{
 			}
 		}
 
+		err = addAdditionalRepositories(ctx, apiClient, createWorkspaceDtos, additionalRepoFlag)
+		if err != nil {
+			return err
+		}
+
+		err = checkGitProviderTokens(ctx, apiClient, createWorkspaceDtos)
+		if err != nil {
+			return err
+		}
+
 		workspaceNames := []string{}
 		for i := range createWorkspaceDtos {
 			workspaceNames = append(workspaceNames, createWorkspaceDtos[i].Name)
@@ -262,7 +272,7 @@ var CreateCmd = &cobra.Command{
 		if len(createdWorkspaces) > 1 {
 			info.RenderMulti(createdWorkspaces, chosenIde.Name, false)
 		} else {
-			info.Render(&createdWorkspaces[0], chosenIde.Name, false)
+			info.Render(&createdWorkspaces[0], chosenIde.Name, false, false)
 		}
 
 		if noIdeFlag {
@@ -282,6 +292,7 @@ var IdeFlag string
 var noIdeFlag bool
 var blankFlag bool
 var multiWorkspaceFlag bool
+var additionalRepoFlag []string
 
 var workspaceConfigurationFlags = cmd_common.WorkspaceConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -293,6 +304,10 @@ var workspaceConfigurationFlags = cmd_common.WorkspaceConfigurationFlags{
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
 	Labels:            new([]string),
+	SparsePaths:       new([]string),
+	Lfs:               new(bool),
+	LfsInclude:        new([]string),
+	LfsExclude:        new([]string),
 }
 
 func init() {
@@ -310,6 +325,7 @@ func init() {
 	CreateCmd.Flags().BoolVar(&multiWorkspaceFlag, "multi-workspace", false, "Target with multiple workspaces/repos")
 	CreateCmd.Flags().BoolVarP(&YesFlag, "yes", "y", false, "Automatically confirm any prompts")
 	CreateCmd.Flags().StringSliceVar(workspaceConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branches to use in the workspaces")
+	CreateCmd.Flags().StringArrayVar(&additionalRepoFlag, "additional-repo", []string{}, "Clone an additional repository next to the workspace repository (e.g. --additional-repo 'https://github.com/org/lib,branch=dev,path=lib')")
 
 	cmd_common.AddWorkspaceConfigurationFlags(CreateCmd, workspaceConfigurationFlags, true)
 }
@@ -92,6 +92,8 @@ func GetWorkspacesCreationDataFromPrompt(ctx context.Context, params WorkspacesD
 				if err != nil {
 					return nil, apiclient_util.HandleErrorResponse(res, err)
 				}
+				templateRepo.SparsePaths = workspaceTemplate.SparsePaths
+				templateRepo.Lfs = workspaceTemplate.Lfs
 
 				createWorkspaceDto := apiclient.CreateWorkspaceDTO{
 					Name:                workspaceName,
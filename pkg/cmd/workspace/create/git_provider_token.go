@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// Git providers report authentication failures with these status codes, other errors might be transient
+var tokenRejectedRegex = regexp.MustCompile(`^status code: 40[13]\b`)
+
+// Fails before any resources are created if the token of a Git provider used by the workspaces
+// was rejected or has expired according to the last token health check. Statuses that might be
+// transient or outdated only produce a warning.
+func checkGitProviderTokens(ctx context.Context, apiClient *apiclient.APIClient, createWorkspaceDtos []apiclient.CreateWorkspaceDTO) error {
+	checkedConfigIds := map[string]bool{}
+
+	for _, createWorkspaceDto := range createWorkspaceDtos {
+		gitProviderConfigId := createWorkspaceDto.GetGitProviderConfigId()
+		if gitProviderConfigId == "" || checkedConfigIds[gitProviderConfigId] {
+			continue
+		}
+		checkedConfigIds[gitProviderConfigId] = true
+
+		// Public repositories are not cloned with a registered Git provider config
+		gitProvider, _, err := apiClient.GitProviderAPI.FindGitProvider(ctx, gitProviderConfigId).Execute()
+		if err != nil {
+			continue
+		}
+
+		err = checkGitProviderToken(gitProvider)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkGitProviderToken(gitProvider *apiclient.GitProvider) error {
+	tokenStatus := gitProvider.TokenStatus
+	// The token has not been checked yet
+	if tokenStatus == nil {
+		return nil
+	}
+
+	if !tokenStatus.Valid {
+		if !tokenRejectedRegex.MatchString(tokenStatus.GetError()) {
+			log.Warnf("The last token check of Git provider '%s' failed: %s", gitProvider.Alias, tokenStatus.GetError())
+			return nil
+		}
+
+		return fmt.Errorf("the token of Git provider '%s' is not valid: %s\nUpdate the token with 'daytona git-provider update' and try again", gitProvider.Alias, tokenStatus.GetError())
+	}
+
+	// OAuth tokens are refreshed by the server before they are used
+	if tokenStatus.ExpiresAt == nil || gitProvider.GetOauthClientId() != "" {
+		return nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, *tokenStatus.ExpiresAt)
+	if err != nil {
+		log.Warnf("Failed to parse the token expiry of Git provider '%s': %s", gitProvider.Alias, err)
+		return nil
+	}
+
+	if time.Now().After(expiresAt) {
+		return fmt.Errorf("the token of Git provider '%s' has expired\nUpdate the token with 'daytona git-provider update' and try again", gitProvider.Alias)
+	}
+
+	return nil
+}
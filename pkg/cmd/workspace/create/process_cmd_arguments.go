@@ -104,6 +104,20 @@ func ProcessCmdArguments(ctx context.Context, params ProcessCmdArgumentsParams)
 		}
 	}
 
+	// The sparse checkout flag overrides the sparse checkout of the workspace template
+	if len(*params.WorkspaceConfigurationFlags.SparsePaths) > 0 {
+		for i := range *params.CreateWorkspaceDtos {
+			(*params.CreateWorkspaceDtos)[i].Source.Repository.SparsePaths = *params.WorkspaceConfigurationFlags.SparsePaths
+		}
+	}
+
+	lfsConfig := cmd_common.GetLfsConfig(params.WorkspaceConfigurationFlags)
+	if lfsConfig != nil {
+		for i := range *params.CreateWorkspaceDtos {
+			(*params.CreateWorkspaceDtos)[i].Source.Repository.Lfs = lfsConfig
+		}
+	}
+
 	generateWorkspaceIds(params.CreateWorkspaceDtos)
 	setInitialWorkspaceNames(params.CreateWorkspaceDtos, *params.ExistingWorkspaces)
 
@@ -5,6 +5,8 @@ package workspace
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
@@ -14,6 +16,7 @@ import (
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -70,7 +73,7 @@ var InfoCmd = &cobra.Command{
 			return nil
 		}
 
-		info.Render(ws, "", false)
+		info.Render(ws, "", false, isPrUpdated(ctx, apiClient, ws))
 		return nil
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -81,3 +84,34 @@ var InfoCmd = &cobra.Command{
 func init() {
 	format.RegisterFormatFlag(InfoCmd)
 }
+
+// isPrUpdated checks if the head of the pull request the workspace was created from has moved since the workspace was created.
+// Errors are only logged as the check should not prevent showing the workspace info.
+func isPrUpdated(ctx context.Context, apiClient *apiclient.APIClient, ws *apiclient.WorkspaceDTO) bool {
+	pullRequest := ws.Repository.PullRequest
+	if pullRequest == nil || ws.GitProviderConfigId == nil {
+		return false
+	}
+
+	page := int32(1)
+	perPage := int32(100)
+
+	for {
+		prs, res, err := apiClient.GitProviderAPI.GetRepoPRs(ctx, *ws.GitProviderConfigId, url.QueryEscape(pullRequest.BaseRepoOwner), url.QueryEscape(pullRequest.BaseRepoId)).Page(page).PerPage(perPage).Execute()
+		if err != nil {
+			log.Debug(apiclient_util.HandleErrorResponse(res, err))
+			return false
+		}
+
+		for _, pr := range prs {
+			if pr.Branch == pullRequest.HeadBranch && strings.EqualFold(pr.SourceRepoOwner, ws.Repository.Owner) && strings.EqualFold(pr.SourceRepoName, ws.Repository.Name) {
+				return pr.Sha != ws.Repository.Sha
+			}
+		}
+
+		if len(prs) < int(perPage) {
+			return false
+		}
+		page++
+	}
+}
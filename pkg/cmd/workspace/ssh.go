@@ -97,6 +97,8 @@ var SshCmd = &cobra.Command{
 		sshArgs := []string{}
 		if len(args) > 1 {
 			sshArgs = append(sshArgs, args[1:]...)
+		} else {
+			common.ForwardWorkspacePorts(activeProfile, ws)
 		}
 
 		gpgKey, err := common.GetGitProviderGpgKey(apiClient, ctx, ws.GitProviderConfigId)
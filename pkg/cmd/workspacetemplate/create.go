@@ -242,6 +242,8 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		RepositoryUrl:       repoUrl,
 		EnvVars:             workspace.EnvVars,
 		GitProviderConfigId: workspace.GitProviderConfigId,
+		SparsePaths:         *workspaceConfigurationFlags.SparsePaths,
+		Lfs:                 cmd_common.GetLfsConfig(workspaceConfigurationFlags),
 	}
 
 	if newWorkspaceTemplate.Image == nil {
@@ -287,6 +289,10 @@ var workspaceConfigurationFlags = cmd_common.WorkspaceConfigurationFlags{
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
 	Labels:            new([]string),
+	SparsePaths:       new([]string),
+	Lfs:               new(bool),
+	LfsInclude:        new([]string),
+	LfsExclude:        new([]string),
 }
 
 func init() {
@@ -165,6 +165,8 @@ func importWorkspaceTemplate(ctx context.Context, apiClient *apiclient.APIClient
 		RepositoryUrl:       template.RepositoryUrl,
 		EnvVars:             template.EnvVars,
 		GitProviderConfigId: template.GitProviderConfigId,
+		SparsePaths:         template.SparsePaths,
+		Lfs:                 template.Lfs,
 	}
 
 	if newWorkspaceTemplate.Image == nil {
@@ -120,6 +120,8 @@ var updateCmd = &cobra.Command{
 			RepositoryUrl:       createDto[0].Source.Repository.Url,
 			EnvVars:             createDto[0].EnvVars,
 			GitProviderConfigId: createDto[0].GitProviderConfigId,
+			SparsePaths:         workspaceTemplate.SparsePaths,
+			Lfs:                 workspaceTemplate.Lfs,
 		}
 
 		res, err = apiClient.WorkspaceTemplateAPI.SaveWorkspaceTemplate(ctx).WorkspaceTemplate(newWorkspaceTemplate).Execute()
@@ -0,0 +1,11 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import "strings"
+
+// QuoteShellArg quotes the value as a single argument for POSIX shells
+func QuoteShellArg(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
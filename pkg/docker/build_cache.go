@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// BuildKit plain progress output, e.g. "#7 [2/5] RUN apt-get update" and "#7 CACHED"
+var buildkitStepRegex = regexp.MustCompile(`^#(\d+) \[(?:[^\]]*\s)?\d+/\d+\]`)
+var buildkitCachedRegex = regexp.MustCompile(`^#(\d+) CACHED`)
+
+// Classic builder output, e.g. "Step 2/5 : RUN apt-get update" followed by " ---> Using cache"
+var classicStepRegex = regexp.MustCompile(`^Step \d+/\d+ :`)
+
+// BuildCacheStatsWriter forwards the build output to the underlying writer
+// while counting the build steps and the ones reused from the layer cache
+type BuildCacheStatsWriter struct {
+	writer io.Writer
+
+	mu          sync.Mutex
+	line        []byte
+	steps       map[string]bool
+	cachedSteps map[string]bool
+	classicStep int
+	classicHits int
+}
+
+func NewBuildCacheStatsWriter(writer io.Writer) *BuildCacheStatsWriter {
+	return &BuildCacheStatsWriter{
+		writer:      writer,
+		steps:       map[string]bool{},
+		cachedSteps: map[string]bool{},
+	}
+}
+
+func (w *BuildCacheStatsWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	w.line = append(w.line, p...)
+	for {
+		i := bytes.IndexAny(w.line, "\r\n")
+		if i == -1 {
+			break
+		}
+		w.parseLine(string(w.line[:i]))
+		w.line = w.line[i+1:]
+	}
+	w.mu.Unlock()
+
+	if w.writer == nil {
+		return len(p), nil
+	}
+
+	return w.writer.Write(p)
+}
+
+// Stats returns the number of cached build steps and the total number of build steps
+func (w *BuildCacheStatsWriter) Stats() (int, int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	cached := w.classicHits
+	for id := range w.cachedSteps {
+		if w.steps[id] {
+			cached++
+		}
+	}
+
+	return cached, len(w.steps) + w.classicStep
+}
+
+func (w *BuildCacheStatsWriter) parseLine(line string) {
+	// The devcontainer CLI prefixes the build output with timestamps and log levels
+	if i := strings.Index(line, "#"); i > 0 && !strings.HasPrefix(strings.TrimSpace(line), "Step ") {
+		line = line[i:]
+	}
+	line = strings.TrimSpace(line)
+
+	if match := buildkitStepRegex.FindStringSubmatch(line); match != nil {
+		w.steps[match[1]] = true
+		return
+	}
+
+	if match := buildkitCachedRegex.FindStringSubmatch(line); match != nil {
+		w.cachedSteps[match[1]] = true
+		return
+	}
+
+	if classicStepRegex.MatchString(line) {
+		w.classicStep++
+		return
+	}
+
+	if line == "---> Using cache" {
+		w.classicHits++
+	}
+}
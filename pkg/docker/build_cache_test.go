@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCacheStatsWriterBuildkit(t *testing.T) {
+	var out bytes.Buffer
+	w := docker.NewBuildCacheStatsWriter(&out)
+
+	output := "#1 [internal] load build definition from Dockerfile\n" +
+		"#5 [1/3] FROM docker.io/library/ubuntu:22.04\n" +
+		"#5 CACHED\n" +
+		"[2024-10-01T10:00:00.000Z] #6 [2/3] RUN apt-get upda"
+
+	_, err := w.Write([]byte(output))
+	require.Nil(t, err)
+	_, err = w.Write([]byte("te\n#6 CACHED\n#7 [3/3] COPY . /app\n#7 DONE 0.1s\n"))
+	require.Nil(t, err)
+
+	cached, total := w.Stats()
+	require.Equal(t, 2, cached)
+	require.Equal(t, 3, total)
+	require.Equal(t, output+"te\n#6 CACHED\n#7 [3/3] COPY . /app\n#7 DONE 0.1s\n", out.String())
+}
+
+func TestBuildCacheStatsWriterClassic(t *testing.T) {
+	w := docker.NewBuildCacheStatsWriter(nil)
+
+	_, err := w.Write([]byte("Step 1/3 : FROM ubuntu\n ---> 1234\nStep 2/3 : RUN apt-get update\n ---> Using cache\n ---> 5678\nStep 3/3 : COPY . /app\n ---> 9abc\n"))
+	require.Nil(t, err)
+
+	cached, total := w.Stats()
+	require.Equal(t, 1, cached)
+	require.Equal(t, 3, total)
+}
@@ -0,0 +1,418 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+type BuildImageOptions struct {
+	// Directory containing the repository, the Dockerfile config paths are relative to it.
+	// When empty, the build context only contains the generated Dockerfile.
+	WorkspaceDir string
+	Dockerfile   *models.DockerfileConfig
+	// Generated Dockerfile content, built instead of the file at Dockerfile.FilePath when set
+	DockerfileContent   string
+	ImageName           string
+	CacheFrom           []string
+	ContainerRegistries common.ContainerRegistries
+	Labels              map[string]string
+	LogWriter           io.Writer
+	SshClient           *ssh.Client
+}
+
+// BuildImage builds an image from a Dockerfile and returns the user the image runs as
+func (d *DockerClient) BuildImage(opts BuildImageOptions) (string, error) {
+	ctx := context.Background()
+
+	contextDir, dockerfilePath, err := getBuildContextPaths(opts.Dockerfile)
+	if err != nil {
+		return "", err
+	}
+
+	buildArgs := map[string]*string{}
+	for k, v := range opts.Dockerfile.Args {
+		buildArgs[k] = &v
+	}
+
+	for _, cacheImage := range opts.CacheFrom {
+		cr := opts.ContainerRegistries.FindContainerRegistryByImageName(cacheImage)
+		err := d.PullImage(cacheImage, cr, opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error pulling cached build image: %v. Continuing without cache.\n", err)))
+			continue
+		}
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", cacheImage)))
+	}
+
+	var buildContext io.ReadCloser
+	if opts.WorkspaceDir == "" {
+		buildContext, err = getDockerfileContext(dockerfilePath, opts.DockerfileContent)
+	} else {
+		buildContext, err = d.getBuildContext(opts.WorkspaceDir, contextDir, dockerfilePath, opts.DockerfileContent, opts.SshClient)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer buildContext.Close()
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Building image from %s...\n", opts.Dockerfile.FilePath)))
+
+	resp, err := d.apiClient.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Tags:        []string{opts.ImageName},
+		Dockerfile:  dockerfilePath,
+		BuildArgs:   buildArgs,
+		Target:      opts.Dockerfile.Target,
+		CacheFrom:   opts.CacheFrom,
+		Labels:      opts.Labels,
+		AuthConfigs: getBuildAuthConfigs(opts.ContainerRegistries),
+		Remove:      true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	err = jsonmessage.DisplayJSONMessagesStream(resp.Body, opts.LogWriter, 0, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	opts.LogWriter.Write([]byte("Image built successfully\n"))
+
+	inspect, _, err := d.apiClient.ImageInspectWithRaw(ctx, opts.ImageName)
+	if err != nil {
+		return "", err
+	}
+
+	if inspect.Config == nil || inspect.Config.User == "" {
+		return "root", nil
+	}
+
+	return inspect.Config.User, nil
+}
+
+// getBuildContextPaths returns the cleaned build context relative to the workspace directory and the
+// Dockerfile path relative to the build context. Both have to stay inside the workspace directory.
+func getBuildContextPaths(dockerfile *models.DockerfileConfig) (string, string, error) {
+	contextDir := dockerfile.Context
+	if contextDir == "" {
+		contextDir = "."
+	}
+	contextDir = filepath.Clean(contextDir)
+	if !filepath.IsLocal(contextDir) {
+		return "", "", fmt.Errorf("build context %s must be a relative path inside the workspace directory", dockerfile.Context)
+	}
+
+	dockerfilePath, err := filepath.Rel(contextDir, filepath.Clean(dockerfile.FilePath))
+	if err != nil || !filepath.IsLocal(dockerfilePath) {
+		return "", "", fmt.Errorf("dockerfile %s must be inside the build context %s", dockerfile.FilePath, contextDir)
+	}
+
+	return filepath.ToSlash(contextDir), filepath.ToSlash(dockerfilePath), nil
+}
+
+// getBuildContext returns the build context directory as a tar stream.
+// Paths matched by the .dockerignore file of the context are left out, except for the Dockerfile.
+// Generated Dockerfile content replaces the Dockerfile of the context when set.
+func (d *DockerClient) getBuildContext(workspaceDir, relContextDir, dockerfilePath, dockerfileContent string, sshClient *ssh.Client) (io.ReadCloser, error) {
+	contextDir := path.Join(workspaceDir, relContextDir)
+	if sshClient == nil {
+		contextDir = filepath.Join(workspaceDir, filepath.FromSlash(relContextDir))
+		err := checkInsideDir(workspaceDir, contextDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var dockerignore []byte
+	var err error
+	if sshClient != nil {
+		dockerignore, err = sshClient.ReadFile(path.Join(contextDir, ".dockerignore"))
+	} else {
+		dockerignore, err = os.ReadFile(filepath.Join(contextDir, ".dockerignore"))
+	}
+	if err != nil {
+		dockerignore = nil
+	}
+
+	ignore := newDockerignoreMatcher(string(dockerignore), dockerfilePath)
+	if dockerfileContent != "" {
+		contextIgnore := ignore
+		ignore = func(relPath string, isDir bool) bool {
+			return relPath == dockerfilePath || contextIgnore(relPath, isDir)
+		}
+	}
+
+	r, w := io.Pipe()
+	tw := tar.NewWriter(w)
+
+	// closeContext adds the generated Dockerfile to the build context and terminates the stream
+	closeContext := func(err error) {
+		if err == nil && dockerfileContent != "" {
+			err = tw.WriteHeader(&tar.Header{
+				Name:     dockerfilePath,
+				Mode:     0644,
+				Size:     int64(len(dockerfileContent)),
+				Typeflag: tar.TypeReg,
+			})
+			if err == nil {
+				_, err = tw.Write([]byte(dockerfileContent))
+			}
+		}
+		if err == nil {
+			err = tw.Close()
+		}
+		w.CloseWithError(err)
+	}
+
+	if sshClient != nil {
+		session, err := sshClient.NewSession()
+		if err != nil {
+			return nil, err
+		}
+
+		stdout, err := session.StdoutPipe()
+		if err != nil {
+			session.Close()
+			return nil, err
+		}
+
+		// The context is checked again on the remote host since symlinks can only be resolved there
+		cmd := fmt.Sprintf(`cd %s && case "$(pwd -P)/" in "$(cd %s && pwd -P)/"*) exec tar -cf - . ;; *) echo "build context is outside of the workspace directory" >&2; exit 1 ;; esac`, common.QuoteShellArg(contextDir), common.QuoteShellArg(workspaceDir))
+
+		err = session.Start(cmd)
+		if err != nil {
+			session.Close()
+			return nil, err
+		}
+
+		go func() {
+			defer session.Close()
+			err := filterTar(stdout, tw, ignore)
+			if err == nil {
+				err = session.Wait()
+			}
+			closeContext(err)
+		}()
+
+		return r, nil
+	}
+
+	go func() {
+		closeContext(writeTar(contextDir, tw, ignore))
+	}()
+
+	return r, nil
+}
+
+// checkInsideDir returns an error if the path resolves to a location outside of dir after following symlinks
+func checkInsideDir(dir, p string) error {
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	resolvedPath, err := filepath.EvalSymlinks(p)
+	if err != nil {
+		return err
+	}
+
+	relPath, err := filepath.Rel(resolvedDir, resolvedPath)
+	if err != nil || !filepath.IsLocal(relPath) {
+		return fmt.Errorf("build context %s is outside of the workspace directory", p)
+	}
+
+	return nil
+}
+
+// getDockerfileContext returns a build context containing only the generated Dockerfile
+func getDockerfileContext(dockerfilePath, dockerfileContent string) (io.ReadCloser, error) {
+	if dockerfileContent == "" {
+		return nil, errors.New("dockerfile content is required when building without a workspace directory")
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	err := tw.WriteHeader(&tar.Header{
+		Name:     dockerfilePath,
+		Mode:     0644,
+		Size:     int64(len(dockerfileContent)),
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tw.Write([]byte(dockerfileContent))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(&buf), nil
+}
+
+func writeTar(dir string, tw *tar.Writer, ignore func(string, bool) bool) error {
+	return filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if ignore(relPath, entry.IsDir()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = relPath
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
+
+// filterTar copies a tar stream while dropping the entries matched by ignore
+func filterTar(r io.Reader, tw *tar.Writer, ignore func(string, bool) bool) error {
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(header.Name, "./"), "/")
+		if name == "" || name == "." || ignore(name, header.Typeflag == tar.TypeDir) {
+			continue
+		}
+		header.Name = name
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(tw, tr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newDockerignoreMatcher returns a function reporting whether a context path is excluded by the
+// .dockerignore content. Dockerignore patterns are always relative to the context root.
+func newDockerignoreMatcher(content, dockerfilePath string) func(string, bool) bool {
+	patterns := []gitignore.Pattern{}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		negate := strings.HasPrefix(line, "!")
+		line = path.Clean(strings.TrimPrefix(strings.TrimPrefix(line, "!"), "/"))
+
+		pattern := "/" + line
+		if negate {
+			pattern = "!" + pattern
+		}
+
+		patterns = append(patterns, gitignore.ParsePattern(pattern, nil))
+	}
+
+	matcher := gitignore.NewMatcher(patterns)
+
+	return func(relPath string, isDir bool) bool {
+		if relPath == dockerfilePath || relPath == ".dockerignore" {
+			return false
+		}
+		return matcher.Match(strings.Split(relPath, "/"), isDir)
+	}
+}
+
+func getBuildAuthConfigs(containerRegistries common.ContainerRegistries) map[string]registry.AuthConfig {
+	authConfigs := map[string]registry.AuthConfig{}
+
+	for _, cr := range containerRegistries {
+		authConfigs[cr.Server] = registry.AuthConfig{
+			Username:      cr.Username,
+			Password:      cr.Password,
+			ServerAddress: cr.Server,
+		}
+	}
+
+	return authConfigs
+}
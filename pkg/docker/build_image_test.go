@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestBuildImage() {
+	workspaceDir := s.T().TempDir()
+
+	files := map[string]string{
+		".dockerignore":         "node_modules\n*.log\n!keep.log\n",
+		"app/Dockerfile":        "FROM alpine",
+		"main.go":               "package main",
+		"debug.log":             "debug",
+		"keep.log":              "keep",
+		"node_modules/index.js": "",
+	}
+
+	for name, content := range files {
+		filePath := filepath.Join(workspaceDir, name)
+		require.Nil(s.T(), os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.Nil(s.T(), os.WriteFile(filePath, []byte(content), 0644))
+	}
+
+	contextFiles := []string{}
+	version := "1.0"
+
+	s.mockClient.On("ImageBuild", mock.Anything, mock.Anything, mock.MatchedBy(func(options types.ImageBuildOptions) bool {
+		return options.Dockerfile == "app/Dockerfile" && options.Target == "dev" && *options.BuildArgs["VERSION"] == version && options.Tags[0] == "test-image:tag"
+	})).Run(func(args mock.Arguments) {
+		tr := tar.NewReader(args.Get(1).(io.Reader))
+		for {
+			header, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.Nil(s.T(), err)
+			contextFiles = append(contextFiles, header.Name)
+		}
+	}).Return(types.ImageBuildResponse{
+		Body: io.NopCloser(strings.NewReader(`{"stream":"Successfully built"}`)),
+	}, nil)
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, "test-image:tag").Return(types.ImageInspect{
+		Config: &container.Config{User: "builder"},
+	}, nil)
+
+	user, err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+		WorkspaceDir: workspaceDir,
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: "app/Dockerfile",
+			Args:     map[string]string{"VERSION": version},
+			Target:   "dev",
+		},
+		ImageName: "test-image:tag",
+		LogWriter: io.Discard,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "builder", user)
+
+	sort.Strings(contextFiles)
+	require.Equal(s.T(), []string{".dockerignore", "app", "app/Dockerfile", "keep.log", "main.go"}, contextFiles)
+}
+
+func (s *DockerClientTestSuite) TestBuildImageDockerfileOutsideContext() {
+	_, err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+		WorkspaceDir: s.T().TempDir(),
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: "Dockerfile",
+			Context:  "app",
+		},
+		ImageName: "test-image:tag",
+		LogWriter: io.Discard,
+	})
+	require.NotNil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestBuildImageWithGeneratedDockerfile() {
+	workspaceDir := s.T().TempDir()
+	require.Nil(s.T(), os.WriteFile(filepath.Join(workspaceDir, "flake.nix"), []byte("{}"), 0644))
+
+	dockerfile := ""
+
+	s.mockClient.On("ImageBuild", mock.Anything, mock.Anything, mock.MatchedBy(func(options types.ImageBuildOptions) bool {
+		return options.Dockerfile == docker.NIX_DOCKERFILE_PATH
+	})).Run(func(args mock.Arguments) {
+		tr := tar.NewReader(args.Get(1).(io.Reader))
+		for {
+			header, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.Nil(s.T(), err)
+			if header.Name == docker.NIX_DOCKERFILE_PATH {
+				content, err := io.ReadAll(tr)
+				require.Nil(s.T(), err)
+				dockerfile = string(content)
+			}
+		}
+	}).Return(types.ImageBuildResponse{
+		Body: io.NopCloser(strings.NewReader(`{"stream":"Successfully built"}`)),
+	}, nil)
+	s.mockClient.On("ImageInspectWithRaw", mock.Anything, "test-image:tag").Return(types.ImageInspect{
+		Config: &container.Config{},
+	}, nil)
+
+	user, err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+		WorkspaceDir: workspaceDir,
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: docker.NIX_DOCKERFILE_PATH,
+		},
+		DockerfileContent: "FROM base-image:tag\n",
+		ImageName:         "test-image:tag",
+		LogWriter:         io.Discard,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "root", user)
+	require.Equal(s.T(), "FROM base-image:tag\n", dockerfile)
+}
+
+func (s *DockerClientTestSuite) TestBuildImageContextOutsideWorkspace() {
+	workspaceDir := s.T().TempDir()
+	require.Nil(s.T(), os.Symlink(s.T().TempDir(), filepath.Join(workspaceDir, "link")))
+
+	for _, dockerfile := range []models.DockerfileConfig{
+		{FilePath: "../../Dockerfile", Context: "../.."},
+		{FilePath: "/Dockerfile", Context: "/"},
+		{FilePath: "app/../../Dockerfile", Context: "app/../.."},
+		{FilePath: "link/Dockerfile", Context: "link"},
+	} {
+		_, err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+			WorkspaceDir: workspaceDir,
+			Dockerfile:   &dockerfile,
+			ImageName:    "test-image:tag",
+			LogWriter:    io.Discard,
+		})
+		require.NotNil(s.T(), err, dockerfile.Context)
+	}
+}
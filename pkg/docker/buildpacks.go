@@ -0,0 +1,184 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+const DEFAULT_BUILDPACKS_BUILDER = "paketobuildpacks/builder-jammy-base:latest"
+
+const buildpacksPlatformApi = "0.12"
+const buildpacksSourceDir = "/source"
+const buildpacksEnvScript = "/etc/profile.d/daytona-buildpacks.sh"
+
+type BuildpacksOptions struct {
+	WorkspaceDir string
+	Buildpacks   *models.BuildpacksConfig
+	ImageName    string
+	// Workspace user created in the resulting image
+	User string
+	// Previous build image used to reuse the buildpack layers
+	CacheFrom           string
+	ContainerRegistries common.ContainerRegistries
+	Labels              map[string]string
+	LogWriter           io.Writer
+}
+
+// BuildFromBuildpacks builds the repository with Cloud Native Buildpacks and extends the resulting image
+// so it can be used as a workspace: the buildpack environment is loaded by every shell and the workspace user is added.
+// The buildpacks lifecycle runs in a builder container so it works the same for local and remote Docker hosts.
+func (d *DockerClient) BuildFromBuildpacks(opts BuildpacksOptions) error {
+	ctx := context.Background()
+
+	// The lifecycle exports the application image to the same Docker daemon over its socket
+	dockerSocket, err := d.getDockerSocketPath()
+	if err != nil {
+		return err
+	}
+
+	builderImage := DEFAULT_BUILDPACKS_BUILDER
+	if opts.Buildpacks != nil && opts.Buildpacks.Builder != "" {
+		builderImage = opts.Buildpacks.Builder
+	}
+
+	err = d.PullImage(builderImage, opts.ContainerRegistries.FindContainerRegistryByImageName(builderImage), opts.LogWriter)
+	if err != nil {
+		return err
+	}
+
+	appImage := fmt.Sprintf("daytona-buildpacks-%s:latest", stringid.TruncateID(stringid.GenerateRandomID()))
+
+	creatorCmd := []string{
+		"/cnb/lifecycle/creator",
+		"-app=/workspace",
+		"-daemon",
+		"-log-level=info",
+	}
+
+	if opts.CacheFrom != "" {
+		cr := opts.ContainerRegistries.FindContainerRegistryByImageName(opts.CacheFrom)
+		err := d.PullImage(opts.CacheFrom, cr, opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error pulling cached build image: %v. Continuing without cache.\n", err)))
+		} else {
+			creatorCmd = append(creatorCmd, "-previous-image="+opts.CacheFrom)
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", opts.CacheFrom)))
+		}
+	}
+
+	creatorCmd = append(creatorCmd, appImage)
+
+	// The repository is copied so buildpacks never modify the workspace directory
+	cmd := fmt.Sprintf("cp -a %s/. /workspace/ && chown -R $CNB_USER_ID:$CNB_GROUP_ID /workspace && %s", buildpacksSourceDir, strings.Join(creatorCmd, " "))
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Building image with buildpacks from %s...\n", builderImage)))
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:      builderImage,
+		Entrypoint: []string{"sh"},
+		Cmd:        []string{"-c", cmd},
+		User:       "root",
+		Env:        []string{"CNB_PLATFORM_API=" + buildpacksPlatformApi},
+	}, &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:     mount.TypeBind,
+				Source:   opts.WorkspaceDir,
+				Target:   buildpacksSourceDir,
+				ReadOnly: true,
+			},
+			{
+				Type:   mount.TypeBind,
+				Source: dockerSocket,
+				Target: "/var/run/docker.sock",
+			},
+		},
+	}, nil, nil, fmt.Sprintf("daytona-buildpacks-%s", stringid.TruncateID(stringid.GenerateRandomID())))
+	if err != nil {
+		return err
+	}
+	defer d.RemoveContainer(c.ID) // nolint:errcheck
+
+	waitResponse, errChan := d.apiClient.ContainerWait(ctx, c.ID, container.WaitConditionNextExit)
+
+	err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		err := d.GetContainerLogs(c.ID, opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error reading buildpacks output: %v\n", err)))
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return err
+		}
+	case resp := <-waitResponse:
+		if resp.Error != nil {
+			return fmt.Errorf("buildpacks container exited with error: %s", resp.Error.Message)
+		}
+		if resp.StatusCode != 0 {
+			return fmt.Errorf("buildpacks container exited with status %d", resp.StatusCode)
+		}
+	}
+
+	defer d.DeleteImage(appImage, true, nil) // nolint:errcheck
+
+	_, err = d.BuildImage(BuildImageOptions{
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: "Dockerfile",
+		},
+		DockerfileContent:   GetBuildpacksDockerfile(appImage, opts.User),
+		ImageName:           opts.ImageName,
+		ContainerRegistries: opts.ContainerRegistries,
+		Labels:              opts.Labels,
+		LogWriter:           opts.LogWriter,
+	})
+
+	return err
+}
+
+func (d *DockerClient) getDockerSocketPath() (string, error) {
+	host := d.apiClient.DaemonHost()
+
+	socketPath, ok := strings.CutPrefix(host, "unix://")
+	if !ok || socketPath == "" {
+		return "", fmt.Errorf("building with buildpacks requires a Docker daemon reachable over a unix socket, got %s", host)
+	}
+
+	return socketPath, nil
+}
+
+// GetBuildpacksDockerfile returns a Dockerfile that turns a buildpacks application image into a workspace image
+func GetBuildpacksDockerfile(appImage, user string) string {
+	lines := []string{
+		fmt.Sprintf("FROM %s", appImage),
+		"USER root",
+		// The run images of the builders are Ubuntu or Alpine based
+		"RUN (apt-get update && apt-get install -y sudo curl git bash || apk add --no-cache sudo curl git bash) && rm -rf /var/lib/apt/lists/*",
+		fmt.Sprintf("RUN (id -u %[1]s || useradd -m -s /bin/bash %[1]s || adduser -D -s /bin/bash %[1]s) && echo '%[1]s ALL=(ALL) NOPASSWD:ALL' > /etc/sudoers.d/%[1]s", user),
+		// The launcher exposes the runtimes installed by the buildpacks
+		fmt.Sprintf(`RUN ["/cnb/lifecycle/launcher", "sh", "-c", "export -p | grep -v -E ' (HOME|HOSTNAME|PWD|OLDPWD|SHLVL|USER)=' > %s"]`, buildpacksEnvScript),
+		fmt.Sprintf("RUN echo '. %s' >> /etc/bash.bashrc", buildpacksEnvScript),
+		"ENTRYPOINT []",
+		fmt.Sprintf("USER %s", user),
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
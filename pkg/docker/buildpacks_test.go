@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuildpacksDockerfile(t *testing.T) {
+	dockerfile := docker.GetBuildpacksDockerfile("app-image:latest", "daytona")
+
+	lines := strings.Split(strings.TrimSpace(dockerfile), "\n")
+	require.Equal(t, "FROM app-image:latest", lines[0])
+	require.Equal(t, "USER daytona", lines[len(lines)-1])
+	require.Contains(t, dockerfile, "useradd -m -s /bin/bash daytona")
+	require.Contains(t, dockerfile, "/etc/sudoers.d/daytona")
+	require.Contains(t, dockerfile, `RUN ["/cnb/lifecycle/launcher", "sh", "-c", "export -p`)
+}
@@ -49,6 +49,8 @@ type IDockerClient interface {
 	DeleteImage(imageName string, force bool, logWriter io.Writer) error
 
 	CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error)
+	BuildImage(opts BuildImageOptions) (string, error)
+	BuildFromBuildpacks(opts BuildpacksOptions) error
 	RemoveContainer(containerName string) error
 }
 
@@ -82,17 +84,35 @@ func (d *DockerClient) GetWorkspaceVolumeName(workspace *models.Workspace) strin
 	return workspace.TargetId + "-" + workspace.Id
 }
 
+// getComposeContainers returns the compose project of the workspace container
+// and the containers of the other services in that project
 func (d *DockerClient) getComposeContainers(c types.ContainerJSON) (string, []types.Container, error) {
 	ctx := context.Background()
 
-	for k, v := range c.Config.Labels {
-		if k == "com.docker.compose.workspace" {
-			containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{
-				Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("com.docker.compose.workspace=%s", v))),
-			})
-			return v, containers, err
+	if c.Config == nil {
+		return "", nil, nil
+	}
+
+	composeProject, ok := c.Config.Labels[composeProjectLabel]
+	if !ok {
+		return "", nil, nil
+	}
+
+	containers, err := d.apiClient.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", composeProjectLabel, composeProject))),
+		All:     true,
+	})
+	if err != nil {
+		return composeProject, nil, err
+	}
+
+	serviceContainers := []types.Container{}
+	for _, container := range containers {
+		if c.ContainerJSONBase != nil && container.ID == c.ID {
+			continue
 		}
+		serviceContainers = append(serviceContainers, container)
 	}
 
-	return "", nil, nil
+	return composeProject, serviceContainers, nil
 }
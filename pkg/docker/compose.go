@@ -0,0 +1,179 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+)
+
+const (
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+func (d *DockerClient) getWorkspaceServices(c types.ContainerJSON) ([]models.WorkspaceService, error) {
+	_, composeContainers, err := d.getComposeContainers(c)
+	if err != nil {
+		return nil, err
+	}
+
+	services := []models.WorkspaceService{}
+	for _, c := range composeContainers {
+		services = append(services, models.WorkspaceService{
+			Name:   getComposeServiceName(c),
+			State:  c.State,
+			Status: c.Status,
+		})
+	}
+
+	return services, nil
+}
+
+// streamComposeServiceLogs writes the logs of the other compose services, starting at the given time,
+// to the log writer. The returned function stops the streaming and must be called before the log writer
+// is closed since the service containers usually outlive it.
+func (d *DockerClient) streamComposeServiceLogs(w *models.Workspace, since time.Time, logWriter io.Writer) (func(), error) {
+	if logWriter == nil {
+		return func() {}, nil
+	}
+
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetWorkspaceContainerName(w))
+	if err != nil {
+		return nil, err
+	}
+
+	_, composeContainers, err := d.getComposeContainers(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range composeContainers {
+		writer := &serviceLogWriter{
+			prefix: fmt.Sprintf("[%s] ", getComposeServiceName(c)),
+			writer: logWriter,
+			mu:     &mu,
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := d.copyContainerLogs(ctx, c.ID, container.LogsOptions{
+				ShowStdout: true,
+				ShowStderr: true,
+				Follow:     true,
+				Since:      fmt.Sprint(since.Unix()),
+			}, writer)
+			if err != nil && ctx.Err() == nil {
+				writer.writeLine(fmt.Sprintf("Failed to read logs of service %s: %s\n", getComposeServiceName(c), err))
+			}
+		}()
+	}
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}, nil
+}
+
+// removeComposeResources removes the networks and volumes created for the compose project
+func (d *DockerClient) removeComposeResources(composeProject string) error {
+	ctx := context.Background()
+	projectFilter := filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", composeProjectLabel, composeProject)))
+
+	networks, err := d.apiClient.NetworkList(ctx, network.ListOptions{
+		Filters: projectFilter,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, n := range networks {
+		err = d.apiClient.NetworkRemove(ctx, n.ID)
+		if err != nil && !client.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	volumes, err := d.apiClient.VolumeList(ctx, volume.ListOptions{
+		Filters: projectFilter,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range volumes.Volumes {
+		err = d.apiClient.VolumeRemove(ctx, v.Name, true)
+		if err != nil && !client.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getComposeServiceName(c types.Container) string {
+	if service, ok := c.Labels[composeServiceLabel]; ok {
+		return service
+	}
+
+	if len(c.Names) > 0 {
+		return strings.TrimPrefix(c.Names[0], "/")
+	}
+
+	return c.ID
+}
+
+// serviceLogWriter prefixes every line with the name of the service it came from
+type serviceLogWriter struct {
+	prefix string
+	writer io.Writer
+	mu     *sync.Mutex
+	buf    []byte
+}
+
+func (w *serviceLogWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i == -1 {
+			break
+		}
+
+		err := w.writeLine(string(w.buf[:i+1]))
+		if err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (w *serviceLogWriter) writeLine(line string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.writer.Write([]byte(w.prefix + line))
+	return err
+}
@@ -16,16 +16,20 @@ func (d *DockerClient) GetContainerLogs(containerName string, logWriter io.Write
 		return nil
 	}
 
-	inspect, err := d.apiClient.ContainerInspect(context.Background(), containerName)
+	return d.copyContainerLogs(context.Background(), containerName, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	}, logWriter)
+}
+
+func (d *DockerClient) copyContainerLogs(ctx context.Context, containerName string, options container.LogsOptions, logWriter io.Writer) error {
+	inspect, err := d.apiClient.ContainerInspect(ctx, containerName)
 	if err != nil {
 		return err
 	}
 
-	logs, err := d.apiClient.ContainerLogs(context.Background(), containerName, container.LogsOptions{
-		ShowStdout: true,
-		ShowStderr: true,
-		Follow:     true,
-	})
+	logs, err := d.apiClient.ContainerLogs(ctx, containerName, options)
 	if err != nil {
 		return err
 	}
@@ -62,6 +62,12 @@ func (d *DockerClient) CreateWorkspace(opts *CreateWorkspaceOptions) error {
 		case detect.BuilderTypeDevcontainer:
 			_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
 			return err
+		case detect.BuilderTypeDockerfile:
+			return d.createWorkspaceFromDockerfile(opts, pulledImages)
+		case detect.BuilderTypeNix:
+			return d.createWorkspaceFromNix(opts, pulledImages)
+		case detect.BuilderTypeBuildpacks:
+			return d.createWorkspaceFromBuildpacks(opts, pulledImages)
 		case detect.BuilderTypeImage:
 			return d.createWorkspaceFromImage(opts, pulledImages, true)
 		default:
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pulledImages map keeps track of pulled images for workspace creation in order to avoid pulling the same image multiple times
+// The workspace falls back to its image when buildpacks can not build the repository
+func (d *DockerClient) createWorkspaceFromBuildpacks(opts *CreateWorkspaceOptions, pulledImages map[string]bool) error {
+	imageName := fmt.Sprintf("daytona-%s:latest", strings.ToLower(opts.Workspace.Id))
+
+	cacheFrom := ""
+	if opts.Workspace.BuildConfig.CachedBuild != nil {
+		cacheFrom = opts.Workspace.BuildConfig.CachedBuild.Image
+	}
+
+	err := d.BuildFromBuildpacks(BuildpacksOptions{
+		WorkspaceDir:        opts.WorkspaceDir,
+		Buildpacks:          opts.Workspace.BuildConfig.Buildpacks,
+		ImageName:           imageName,
+		User:                opts.Workspace.User,
+		CacheFrom:           cacheFrom,
+		ContainerRegistries: opts.ContainerRegistries,
+		Labels: map[string]string{
+			"daytona.target.id":    opts.Workspace.TargetId,
+			"daytona.workspace.id": opts.Workspace.Id,
+		},
+		LogWriter: opts.LogWriter,
+	})
+	if err != nil {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to build the workspace with buildpacks: %v. Falling back to %s\n", err, opts.Workspace.Image)))
+		}
+		return d.createWorkspaceFromImage(opts, pulledImages, true)
+	}
+
+	opts.Workspace.Image = imageName
+	pulledImages[imageName] = true
+
+	return d.createWorkspaceFromImage(opts, pulledImages, true)
+}
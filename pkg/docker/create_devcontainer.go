@@ -51,6 +51,10 @@ type CreateDevcontainerOptions struct {
 	EnvVars             map[string]string
 	IdLabels            map[string]string
 	BuilderImage        string
+	// Additional images the build layers can be reused from
+	CacheFrom []string
+	// Cache export passed to the image build, e.g. type=inline
+	CacheTo string
 }
 
 func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
@@ -278,15 +282,25 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		devcontainerCmd = append(devcontainerCmd, "--id-label", fmt.Sprintf("%s=%s", k, v))
 	}
 
+	cacheFrom := opts.CacheFrom
 	if opts.BuildConfig.CachedBuild != nil {
-		cr := opts.ContainerRegistries.FindContainerRegistryByImageName(opts.BuildConfig.CachedBuild.Image)
-		err := d.PullImage(opts.BuildConfig.CachedBuild.Image, cr, opts.LogWriter)
+		cacheFrom = append([]string{opts.BuildConfig.CachedBuild.Image}, cacheFrom...)
+	}
+
+	for _, cacheImage := range cacheFrom {
+		cr := opts.ContainerRegistries.FindContainerRegistryByImageName(cacheImage)
+		err := d.PullImage(cacheImage, cr, opts.LogWriter)
 		if err != nil {
 			opts.LogWriter.Write([]byte(fmt.Sprintf("Error pulling cached build image: %v. Continuing without cache.\n", err)))
+			continue
 		}
 
-		devcontainerCmd = append(devcontainerCmd, "--cache-from", opts.BuildConfig.CachedBuild.Image)
-		opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", opts.BuildConfig.CachedBuild.Image)))
+		devcontainerCmd = append(devcontainerCmd, "--cache-from", cacheImage)
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", cacheImage)))
+	}
+
+	if opts.CacheTo != "" {
+		devcontainerCmd = append(devcontainerCmd, "--cache-to", opts.CacheTo)
 	}
 
 	if opts.Prebuild {
@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pulledImages map keeps track of pulled images for workspace creation in order to avoid pulling the same image multiple times
+func (d *DockerClient) createWorkspaceFromDockerfile(opts *CreateWorkspaceOptions, pulledImages map[string]bool) error {
+	cacheFrom := []string{}
+	if opts.Workspace.BuildConfig.CachedBuild != nil {
+		cacheFrom = append(cacheFrom, opts.Workspace.BuildConfig.CachedBuild.Image)
+	}
+
+	imageName := fmt.Sprintf("daytona-%s:latest", strings.ToLower(opts.Workspace.Id))
+
+	user, err := d.BuildImage(BuildImageOptions{
+		WorkspaceDir:        opts.WorkspaceDir,
+		Dockerfile:          opts.Workspace.BuildConfig.Dockerfile,
+		ImageName:           imageName,
+		CacheFrom:           cacheFrom,
+		ContainerRegistries: opts.ContainerRegistries,
+		Labels: map[string]string{
+			"daytona.target.id":    opts.Workspace.TargetId,
+			"daytona.workspace.id": opts.Workspace.Id,
+		},
+		LogWriter: opts.LogWriter,
+		SshClient: opts.SshClient,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The workspace container runs the built image as the user defined in the Dockerfile
+	opts.Workspace.Image = imageName
+	opts.Workspace.User = user
+	pulledImages[imageName] = true
+
+	return d.createWorkspaceFromImage(opts, pulledImages, true)
+}
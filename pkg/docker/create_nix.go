@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+// pulledImages map keeps track of pulled images for workspace creation in order to avoid pulling the same image multiple times
+// The workspace falls back to its image when the Nix dev shell can not be built
+func (d *DockerClient) createWorkspaceFromNix(opts *CreateWorkspaceOptions, pulledImages map[string]bool) error {
+	dockerfile, err := GetNixDockerfile(opts.Workspace.BuildConfig.Nix, opts.Workspace.Image, opts.Workspace.User)
+	if err != nil {
+		return err
+	}
+
+	imageName := fmt.Sprintf("daytona-%s:latest", strings.ToLower(opts.Workspace.Id))
+
+	_, err = d.BuildImage(BuildImageOptions{
+		WorkspaceDir: opts.WorkspaceDir,
+		Dockerfile: &models.DockerfileConfig{
+			FilePath: NIX_DOCKERFILE_PATH,
+		},
+		DockerfileContent:   dockerfile,
+		ImageName:           imageName,
+		ContainerRegistries: opts.ContainerRegistries,
+		Labels: map[string]string{
+			"daytona.target.id":    opts.Workspace.TargetId,
+			"daytona.workspace.id": opts.Workspace.Id,
+		},
+		LogWriter: opts.LogWriter,
+		SshClient: opts.SshClient,
+	})
+	if err != nil {
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to build the Nix dev shell: %v. Falling back to %s\n", err, opts.Workspace.Image)))
+		}
+		return d.createWorkspaceFromImage(opts, pulledImages, true)
+	}
+
+	opts.Workspace.Image = imageName
+	pulledImages[imageName] = true
+
+	return d.createWorkspaceFromImage(opts, pulledImages, true)
+}
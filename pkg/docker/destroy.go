@@ -59,12 +59,12 @@ func (d *DockerClient) removeWorkspaceContainer(w *models.Workspace) error {
 	}
 
 	// TODO: Add logging
-	_, composeContainers, err := d.getComposeContainers(c)
+	composeProject, composeContainers, err := d.getComposeContainers(c)
 	if err != nil {
 		return err
 	}
 
-	if composeContainers == nil {
+	if composeProject == "" {
 		return nil
 	}
 
@@ -78,7 +78,7 @@ func (d *DockerClient) removeWorkspaceContainer(w *models.Workspace) error {
 		}
 	}
 
-	return nil
+	return d.removeComposeResources(composeProject)
 }
 
 func (d *DockerClient) RemoveContainer(containerName string) error {
@@ -5,9 +5,12 @@ package docker_test
 
 import (
 	"os"
+	"slices"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +51,53 @@ func (s *DockerClientTestSuite) TestDestroyWorkspace() {
 	_, err = os.Stat(workspaceDir)
 	require.True(s.T(), os.IsNotExist(err))
 }
+
+func (s *DockerClientTestSuite) TestDestroyComposeWorkspace() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.MatchedBy(isComposeProjectListOptions)).Return([]types.Container{
+		{ID: "workspace-container-id"},
+		{ID: "db-container-id"},
+	}, nil)
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetWorkspaceContainerName(workspace1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID: "workspace-container-id",
+		},
+		Config: &container.Config{
+			Labels: map[string]string{
+				"com.docker.compose.project": "test-project",
+			},
+		},
+	}, nil)
+
+	removeOptions := container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}
+	s.mockClient.On("ContainerRemove", mock.Anything, containerName, removeOptions).Return(nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, "db-container-id", removeOptions).Return(nil)
+
+	s.mockClient.On("VolumeRemove", mock.Anything, s.dockerClient.GetWorkspaceVolumeName(workspace1), true).Return(nil)
+
+	s.mockClient.On("NetworkList", mock.Anything, mock.Anything).Return([]network.Inspect{
+		{ID: "test-project-network-id"},
+	}, nil)
+	s.mockClient.On("NetworkRemove", mock.Anything, "test-project-network-id").Return(nil)
+	s.mockClient.On("VolumeList", mock.Anything, mock.Anything).Return(volume.ListResponse{
+		Volumes: []*volume.Volume{
+			{Name: "test-project_db-data"},
+		},
+	}, nil)
+	s.mockClient.On("VolumeRemove", mock.Anything, "test-project_db-data", true).Return(nil)
+
+	workspaceDir := s.T().TempDir()
+
+	err := s.dockerClient.DestroyWorkspace(workspace1, workspaceDir, nil)
+	require.Nil(s.T(), err)
+}
+
+func isComposeProjectListOptions(options container.ListOptions) bool {
+	return slices.Contains(options.Filters.Get("label"), "com.docker.compose.project=test-project")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"maps"
 
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/docker/docker/api/types"
@@ -26,7 +27,25 @@ func (d *DockerClient) GetWorkspaceProviderMetadata(w *models.Workspace) (string
 		return "", errors.New("container labels not found")
 	}
 
-	metadata, err := json.Marshal(info.Config.Labels)
+	labels := info.Config.Labels
+
+	// Sidecar services of compose based workspaces are reported along with the container labels
+	if _, ok := labels[composeProjectLabel]; ok {
+		services, err := d.getWorkspaceServices(*info)
+		if err != nil {
+			return "", err
+		}
+
+		servicesJson, err := json.Marshal(services)
+		if err != nil {
+			return "", err
+		}
+
+		labels = maps.Clone(labels)
+		labels[models.WorkspaceServicesProviderMetadataKey] = string(servicesJson)
+	}
+
+	metadata, err := json.Marshal(labels)
 	if err != nil {
 		return "", err
 	}
@@ -36,3 +36,36 @@ func (s *DockerClientTestSuite) TestGetWorkspaceProviderMetadata() {
 	require.Nil(s.T(), err)
 	require.Equal(s.T(), workspaceMetadata, metadata)
 }
+
+func (s *DockerClientTestSuite) TestGetComposeWorkspaceProviderMetadata() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.MatchedBy(isComposeProjectListOptions)).Return([]types.Container{
+		{ID: "workspace-container-id"},
+		{
+			ID:     "db-container-id",
+			Labels: map[string]string{"com.docker.compose.service": "db"},
+			State:  "running",
+			Status: "Up 5 minutes",
+		},
+	}, nil)
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetWorkspaceContainerName(workspace1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID: "workspace-container-id",
+		},
+		Config: &container.Config{
+			Labels: map[string]string{
+				"com.docker.compose.project": "test-project",
+			},
+		},
+	}, nil)
+
+	workspaceMetadata, err := s.dockerClient.GetWorkspaceProviderMetadata(workspace1)
+	require.Nil(s.T(), err)
+	require.JSONEq(s.T(), `{
+		"com.docker.compose.project": "test-project",
+		"daytona.services": "[{\"name\":\"db\",\"state\":\"running\",\"status\":\"Up 5 minutes\"}]"
+	}`, workspaceMetadata)
+}
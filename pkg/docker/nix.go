@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+// Path of the generated Dockerfile inside the build context, chosen to not collide with repository files
+const NIX_DOCKERFILE_PATH = ".daytona-nix.Dockerfile"
+
+const nixInstallUrl = "https://install.determinate.systems/nix"
+const nixSourceDir = "/tmp/daytona-nix"
+const nixDevEnvScript = "/etc/profile.d/daytona-nix.sh"
+
+// devenv is installed from a fixed release so the resulting images are reproducible
+const devenvFlakeRef = "github:cachix/devenv/v1.3.1"
+
+// Paths of the dev shell closure exported by the intermediate stage
+const nixRootsFile = "/tmp/daytona-nix-roots"
+const nixClosureDir = "/tmp/daytona-nix-closure"
+const nixRegistrationFile = "/tmp/daytona-nix-registration"
+
+// GetNixDockerfile returns a multi-stage Dockerfile that installs Nix on top of the base image and bakes
+// the dev shell closure of the Nix file into the image. The dev shell environment is loaded by every shell.
+func GetNixDockerfile(nix *models.NixConfig, baseImage, user string) (string, error) {
+	dir := path.Dir(path.Clean(nix.FilePath))
+	nixCmd := "nix --extra-experimental-features 'nix-command flakes'"
+
+	var devEnvCmd string
+	switch path.Base(nix.FilePath) {
+	case "flake.nix":
+		devEnvCmd = fmt.Sprintf("%s print-dev-env 'path:%s'", nixCmd, path.Join(nixSourceDir, dir))
+	case "shell.nix":
+		devEnvCmd = fmt.Sprintf("%s print-dev-env --file '%s'", nixCmd, path.Join(nixSourceDir, nix.FilePath))
+	case "devenv.nix":
+		devEnvCmd = fmt.Sprintf("%s profile install '%s#devenv' && cd '%s' && devenv print-dev-env", nixCmd, devenvFlakeRef, path.Join(nixSourceDir, dir))
+	default:
+		return "", fmt.Errorf("unsupported nix file: %s", nix.FilePath)
+	}
+
+	// The dev shell closure consists of the store paths referenced by the environment script and their
+	// dependencies. Only the closure is exported, so sources copied into the store by the evaluation
+	// (e.g. the repository of a flake) are not shipped in the final image.
+	exportClosureCmd := strings.Join([]string{
+		fmt.Sprintf("grep -oE '/nix/store/[0-9a-z]{32}-[A-Za-z0-9+._-]+' %s | sort -u > %s", nixDevEnvScript, nixRootsFile),
+		fmt.Sprintf("nix-store -qR $(cat %s) > /tmp/daytona-nix-closure-paths", nixRootsFile),
+		fmt.Sprintf("mkdir -p %s", nixClosureDir),
+		fmt.Sprintf("tar -cf - $(cat /tmp/daytona-nix-closure-paths) | tar -xf - -C %s", nixClosureDir),
+		fmt.Sprintf("nix-store --dump-db $(cat /tmp/daytona-nix-closure-paths) > %s", nixRegistrationFile),
+	}, " && ")
+
+	// The closure is registered in the Nix database of the final image and its roots are protected
+	// from garbage collection
+	importClosureCmd := strings.Join([]string{
+		fmt.Sprintf("nix-store --load-db < %s", nixRegistrationFile),
+		fmt.Sprintf(`for p in $(cat %s); do ln -sfn "$p" "/nix/var/nix/gcroots/$(basename "$p")"; done`, nixRootsFile),
+		fmt.Sprintf("rm %s %s", nixRegistrationFile, nixRootsFile),
+	}, " && ")
+
+	// The source is only copied into an intermediate stage so it is not shipped in a layer of the
+	// final image. The final image only takes the dev shell closure and the dev shell environment script.
+	lines := []string{
+		fmt.Sprintf("FROM %s AS nix-base", baseImage),
+		"USER root",
+		fmt.Sprintf("RUN curl --proto '=https' --tlsv1.2 -sSf -L %s | sh -s -- install linux --init none --no-confirm", nixInstallUrl),
+		"ENV PATH=/nix/var/nix/profiles/default/bin:$PATH",
+		"",
+		"FROM nix-base AS nix-env",
+		fmt.Sprintf("COPY . %s", nixSourceDir),
+		fmt.Sprintf("RUN %s > %s", devEnvCmd, nixDevEnvScript),
+		fmt.Sprintf("RUN %s", exportClosureCmd),
+		"",
+		"FROM nix-base",
+		fmt.Sprintf("COPY --from=nix-env %s/nix/store /nix/store", nixClosureDir),
+		fmt.Sprintf("COPY --from=nix-env %s %s", nixRegistrationFile, nixRegistrationFile),
+		fmt.Sprintf("COPY --from=nix-env %s %s", nixRootsFile, nixRootsFile),
+		fmt.Sprintf("COPY --from=nix-env %s %s", nixDevEnvScript, nixDevEnvScript),
+		fmt.Sprintf("RUN %s", importClosureCmd),
+		fmt.Sprintf("RUN echo '. %s' >> /etc/bash.bashrc", nixDevEnvScript),
+		fmt.Sprintf("USER %s", user),
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
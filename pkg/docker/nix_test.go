@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNixDockerfile(t *testing.T) {
+	dockerfile, err := docker.GetNixDockerfile(&models.NixConfig{FilePath: "nix/flake.nix"}, "base-image:tag", "daytona")
+	require.Nil(t, err)
+	require.Contains(t, dockerfile, "FROM base-image:tag AS nix-base\n")
+	require.Contains(t, dockerfile, "\nFROM nix-base\nCOPY --from=nix-env /tmp/daytona-nix-closure/nix/store /nix/store\n")
+	// Only the dev shell closure is shipped, not the whole store containing the flake source
+	require.NotContains(t, dockerfile, "COPY --from=nix-env /nix /nix")
+	require.Contains(t, dockerfile, "nix-store -qR $(cat /tmp/daytona-nix-roots)")
+	require.Contains(t, dockerfile, "nix-store --load-db < /tmp/daytona-nix-registration")
+	require.NotContains(t, dockerfile, "rm -rf")
+	require.Contains(t, dockerfile, "print-dev-env 'path:/tmp/daytona-nix/nix'")
+	require.Contains(t, dockerfile, "\nUSER daytona\n")
+
+	dockerfile, err = docker.GetNixDockerfile(&models.NixConfig{FilePath: "shell.nix"}, "base-image:tag", "daytona")
+	require.Nil(t, err)
+	require.Contains(t, dockerfile, "--file '/tmp/daytona-nix/shell.nix'")
+
+	dockerfile, err = docker.GetNixDockerfile(&models.NixConfig{FilePath: "devenv.nix"}, "base-image:tag", "daytona")
+	require.Nil(t, err)
+	require.Contains(t, dockerfile, "profile install 'github:cachix/devenv/v1.3.1#devenv' && cd '/tmp/daytona-nix' && devenv print-dev-env")
+
+	_, err = docker.GetNixDockerfile(&models.NixConfig{FilePath: "default.nix"}, "base-image:tag", "daytona")
+	require.NotNil(t, err)
+}
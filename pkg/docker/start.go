@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/models"
@@ -19,6 +20,7 @@ import (
 func (d *DockerClient) StartWorkspace(opts *CreateWorkspaceOptions, daytonaDownloadUrl string) error {
 	var err error
 	containerUser := opts.Workspace.User
+	startTime := time.Now()
 
 	builderType, err := detect.DetectWorkspaceBuilderType(opts.Workspace.BuildConfig, opts.WorkspaceDir, opts.SshClient)
 	if err != nil {
@@ -30,7 +32,9 @@ func (d *DockerClient) StartWorkspace(opts *CreateWorkspaceOptions, daytonaDownl
 		var remoteUser RemoteUser
 		remoteUser, err = d.startDevcontainerWorkspace(opts)
 		containerUser = string(remoteUser)
-	case detect.BuilderTypeImage:
+	case detect.BuilderTypeDockerfile:
+		containerUser, err = d.startDockerfileWorkspace(opts)
+	case detect.BuilderTypeImage, detect.BuilderTypeNix, detect.BuilderTypeBuildpacks:
 		err = d.startImageWorkspace(opts)
 	default:
 		return fmt.Errorf("unknown builder type: %s", builderType)
@@ -40,6 +44,14 @@ func (d *DockerClient) StartWorkspace(opts *CreateWorkspaceOptions, daytonaDownl
 		return err
 	}
 
+	// The service logs are streamed until the agent is started, the log writer is closed afterwards
+	stopServiceLogs, err := d.streamComposeServiceLogs(opts.Workspace, startTime, opts.LogWriter)
+	if err != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to stream compose service logs: %s\n", err)))
+	} else {
+		defer stopServiceLogs()
+	}
+
 	return d.startDaytonaAgent(opts.Workspace, containerUser, daytonaDownloadUrl, opts.LogWriter)
 }
 
@@ -42,6 +42,8 @@ func (d *DockerClient) runDevcontainerUserCommands(opts *CreateWorkspaceOptions)
 		"--override-config=" + path.Join(paths.OverridesTarget, "devcontainer.json"),
 		"--id-label=daytona.target.id=" + opts.Workspace.TargetId,
 		"--id-label=daytona.workspace.id=" + opts.Workspace.Id,
+		// Commands after waitFor are run by the agent on every start
+		"--skip-non-blocking-commands",
 	}
 
 	cmd := strings.Join(devcontainerCmd, " ")
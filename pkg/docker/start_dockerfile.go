@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+)
+
+func (d *DockerClient) startDockerfileWorkspace(opts *CreateWorkspaceOptions) (string, error) {
+	err := d.startImageWorkspace(opts)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetWorkspaceContainerName(opts.Workspace))
+	if err != nil {
+		return "", err
+	}
+
+	if c.Config == nil || c.Config.User == "" {
+		return "root", nil
+	}
+
+	return c.Config.User, nil
+}
@@ -29,13 +29,12 @@ func (d *DockerClient) startImageWorkspace(opts *CreateWorkspaceOptions) error {
 		return err
 	}
 
-	// TODO: Add logging
 	_, composeContainers, err := d.getComposeContainers(c)
 	if err != nil {
 		return err
 	}
 
-	if composeContainers != nil {
+	if len(composeContainers) > 0 {
 		if opts.LogWriter != nil {
 			opts.LogWriter.Write([]byte("Starting compose containers\n"))
 		}
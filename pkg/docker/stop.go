@@ -46,13 +46,12 @@ func (d *DockerClient) stopWorkspaceContainer(w *models.Workspace, logWriter io.
 		time.Sleep(1 * time.Second)
 	}
 
-	// TODO: Add logging
 	_, composeContainers, err := d.getComposeContainers(c)
 	if err != nil {
 		return err
 	}
 
-	if composeContainers == nil {
+	if len(composeContainers) == 0 {
 		return nil
 	}
 
@@ -61,6 +60,10 @@ func (d *DockerClient) stopWorkspaceContainer(w *models.Workspace, logWriter io.
 	}
 
 	for _, c := range composeContainers {
+		if c.State != "running" {
+			continue
+		}
+
 		err = d.apiClient.ContainerStop(ctx, c.ID, container.StopOptions{
 			Signal: "SIGKILL",
 		})
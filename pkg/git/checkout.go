@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Checkout switches the worktree to the given branch, tag or commit.
+// A local branch tracking the remote branch of the same name is created if needed.
+func (s *Service) Checkout(ref string, create bool) error {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid ref: %s", ref)
+	}
+
+	args := []string{"checkout"}
+	if create {
+		args = append(args, "-b")
+	}
+	// The separator keeps a ref that matches a file name from being treated as a pathspec,
+	// which would discard the changes of that file instead of switching the worktree
+	args = append(args, ref, "--")
+
+	_, err := s.runGitCommand(args...)
+	return err
+}
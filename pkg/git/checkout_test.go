@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/stretchr/testify/require"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	output, err := cmd.CombinedOutput()
+	require.Nil(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
+
+func TestCheckoutRefNamedLikeModifiedFile(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-b", "main")
+
+	filePath := filepath.Join(dir, "release")
+	require.Nil(t, os.WriteFile(filePath, []byte("committed\n"), 0644))
+	runGit(t, dir, "add", "release")
+	runGit(t, dir, "commit", "-m", "initial")
+
+	require.Nil(t, os.WriteFile(filePath, []byte("modified\n"), 0644))
+
+	gitService := &git.Service{WorkspaceDir: dir}
+
+	// The ref does not exist, so the file of the same name must not be checked out instead
+	err := gitService.Checkout("release", false)
+	require.NotNil(t, err)
+
+	content, err := os.ReadFile(filePath)
+	require.Nil(t, err)
+	require.Equal(t, "modified\n", string(content))
+
+	require.Nil(t, gitService.Checkout("release", true))
+	require.Equal(t, "release", runGit(t, dir, "rev-parse", "--abbrev-ref", "HEAD"))
+
+	content, err = os.ReadFile(filePath)
+	require.Nil(t, err)
+	require.Equal(t, "modified\n", string(content))
+}
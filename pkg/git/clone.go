@@ -4,9 +4,16 @@
 package git
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 
+	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -15,7 +22,21 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
+const (
+	submodulesWarning = "Warning: failed to initialize submodules"
+	lfsWarning        = "Warning: failed to fetch LFS objects"
+)
+
 func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	// go-git supports neither partial clones nor cone mode sparse checkouts
+	if len(repo.SparsePaths) > 0 {
+		err := s.cloneSparseRepository(repo, auth)
+		if err != nil {
+			return err
+		}
+		return s.fetchRepositoryContent(repo, auth)
+	}
+
 	cloneOptions := &git.CloneOptions{
 		URL:             repo.Url,
 		SingleBranch:    true,
@@ -37,6 +58,9 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 	}
 
 	cloneOptions.ReferenceName = plumbing.ReferenceName("refs/heads/" + repo.Branch)
+	if repo.Target == gitprovider.CloneTargetTag {
+		cloneOptions.ReferenceName = plumbing.ReferenceName("refs/tags/" + repo.Branch)
+	}
 
 	_, err := git.PlainClone(s.WorkspaceDir, false, cloneOptions)
 	if err != nil {
@@ -62,11 +86,206 @@ func (s *Service) CloneRepository(repo *gitprovider.GitRepository, auth *http.Ba
 		}
 	}
 
-	return err
+	return s.fetchRepositoryContent(repo, auth)
 }
 
+// CloneRepositoryCmd returns the clone command of the repository as shell arguments.
+// All interpolated values are single-quoted as the command is run with sh -c.
 func (s *Service) CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http.BasicAuth) []string {
-	cloneCmd := []string{"git", "clone", "--single-branch", "--branch", fmt.Sprintf("\"%s\"", repo.Branch)}
+	workspaceDir := common.QuoteShellArg(s.WorkspaceDir)
+
+	cloneCmd := []string{"git", "clone", "--single-branch", "--branch", common.QuoteShellArg(repo.Branch)}
+	if len(repo.SparsePaths) > 0 {
+		cloneCmd = append(cloneCmd, "--filter=blob:none", "--sparse")
+	}
+
+	cloneCmd = append(cloneCmd, common.QuoteShellArg(getCloneUrl(repo, auth)), workspaceDir)
+
+	if len(repo.SparsePaths) > 0 {
+		cloneCmd = append(cloneCmd, "&&", "git", "-C", workspaceDir, "sparse-checkout", "set", "--cone")
+		for _, sparsePath := range gitprovider.NormalizeSparsePaths(repo.SparsePaths) {
+			cloneCmd = append(cloneCmd, common.QuoteShellArg(sparsePath))
+		}
+	}
+
+	if repo.Target == gitprovider.CloneTargetCommit {
+		cloneCmd = append(cloneCmd, "&&", "cd", workspaceDir)
+		cloneCmd = append(cloneCmd, "&&", "git", "checkout", common.QuoteShellArg(repo.Sha))
+	}
+
+	for _, sparsePath := range gitprovider.NormalizeSparsePaths(repo.SparsePaths) {
+		cloneCmd = append(cloneCmd, "&&", "git", "-C", workspaceDir, "config", "--add", "submodule.active", common.QuoteShellArg(sparsePath))
+	}
+
+	// Submodules and LFS objects are fetched on a best-effort basis, failures only print a warning
+	cloneCmd = append(cloneCmd, "&&", "(", "git", "-C", workspaceDir)
+	if auth != nil {
+		key, value := getCredentialsUrlRewrite(repo, auth)
+		cloneCmd = append(cloneCmd, "-c", common.QuoteShellArg(fmt.Sprintf("%s=%s", key, value)))
+	}
+	cloneCmd = append(cloneCmd, "submodule", "update", "--init", "--recursive", "||", "echo", common.QuoteShellArg(submodulesWarning), ")")
+
+	if repo.Lfs != nil {
+		// LFS objects are fetched with the filters after the checkout
+		cloneCmd = append([]string{"GIT_LFS_SKIP_SMUDGE=1"}, cloneCmd...)
+		cloneCmd = append(cloneCmd, "&&", "(", "command", "-v", "git-lfs", ">/dev/null")
+		if len(repo.Lfs.Include) > 0 {
+			cloneCmd = append(cloneCmd, "&&", "git", "-C", workspaceDir, "config", "lfs.fetchinclude", common.QuoteShellArg(strings.Join(repo.Lfs.Include, ",")))
+		}
+		if len(repo.Lfs.Exclude) > 0 {
+			cloneCmd = append(cloneCmd, "&&", "git", "-C", workspaceDir, "config", "lfs.fetchexclude", common.QuoteShellArg(strings.Join(repo.Lfs.Exclude, ",")))
+		}
+		cloneCmd = append(cloneCmd, "&&", "git", "-C", workspaceDir, "lfs", "install", "--local", "&&", "git", "-C", workspaceDir, "lfs", "pull", "||", "echo", common.QuoteShellArg(lfsWarning), ")")
+	}
+
+	return cloneCmd
+}
+
+// cloneSparseRepository creates a partial clone without blobs and checks out only the sparse paths of the repository.
+// Blobs outside the sparse paths are fetched on demand.
+func (s *Service) cloneSparseRepository(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	// The credentials are passed through the environment so they are not persisted in the remote URL
+	err := s.runRemoteGitCommand(repo, auth, "clone", "--progress", "--single-branch", "--branch", repo.Branch, "--filter=blob:none", "--sparse", getCloneUrl(repo, nil), s.WorkspaceDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.runGitCommand(append([]string{"sparse-checkout", "set", "--cone"}, gitprovider.NormalizeSparsePaths(repo.SparsePaths)...)...)
+	if err != nil {
+		return err
+	}
+
+	if repo.Target == gitprovider.CloneTargetCommit {
+		_, err = s.runGitCommand("checkout", repo.Sha)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchRepositoryContent initializes the submodules of the cloned repository and fetches its LFS objects.
+// Both are best-effort, failures are reported as warnings in the log and don't fail the clone.
+// The credentials are only used for the host of the repository and are not persisted in the git config.
+func (s *Service) fetchRepositoryContent(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	_, err := os.Stat(filepath.Join(s.WorkspaceDir, ".gitmodules"))
+	if err == nil {
+		err = s.initSubmodules(repo, auth)
+		if err != nil {
+			s.logProgress(fmt.Sprintf("%s: %s\n", submodulesWarning, err))
+		}
+	}
+
+	if repo.Lfs == nil {
+		return nil
+	}
+
+	err = s.fetchLfsObjects(repo, auth)
+	if err != nil {
+		s.logProgress(fmt.Sprintf("%s: %s\n", lfsWarning, err))
+	}
+
+	return nil
+}
+
+func (s *Service) initSubmodules(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	_, err := exec.LookPath("git")
+	if err != nil {
+		return errors.New("git is not installed")
+	}
+
+	// Only the submodules inside of a sparse checkout are initialized
+	for _, sparsePath := range gitprovider.NormalizeSparsePaths(repo.SparsePaths) {
+		_, err = s.runGitCommand("config", "--add", "submodule.active", sparsePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.logProgress("Initializing submodules...\n")
+	return s.runRemoteGitCommand(repo, auth, "-C", s.WorkspaceDir, "submodule", "update", "--init", "--recursive", "--progress")
+}
+
+func (s *Service) fetchLfsObjects(repo *gitprovider.GitRepository, auth *http.BasicAuth) error {
+	_, err := exec.LookPath("git-lfs")
+	if err != nil {
+		return errors.New("git-lfs is not installed")
+	}
+
+	// The filters are stored in the repository config so they also apply to later checkouts and pulls
+	if len(repo.Lfs.Include) > 0 {
+		_, err = s.runGitCommand("config", "lfs.fetchinclude", strings.Join(repo.Lfs.Include, ","))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(repo.Lfs.Exclude) > 0 {
+		_, err = s.runGitCommand("config", "lfs.fetchexclude", strings.Join(repo.Lfs.Exclude, ","))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = s.runGitCommand("lfs", "install", "--local")
+	if err != nil {
+		return err
+	}
+
+	s.logProgress("Fetching LFS objects...\n")
+	return s.runRemoteGitCommand(repo, auth, "-C", s.WorkspaceDir, "lfs", "pull")
+}
+
+// runRemoteGitCommand runs a git command that communicates with the remote and streams its progress into the log writer.
+// If the repository is set, the credentials are provided for the host of the repository only.
+func (s *Service) runRemoteGitCommand(repo *gitprovider.GitRepository, auth *http.BasicAuth, args ...string) error {
+	cmd := exec.Command("git", args...)
+	// LFS objects are only fetched on demand with the configured filters
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_SSL_NO_VERIFY=true", "GIT_LFS_SKIP_SMUDGE=1")
+	if repo != nil && auth != nil {
+		key, value := getCredentialsUrlRewrite(repo, auth)
+		cmd.Env = append(cmd.Env, "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0="+key, "GIT_CONFIG_VALUE_0="+value)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if s.LogWriter != nil {
+		cmd.Stderr = io.MultiWriter(s.LogWriter, &stderr)
+	}
+
+	err := cmd.Run()
+	if err != nil {
+		command := args[0]
+		if command == "-C" && len(args) > 2 {
+			command = args[2]
+		}
+		return fmt.Errorf("git %s failed: %s", command, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func (s *Service) logProgress(message string) {
+	if s.LogWriter != nil {
+		_, _ = s.LogWriter.Write([]byte(message))
+	}
+}
+
+// getCredentialsUrlRewrite returns the git config key and value that rewrite the URLs
+// of the repository host to URLs with credentials
+func getCredentialsUrlRewrite(repo *gitprovider.GitRepository, auth *http.BasicAuth) (string, string) {
+	cloneUrl := getCloneUrl(repo, nil)
+	scheme, rest, _ := strings.Cut(cloneUrl, "://")
+	host, _, _ := strings.Cut(rest, "/")
+
+	baseUrl := fmt.Sprintf("%s://%s/", scheme, host)
+	credentialsUrl := fmt.Sprintf("%s://%s:%s@%s/", scheme, auth.Username, auth.Password, host)
+
+	return fmt.Sprintf("url.%s.insteadOf", credentialsUrl), baseUrl
+}
+
+func getCloneUrl(repo *gitprovider.GitRepository, auth *http.BasicAuth) string {
 	cloneUrl := repo.Url
 
 	// Default to https protocol if not specified
@@ -78,12 +297,5 @@ func (s *Service) CloneRepositoryCmd(repo *gitprovider.GitRepository, auth *http
 		cloneUrl = fmt.Sprintf("%s://%s:%s@%s", strings.Split(cloneUrl, "://")[0], auth.Username, auth.Password, strings.SplitN(cloneUrl, "://", 2)[1])
 	}
 
-	cloneCmd = append(cloneCmd, cloneUrl, s.WorkspaceDir)
-
-	if repo.Target == gitprovider.CloneTargetCommit {
-		cloneCmd = append(cloneCmd, "&&", "cd", s.WorkspaceDir)
-		cloneCmd = append(cloneCmd, "&&", "git", "checkout", repo.Sha)
-	}
-
-	return cloneCmd
+	return cloneUrl
 }
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// runGitCommand runs git inside the workspace directory and returns its standard output.
+// Operations that are not supported by go-git (stash, merge, rebase, ...) rely on the git binary.
+func (s *Service) runGitCommand(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", s.WorkspaceDir}, args...)...)
+	// Never open an editor or prompt for credentials
+	cmd.Env = append(os.Environ(), "GIT_EDITOR=true", "GIT_TERMINAL_PROMPT=0")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = strings.TrimSpace(stdout.String())
+		}
+		if message == "" {
+			return "", err
+		}
+		return "", fmt.Errorf("git %s failed: %s", args[0], message)
+	}
+
+	return stdout.String(), nil
+}
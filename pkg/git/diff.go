@@ -0,0 +1,180 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+func (s *Service) Diff(options GitDiffOptions) ([]GitFileDiff, error) {
+	for _, ref := range []string{options.FromRef, options.ToRef} {
+		if strings.HasPrefix(ref, "-") {
+			return nil, fmt.Errorf("invalid ref: %s", ref)
+		}
+	}
+
+	args := []string{"-c", "core.quotePath=false", "diff", "--no-color", "--no-ext-diff", "-M"}
+	if options.Staged {
+		args = append(args, "--cached")
+	}
+	if options.FromRef != "" {
+		args = append(args, options.FromRef)
+		if options.ToRef != "" {
+			args = append(args, options.ToRef)
+		}
+	}
+	args = append(args, "--")
+	args = append(args, options.Files...)
+
+	output, err := s.runGitCommand(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDiff(output), nil
+}
+
+// parseDiff parses the output of git diff into per-file hunks
+func parseDiff(output string) []GitFileDiff {
+	files := []GitFileDiff{}
+
+	var file *GitFileDiff
+	var hunk *GitDiffHunk
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			oldPath, newPath := parseDiffGitLine(strings.TrimPrefix(line, "diff --git "))
+			files = append(files, GitFileDiff{
+				OldPath: oldPath,
+				NewPath: newPath,
+				Status:  models.Modified,
+				Hunks:   []GitDiffHunk{},
+			})
+			file = &files[len(files)-1]
+			hunk = nil
+			continue
+		}
+
+		if file == nil {
+			continue
+		}
+
+		if hunk != nil && line != "" && strings.ContainsAny(line[:1], " +-\\") {
+			hunk.Lines = append(hunk.Lines, line)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@@ "):
+			file.Hunks = append(file.Hunks, parseHunkHeader(line))
+			hunk = &file.Hunks[len(file.Hunks)-1]
+		case strings.HasPrefix(line, "new file mode"):
+			file.Status = models.Added
+		case strings.HasPrefix(line, "deleted file mode"):
+			file.Status = models.Deleted
+		case strings.HasPrefix(line, "rename from "):
+			file.Status = models.Renamed
+			file.OldPath = unquotePath(strings.TrimPrefix(line, "rename from "))
+		case strings.HasPrefix(line, "rename to "):
+			file.NewPath = unquotePath(strings.TrimPrefix(line, "rename to "))
+		case strings.HasPrefix(line, "copy from "):
+			file.Status = models.Copied
+			file.OldPath = unquotePath(strings.TrimPrefix(line, "copy from "))
+		case strings.HasPrefix(line, "copy to "):
+			file.NewPath = unquotePath(strings.TrimPrefix(line, "copy to "))
+		case strings.HasPrefix(line, "Binary files "):
+			file.Binary = true
+		case strings.HasPrefix(line, "--- "):
+			if path := unquotePath(strings.TrimPrefix(line, "--- ")); path != "/dev/null" {
+				file.OldPath = strings.TrimPrefix(path, "a/")
+			}
+		case strings.HasPrefix(line, "+++ "):
+			if path := unquotePath(strings.TrimPrefix(line, "+++ ")); path != "/dev/null" {
+				file.NewPath = strings.TrimPrefix(path, "b/")
+			}
+		}
+	}
+
+	return files
+}
+
+// parseDiffGitLine extracts the paths from the "a/old b/new" part of a diff header.
+// The paths are refined later by the ---/+++ and rename lines when present.
+func parseDiffGitLine(paths string) (string, string) {
+	if strings.HasPrefix(paths, `"`) {
+		oldPath, rest, found := cutQuoted(paths)
+		if found {
+			return strings.TrimPrefix(oldPath, "a/"), strings.TrimPrefix(unquotePath(strings.TrimSpace(rest)), "b/")
+		}
+	}
+
+	idx := strings.LastIndex(paths, " b/")
+	if idx == -1 {
+		return paths, paths
+	}
+
+	return strings.TrimPrefix(paths[:idx], "a/"), unquotePath(paths[idx+3:])
+}
+
+func cutQuoted(value string) (string, string, bool) {
+	for i := 1; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+			continue
+		}
+		if value[i] == '"' {
+			unquoted, err := strconv.Unquote(value[:i+1])
+			if err != nil {
+				return "", "", false
+			}
+			return unquoted, value[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+// unquotePath removes the quoting git adds to paths with special characters
+func unquotePath(path string) string {
+	if !strings.HasPrefix(path, `"`) {
+		return path
+	}
+
+	unquoted, err := strconv.Unquote(path)
+	if err != nil {
+		return path
+	}
+	return unquoted
+}
+
+func parseHunkHeader(line string) GitDiffHunk {
+	hunk := GitDiffHunk{
+		Header:   line,
+		OldLines: 1,
+		NewLines: 1,
+		Lines:    []string{},
+	}
+
+	matches := hunkHeaderRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return hunk
+	}
+
+	hunk.OldStart, _ = strconv.Atoi(matches[1])
+	if matches[2] != "" {
+		hunk.OldLines, _ = strconv.Atoi(matches[2])
+	}
+	hunk.NewStart, _ = strconv.Atoi(matches[3])
+	if matches[4] != "" {
+		hunk.NewLines, _ = strconv.Atoi(matches[4])
+	}
+
+	return hunk
+}
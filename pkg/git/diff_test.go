@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+const diffOutput = `diff --git a/README.md b/README.md
+index 3b18e51..a8d6b4c 100644
+--- a/README.md
++++ b/README.md
+@@ -1,3 +1,4 @@ Title
+ # Daytona
+-old line
++new line
++-- not a header
+ last line
+@@ -10 +11,0 @@
+-removed
+\ No newline at end of file
+diff --git a/docs/new file.md b/docs/new file.md
+new file mode 100644
+index 0000000..e69de29
+--- /dev/null
++++ b/docs/new file.md
+@@ -0,0 +1 @@
++hello
+diff --git a/old.go b/new.go
+similarity index 90%
+rename from old.go
+rename to new.go
+index 1111111..2222222 100644
+--- a/old.go
++++ b/new.go
+@@ -1 +1 @@
+-package old
++package new
+diff --git a/image.png b/image.png
+deleted file mode 100644
+index 1111111..0000000
+Binary files a/image.png and /dev/null differ
+`
+
+func TestParseDiff(t *testing.T) {
+	files := parseDiff(diffOutput)
+	require.Len(t, files, 4)
+
+	readme := files[0]
+	require.Equal(t, "README.md", readme.OldPath)
+	require.Equal(t, "README.md", readme.NewPath)
+	require.Equal(t, models.Modified, readme.Status)
+	require.Len(t, readme.Hunks, 2)
+	require.Equal(t, GitDiffHunk{
+		Header:   "@@ -1,3 +1,4 @@ Title",
+		OldStart: 1,
+		OldLines: 3,
+		NewStart: 1,
+		NewLines: 4,
+		Lines:    []string{" # Daytona", "-old line", "+new line", "+-- not a header", " last line"},
+	}, readme.Hunks[0])
+	require.Equal(t, 10, readme.Hunks[1].OldStart)
+	require.Equal(t, 1, readme.Hunks[1].OldLines)
+	require.Equal(t, 0, readme.Hunks[1].NewLines)
+	require.Equal(t, []string{"-removed", `\ No newline at end of file`}, readme.Hunks[1].Lines)
+
+	added := files[1]
+	require.Equal(t, "docs/new file.md", added.NewPath)
+	require.Equal(t, models.Added, added.Status)
+	require.Equal(t, []string{"+hello"}, added.Hunks[0].Lines)
+
+	renamed := files[2]
+	require.Equal(t, "old.go", renamed.OldPath)
+	require.Equal(t, "new.go", renamed.NewPath)
+	require.Equal(t, models.Renamed, renamed.Status)
+
+	deleted := files[3]
+	require.Equal(t, "image.png", deleted.OldPath)
+	require.Equal(t, models.Deleted, deleted.Status)
+	require.True(t, deleted.Binary)
+	require.Empty(t, deleted.Hunks)
+}
+
+func TestParseDiff_Empty(t *testing.T) {
+	require.Empty(t, parseDiff(""))
+}
+
+func TestDiff_RejectsOptionRefs(t *testing.T) {
+	s := &Service{WorkspaceDir: t.TempDir()}
+
+	_, err := s.Diff(GitDiffOptions{FromRef: "--output=/tmp/diff"})
+	require.Error(t, err)
+
+	_, err = s.Diff(GitDiffOptions{FromRef: "HEAD", ToRef: "-R"})
+	require.Error(t, err)
+}
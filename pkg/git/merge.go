@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Merge merges the branch into the current branch.
+// Conflicts do not return an error, they are listed in the result and can be resolved or aborted afterwards.
+func (s *Service) Merge(branch string, noFastForward bool) (*GitMergeResult, error) {
+	if branch == "" || strings.HasPrefix(branch, "-") {
+		return nil, fmt.Errorf("invalid branch: %s", branch)
+	}
+
+	args := []string{"merge", "--no-edit"}
+	if noFastForward {
+		args = append(args, "--no-ff")
+	}
+	args = append(args, branch)
+
+	return s.runConflictingCommand(args...)
+}
+
+func (s *Service) MergeAbort() error {
+	_, err := s.runGitCommand("merge", "--abort")
+	return err
+}
+
+func (s *Service) RebaseContinue() (*GitMergeResult, error) {
+	return s.runConflictingCommand("rebase", "--continue")
+}
+
+func (s *Service) RebaseAbort() error {
+	_, err := s.runGitCommand("rebase", "--abort")
+	return err
+}
+
+// runConflictingCommand runs a command that can stop on conflicts and reports the conflicting files
+func (s *Service) runConflictingCommand(args ...string) (*GitMergeResult, error) {
+	_, cmdErr := s.runGitCommand(args...)
+
+	conflicts, err := s.listConflicts()
+	if err != nil {
+		return nil, err
+	}
+
+	if cmdErr != nil && len(conflicts) == 0 {
+		return nil, cmdErr
+	}
+
+	return &GitMergeResult{
+		Conflicts: conflicts,
+	}, nil
+}
+
+func (s *Service) listConflicts() ([]string, error) {
+	output, err := s.runGitCommand("diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []string{}
+	for _, file := range strings.Split(strings.TrimSpace(output), "\n") {
+		if file != "" {
+			conflicts = append(conflicts, file)
+		}
+	}
+
+	return conflicts, nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (s *Service) Reset(ref string, mode GitResetMode) error {
+	switch mode {
+	case GitResetModeSoft, GitResetModeMixed, GitResetModeHard:
+	default:
+		return fmt.Errorf("invalid reset mode: %s", mode)
+	}
+
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid ref: %s", ref)
+	}
+
+	_, err := s.runGitCommand("reset", "--"+string(mode), ref, "--")
+	return err
+}
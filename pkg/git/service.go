@@ -31,6 +31,16 @@ type IGitService interface {
 	RepositoryExists() (bool, error)
 	SetGitConfig(userData *gitprovider.GitUser, providerConfig *models.GitProviderConfig) error
 	GetGitStatus() (*models.GitStatus, error)
+	Diff(options GitDiffOptions) ([]GitFileDiff, error)
+	Checkout(ref string, create bool) error
+	StashPush(message string, includeUntracked bool) error
+	StashPop(index int) error
+	StashList() ([]GitStashEntry, error)
+	Reset(ref string, mode GitResetMode) error
+	Merge(branch string, noFastForward bool) (*GitMergeResult, error)
+	MergeAbort() error
+	RebaseContinue() (*GitMergeResult, error)
+	RebaseAbort() error
 }
 
 type Service struct {
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func (s *Service) StashPush(message string, includeUntracked bool) error {
+	args := []string{"stash", "push"}
+	if includeUntracked {
+		args = append(args, "--include-untracked")
+	}
+	if message != "" {
+		args = append(args, "--message", message)
+	}
+
+	_, err := s.runGitCommand(args...)
+	return err
+}
+
+func (s *Service) StashPop(index int) error {
+	_, err := s.runGitCommand("stash", "pop", fmt.Sprintf("stash@{%d}", index))
+	return err
+}
+
+func (s *Service) StashList() ([]GitStashEntry, error) {
+	output, err := s.runGitCommand("stash", "list", "--format=%gd%x00%gs")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []GitStashEntry{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		ref, message, found := strings.Cut(line, "\x00")
+		if !found {
+			continue
+		}
+
+		index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(ref, "stash@{"), "}"))
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, GitStashEntry{
+			Index:   index,
+			Message: message,
+		})
+	}
+
+	return entries, nil
+}
@@ -34,8 +34,18 @@ func (s *Service) GetGitStatus() (*models.GitStatus, error) {
 		return nil, err
 	}
 
+	skipWorktree, err := getSkipWorktreePaths(repo)
+	if err != nil {
+		return nil, err
+	}
+
 	files := []*models.FileStatus{}
 	for path, file := range status {
+		// Files outside of a sparse checkout are not present in the worktree but are not deleted
+		if skipWorktree[path] {
+			continue
+		}
+
 		files = append(files, &models.FileStatus{
 			Name:     path,
 			Extra:    file.Extra,
@@ -63,6 +73,22 @@ func (s *Service) GetGitStatus() (*models.GitStatus, error) {
 	}, nil
 }
 
+func getSkipWorktreePaths(repo *git.Repository) (map[string]bool, error) {
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[string]bool{}
+	for _, entry := range idx.Entries {
+		if entry.SkipWorktree {
+			paths[entry.Name] = true
+		}
+	}
+
+	return paths, nil
+}
+
 func (s *Service) isBranchPublished() (bool, error) {
 	upstream, err := s.getUpstreamBranch()
 	if err != nil {
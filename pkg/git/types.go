@@ -3,7 +3,11 @@
 
 package git
 
-import "time"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/models"
+)
 
 type GitCommitInfo struct {
 	Hash      string    `json:"hash" validate:"required"`
@@ -12,3 +16,49 @@ type GitCommitInfo struct {
 	Message   string    `json:"message" validate:"required"`
 	Timestamp time.Time `json:"timestamp" validate:"required"`
 } // @name GitCommitInfo
+
+type GitFileDiff struct {
+	OldPath string        `json:"oldPath" validate:"required"`
+	NewPath string        `json:"newPath" validate:"required"`
+	Status  models.Status `json:"status" validate:"required"`
+	Binary  bool          `json:"binary" validate:"required"`
+	Hunks   []GitDiffHunk `json:"hunks" validate:"required"`
+} // @name GitFileDiff
+
+type GitDiffHunk struct {
+	Header   string `json:"header" validate:"required"`
+	OldStart int    `json:"oldStart" validate:"required"`
+	OldLines int    `json:"oldLines" validate:"required"`
+	NewStart int    `json:"newStart" validate:"required"`
+	NewLines int    `json:"newLines" validate:"required"`
+	// Lines of the hunk prefixed with ' ', '+' or '-'
+	Lines []string `json:"lines" validate:"required"`
+} // @name GitDiffHunk
+
+type GitDiffOptions struct {
+	// Staged compares the index with HEAD instead of the working tree with the index
+	Staged bool
+	// FromRef compares the working tree (or the index if Staged is set) with the ref.
+	// If ToRef is also set, the two refs are compared.
+	FromRef string
+	ToRef   string
+	Files   []string
+}
+
+type GitStashEntry struct {
+	Index   int    `json:"index" validate:"required"`
+	Message string `json:"message" validate:"required"`
+} // @name GitStashEntry
+
+type GitMergeResult struct {
+	// Files with unresolved conflicts, empty if the operation completed
+	Conflicts []string `json:"conflicts" validate:"required"`
+} // @name GitMergeResult
+
+type GitResetMode string
+
+const (
+	GitResetModeSoft  GitResetMode = "soft"
+	GitResetModeMixed GitResetMode = "mixed"
+	GitResetModeHard  GitResetMode = "hard"
+)
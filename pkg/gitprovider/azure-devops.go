@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -24,6 +25,11 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/servicehooks"
 )
 
+var azurePrebuildEventTypes = []string{"git.push", "git.pullrequest.created", "git.pullrequest.updated"}
+
+// Basic auth username of the prebuild webhook requests
+const azureWebhookUsername = "daytona"
+
 type AzureDevOpsGitProvider struct {
 	*AbstractGitProvider
 
@@ -612,7 +618,7 @@ func (g *AzureDevOpsGitProvider) getLocationClient() location.Client {
 	return client
 }
 
-func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	coreClient, conn, err := g.getApiClient()
 	if err != nil {
 		return "", err
@@ -628,32 +634,39 @@ func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, en
 	}
 	projectID := project.Id.String()
 
-	subscription := servicehooks.Subscription{
-		PublisherId:      util.Pointer("tfs"),
-		EventType:        util.Pointer("git.push"),
-		ResourceVersion:  util.Pointer("1.0"),
-		ConsumerActionId: util.Pointer("httpRequest"),
-		ConsumerId:       util.Pointer("webHooks"),
-		ConsumerInputs: &map[string]string{
-			"url":         endpointUrl,
-			"httpHeaders": "X-AzureDevops-Event:git.push\nX-Owner:" + repo.Owner,
-		},
-		PublisherInputs: &map[string]string{
-			"projectId":  projectID,
-			"repository": repo.Id,
-		},
-		Status: &servicehooks.SubscriptionStatusValues.Enabled,
-	}
+	// Service hook subscriptions are created per event type, so the webhook ID holds all subscription IDs
+	var hookIds []string
+	for _, eventType := range azurePrebuildEventTypes {
+		subscription := servicehooks.Subscription{
+			PublisherId:      util.Pointer("tfs"),
+			EventType:        util.Pointer(eventType),
+			ResourceVersion:  util.Pointer("1.0"),
+			ConsumerActionId: util.Pointer("httpRequest"),
+			ConsumerId:       util.Pointer("webHooks"),
+			ConsumerInputs: &map[string]string{
+				"url":               endpointUrl,
+				"httpHeaders":       "X-AzureDevops-Event:" + eventType + "\nX-Owner:" + repo.Owner,
+				"basicAuthUsername": azureWebhookUsername,
+				"basicAuthPassword": secret,
+			},
+			PublisherInputs: &map[string]string{
+				"projectId":  projectID,
+				"repository": repo.Id,
+			},
+			Status: &servicehooks.SubscriptionStatusValues.Enabled,
+		}
 
-	var hook *servicehooks.Subscription
-	hook, err = serviceHooksClient.CreateSubscription(context.Background(), servicehooks.CreateSubscriptionArgs{
-		Subscription: &subscription,
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to create subscription: %w", err)
+		hook, err := serviceHooksClient.CreateSubscription(context.Background(), servicehooks.CreateSubscriptionArgs{
+			Subscription: &subscription,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to create subscription: %w", err)
+		}
+
+		hookIds = append(hookIds, hook.Id.String())
 	}
 
-	return hook.Id.String(), nil
+	return strings.Join(hookIds, ","), nil
 }
 
 func (g *AzureDevOpsGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error) {
@@ -663,23 +676,32 @@ func (g *AzureDevOpsGitProvider) GetPrebuildWebhook(repo *GitRepository, endpoin
 	}
 
 	serviceHooksClient := servicehooks.NewClient(context.Background(), conn)
-	hooks, err := serviceHooksClient.ListSubscriptions(context.Background(), servicehooks.ListSubscriptionsArgs{
-		PublisherId:      util.Pointer("tfs"),
-		ConsumerActionId: util.Pointer("httpRequest"),
-		ConsumerId:       util.Pointer("webHooks"),
-		EventType:        util.Pointer("git.push"),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
-	}
 
-	for _, hook := range *hooks {
-		if (*hook.ConsumerInputs)["url"] == endpointUrl {
-			return util.Pointer(hook.Id.String()), nil
+	var hookIds []string
+	for _, eventType := range azurePrebuildEventTypes {
+		hooks, err := serviceHooksClient.ListSubscriptions(context.Background(), servicehooks.ListSubscriptionsArgs{
+			PublisherId:      util.Pointer("tfs"),
+			ConsumerActionId: util.Pointer("httpRequest"),
+			ConsumerId:       util.Pointer("webHooks"),
+			EventType:        util.Pointer(eventType),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		for _, hook := range *hooks {
+			if (*hook.ConsumerInputs)["url"] == endpointUrl {
+				hookIds = append(hookIds, hook.Id.String())
+				break
+			}
 		}
 	}
 
-	return nil, nil
+	if len(hookIds) == 0 {
+		return nil, nil
+	}
+
+	return util.Pointer(strings.Join(hookIds, ",")), nil
 }
 
 func (g *AzureDevOpsGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
@@ -688,17 +710,19 @@ func (g *AzureDevOpsGitProvider) UnregisterPrebuildWebhook(repo *GitRepository,
 		return err
 	}
 
-	uuid, err := uuid.Parse(id)
-	if err != nil {
-		return err
-	}
-
 	serviceHooksClient := servicehooks.NewClient(context.Background(), conn)
 
-	if err := serviceHooksClient.DeleteSubscription(context.Background(), servicehooks.DeleteSubscriptionArgs{
-		SubscriptionId: &uuid,
-	}); err != nil {
-		return fmt.Errorf("failed to delete subscription: %w", err)
+	for _, hookId := range strings.Split(id, ",") {
+		uuid, err := uuid.Parse(hookId)
+		if err != nil {
+			return err
+		}
+
+		if err := serviceHooksClient.DeleteSubscription(context.Background(), servicehooks.DeleteSubscriptionArgs{
+			SubscriptionId: &uuid,
+		}); err != nil {
+			return fmt.Errorf("failed to delete subscription: %w", err)
+		}
 	}
 
 	return nil
@@ -729,31 +753,75 @@ func (g *AzureDevOpsGitProvider) GetCommitsRange(repo *GitRepository, initialSha
 	return *commits.AheadCount, nil
 }
 
+func (g *AzureDevOpsGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	// Service hooks do not sign events so the secret is sent as the basic auth password
+	username, password, ok := request.BasicAuth()
+	if !ok || username != azureWebhookUsername {
+		return ErrInvalidWebhookSignature
+	}
+
+	return verifyWebhookToken(password, secret)
+}
+
 func (g *AzureDevOpsGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-AzureDevops-Event") != "git.push" {
-		return nil, fmt.Errorf("invalid event key: %s", request.Header.Get("X-AzureDevops-Event"))
+	eventKey := request.Header.Get("X-AzureDevops-Event")
+	if !slices.Contains(azurePrebuildEventTypes, eventKey) {
+		return nil, fmt.Errorf("invalid event key: %s", eventKey)
 	}
 
 	hook, err := azureWebhook.New()
 	if err != nil {
 		return nil, err
 	}
-	event, err := hook.Parse(request, azureWebhook.GitPushEventType)
+	event, err := hook.Parse(request, azureWebhook.GitPushEventType, azureWebhook.GitPullRequestCreatedEventType, azureWebhook.GitPullRequestUpdatedEventType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse event: %w", err)
 	}
+
+	owner := request.Header.Get("X-Owner")
+
+	if pullRequestEvent, ok := event.(azureWebhook.GitPullRequestEvent); ok {
+		pr := pullRequestEvent.Resource
+		prNumber := uint32(pr.PullRequestID)
+		targetBranch := strings.TrimPrefix(pr.TargetRefName, "refs/heads/")
+
+		return &GitEventData{
+			Type:         GitEventTypePullRequest,
+			Owner:        owner,
+			Url:          util.CleanUpRepositoryUrl(pr.Repository.RemoteURL),
+			Branch:       strings.TrimPrefix(pr.SourceRefName, "refs/heads/"),
+			Sha:          pr.LastMergeSourceCommit.CommitID,
+			PrNumber:     &prNumber,
+			TargetBranch: &targetBranch,
+		}, nil
+	}
+
 	pushEvent, ok := event.(azureWebhook.GitPushEvent)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse push event: %w", err)
 	}
 
-	owner := request.Header.Get("X-Owner")
+	// Branch and tag deletions point the ref to an empty object
+	if len(pushEvent.Resource.RefUpdates) == 0 || strings.Trim(pushEvent.Resource.RefUpdates[0].NewObjectID, "0") == "" {
+		return nil, nil
+	}
+
+	refUpdate := pushEvent.Resource.RefUpdates[0]
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Owner:  owner,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Resource.Repository.RemoteURL),
-		Branch: strings.TrimPrefix(pushEvent.Resource.Repository.DefaultBranch, "refs/heads/"),
-		Sha:    pushEvent.Resource.Commits[0].CommitID,
+		Branch: strings.TrimPrefix(refUpdate.Name, "refs/heads/"),
+		Sha:    refUpdate.NewObjectID,
+	}
+
+	if strings.HasPrefix(refUpdate.Name, "refs/tags/") {
+		tag := strings.TrimPrefix(refUpdate.Name, "refs/tags/")
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		gitEventData.Branch = tag
+		return gitEventData, nil
 	}
 
 	for _, commit := range pushEvent.Resource.Commits {
@@ -435,6 +435,8 @@ func (g *BitbucketGitProvider) GetPrContext(staticContext *StaticGitContext) (*S
 	repo.Owner = parts[0]
 	repo.Name = parts[1]
 	repo.Id = fullName
+	// The source repository differs from the destination repository for pull requests from forks
+	repo.Url = getCloneUrl(staticContext.Source, repo.Owner, repo.Name, true)
 
 	branch, ok := source["branch"].(map[string]interface{})
 	if !ok {
@@ -448,6 +450,14 @@ func (g *BitbucketGitProvider) GetPrContext(staticContext *StaticGitContext) (*S
 
 	repo.Branch = &branchName
 
+	if destination, ok := prMap["destination"].(map[string]interface{}); ok {
+		if destinationBranch, ok := destination["branch"].(map[string]interface{}); ok {
+			if baseBranch, ok := destinationBranch["name"].(string); ok {
+				repo.PrBaseBranch = &baseBranch
+			}
+		}
+	}
+
 	return &repo, nil
 }
 
@@ -534,15 +544,16 @@ type prResponseData struct {
 	} `json:"values"`
 }
 
-func (g *BitbucketGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *BitbucketGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
 	hook, err := client.Repositories.Webhooks.Create(&bitbucket.WebhooksOptions{
 		Active:   true,
 		Owner:    repo.Owner,
-		Events:   []string{"repo:push"},
+		Events:   []string{"repo:push", "pullrequest:created", "pullrequest:updated"},
 		Url:      endpointUrl,
 		RepoSlug: repo.Id,
+		Secret:   secret,
 	})
 
 	if err != nil {
@@ -591,6 +602,48 @@ func (g *BitbucketGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id
 	return g.FormatError(err)
 }
 
+func (g *BitbucketGitProvider) SetCommitStatus(repo *GitRepository, status CommitStatus) error {
+	client := g.getApiClient()
+
+	var state string
+	switch status.State {
+	case CommitStatusStatePending:
+		state = "INPROGRESS"
+	case CommitStatusStateSuccess:
+		state = "SUCCESSFUL"
+	default:
+		state = "FAILED"
+	}
+
+	// Bitbucket requires a link so statuses without one link to the commit
+	targetUrl := g.GetUrlFromContext(&GetRepositoryContext{
+		Url:    repo.Url,
+		Branch: &repo.Sha,
+		Sha:    &repo.Sha,
+	})
+	if status.TargetUrl != nil {
+		targetUrl = *status.TargetUrl
+	}
+
+	// Bitbucket identifies statuses by key and shows the name
+	_, err := client.Repositories.Commits.CreateCommitStatus(&bitbucket.CommitsOptions{
+		Owner:    repo.Owner,
+		RepoSlug: repo.Id,
+		Revision: repo.Sha,
+	}, &bitbucket.CommitStatusOptions{
+		Key:         status.Context,
+		Name:        status.Context,
+		State:       state,
+		Description: status.Description,
+		Url:         targetUrl,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *BitbucketGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	client := g.getApiClient()
 
@@ -606,8 +659,13 @@ func (g *BitbucketGitProvider) GetCommitsRange(repo *GitRepository, initialSha s
 	return commits.Size, nil
 }
 
+func (g *BitbucketGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyHmacSignature(payload, secret, request.Header.Get("X-Hub-Signature"))
+}
+
 func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Event-Key") != "repo:push" {
+	eventKey := request.Header.Get("X-Event-Key")
+	if eventKey != "repo:push" && eventKey != "pullrequest:created" && eventKey != "pullrequest:updated" {
 		return nil, errors.New("invalid event key")
 	}
 	hook, err := bitbucketWebhook.New()
@@ -615,24 +673,44 @@ func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventD
 		return nil, err
 	}
 
-	event, err := hook.Parse(request, bitbucketWebhook.RepoPushEvent)
+	event, err := hook.Parse(request, bitbucketWebhook.RepoPushEvent, bitbucketWebhook.PullRequestCreatedEvent, bitbucketWebhook.PullRequestUpdatedEvent)
 	if err != nil {
 		return nil, errors.New("could not parse event")
 	}
 
+	switch pullRequestEvent := event.(type) {
+	case bitbucketWebhook.PullRequestCreatedPayload:
+		return g.parsePullRequestEventData(pullRequestEvent.PullRequest, pullRequestEvent.Repository), nil
+	case bitbucketWebhook.PullRequestUpdatedPayload:
+		return g.parsePullRequestEventData(pullRequestEvent.PullRequest, pullRequestEvent.Repository), nil
+	}
+
 	pushEvent, ok := event.(bitbucketWebhook.RepoPushPayload)
 	if !ok {
 		return nil, errors.New("could not parse push event")
 	}
 	owner := pushEvent.Repository.Owner.DisplayName
 
+	// Branch and tag deletions have no new target
+	if len(pushEvent.Push.Changes) == 0 || pushEvent.Push.Changes[0].New.Name == "" {
+		return nil, nil
+	}
+
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Repository.Links.HTML.Href) + ".git",
 		Branch: pushEvent.Push.Changes[0].New.Name,
 		Sha:    pushEvent.Push.Changes[0].New.Target.Hash,
 		Owner:  owner,
 	}
 
+	if pushEvent.Push.Changes[0].New.Type == "tag" {
+		tag := pushEvent.Push.Changes[0].New.Name
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		return gitEventData, nil
+	}
+
 	for _, change := range pushEvent.Push.Changes {
 		for _, commit := range change.Commits {
 			gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Hash)
@@ -642,6 +720,23 @@ func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventD
 	return gitEventData, nil
 }
 
+func (g *BitbucketGitProvider) parsePullRequestEventData(pr bitbucketWebhook.PullRequest, repo bitbucketWebhook.Repository) *GitEventData {
+	prNumber := uint32(pr.ID)
+	targetBranch := pr.Destination.Branch.Name
+	headRepoUrl := util.CleanUpRepositoryUrl(pr.Source.Repository.Links.HTML.Href) + ".git"
+
+	return &GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          util.CleanUpRepositoryUrl(repo.Links.HTML.Href) + ".git",
+		Branch:       pr.Source.Branch.Name,
+		Sha:          pr.Source.Commit.Hash,
+		Owner:        repo.Owner.DisplayName,
+		PrNumber:     &prNumber,
+		TargetBranch: &targetBranch,
+		HeadRepoUrl:  &headRepoUrl,
+	}
+}
+
 func (b *BitbucketGitProvider) FormatError(err error) error {
 	re := regexp.MustCompile(`(\d{3})\s(.+)`)
 	match := re.FindStringSubmatch(err.Error())
@@ -529,7 +529,7 @@ func (b *BitbucketServerGitProvider) FormatError(statusCode int, message string)
 	return fmt.Errorf("status code: %d err: Request failed with %s", statusCode, message)
 }
 
-func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client, err := g.getApiClient()
 	if err != nil {
 		return "", err
@@ -537,8 +537,11 @@ func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository
 
 	webhook := map[string]interface{}{
 		"url":    endpointUrl,
-		"events": []string{"repo:refs_changed"},
+		"events": []string{"repo:refs_changed", "pr:opened", "pr:from_ref_updated"},
 		"active": true,
+		"configuration": map[string]string{
+			"secret": secret,
+		},
 	}
 
 	contentType := []string{"application/json"}
@@ -618,8 +621,13 @@ func (g *BitbucketServerGitProvider) GetCommitsRange(repo *GitRepository, initia
 	return int(size), nil
 }
 
+func (g *BitbucketServerGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyHmacSignature(payload, secret, request.Header.Get("X-Hub-Signature"))
+}
+
 func (g *BitbucketServerGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Event-Key") != "repo:refs_changed" {
+	eventKey := request.Header.Get("X-Event-Key")
+	if eventKey != "repo:refs_changed" && eventKey != "pr:opened" && eventKey != "pr:from_ref_updated" {
 		return nil, errors.New("invalid event key")
 	}
 	hook, err := bitbucketWebhook.New()
@@ -627,26 +635,69 @@ func (g *BitbucketServerGitProvider) ParseEventData(request *http.Request) (*Git
 		return nil, err
 	}
 
-	event, err := hook.Parse(request, bitbucketWebhook.RepositoryReferenceChangedEvent)
+	event, err := hook.Parse(request, bitbucketWebhook.RepositoryReferenceChangedEvent, bitbucketWebhook.PullRequestOpenedEvent, bitbucketWebhook.PullRequestFromReferenceUpdatedEvent)
 	if err != nil {
 		return nil, errors.New("could not parse event")
 	}
 
+	switch pullRequestEvent := event.(type) {
+	case bitbucketWebhook.PullRequestOpenedPayload:
+		return g.parsePullRequestEventData(pullRequestEvent.PullRequest), nil
+	case bitbucketWebhook.PullRequestFromReferenceUpdatedPayload:
+		return g.parsePullRequestEventData(pullRequestEvent.PullRequest), nil
+	}
+
 	pushEvent, ok := event.(bitbucketWebhook.RepositoryReferenceChangedPayload)
 	if !ok {
 		return nil, errors.New("could not parse push event")
 	}
+
+	// Branch and tag deletions have no new target
+	if len(pushEvent.Changes) == 0 || pushEvent.Changes[0].Type == "DELETE" {
+		return nil, nil
+	}
+
 	owner := pushEvent.Actor.DisplayName
 	gitEventData := &GitEventData{
-		Url:    fmt.Sprintf("%s/scm/%s/%s.git", strings.TrimSuffix(g.baseApiUrl, "/rest"), strings.ToLower(pushEvent.Repository.Project.Key), pushEvent.Repository.Slug),
+		Type:   GitEventTypePush,
+		Url:    g.getRepositoryCloneUrl(pushEvent.Repository),
 		Branch: strings.TrimPrefix(pushEvent.Changes[0].ReferenceID, "refs/heads/"),
 		Sha:    pushEvent.Changes[0].ToHash,
 		Owner:  owner,
 	}
 
+	if strings.HasPrefix(pushEvent.Changes[0].ReferenceID, "refs/tags/") {
+		tag := strings.TrimPrefix(pushEvent.Changes[0].ReferenceID, "refs/tags/")
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		gitEventData.Branch = tag
+		return gitEventData, nil
+	}
+
 	for _, change := range pushEvent.Changes {
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, change.ToHash)
 	}
 
 	return gitEventData, nil
 }
+
+func (g *BitbucketServerGitProvider) parsePullRequestEventData(pr bitbucketWebhook.PullRequest) *GitEventData {
+	prNumber := uint32(pr.ID)
+	targetBranch := pr.ToRef.DisplayID
+	headRepoUrl := g.getRepositoryCloneUrl(pr.FromRef.Repository)
+
+	return &GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          g.getRepositoryCloneUrl(pr.ToRef.Repository),
+		Branch:       pr.FromRef.DisplayID,
+		Sha:          pr.FromRef.LatestCommit,
+		Owner:        pr.ToRef.Repository.Slug,
+		PrNumber:     &prNumber,
+		TargetBranch: &targetBranch,
+		HeadRepoUrl:  &headRepoUrl,
+	}
+}
+
+func (g *BitbucketServerGitProvider) getRepositoryCloneUrl(repo bitbucketWebhook.Repository) string {
+	return fmt.Sprintf("%s/scm/%s/%s.git", strings.TrimSuffix(g.baseApiUrl, "/rest"), strings.ToLower(repo.Project.Key), repo.Slug)
+}
@@ -0,0 +1,488 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+var scpLikeUrlRegex = regexp.MustCompile(`^(?:[\w.-]+@)?([\w.-]+):(.*)$`)
+
+var ErrGenericListingNotSupported = errors.New("listing is not supported by generic git servers, use the repository URL instead")
+
+var ErrGenericSshNotSupported = errors.New("SSH URLs are not supported by generic git servers, use the HTTPS URL instead")
+
+// Depths of the shallow fetches used to search the history, 0 fetches the full history
+var genericHistoryDepths = []int{100, 1000, 0}
+
+// GenericGitProvider talks to any git server over the git protocol (HTTP(S), git or local paths).
+// Since there is no API, repositories are discovered with ls-remote and history is read from
+// shallow in-memory fetches of the needed branches. SSH is not supported since the provider config
+// only holds a token, so SSH URLs are neither handled nor accepted as the base URL.
+type GenericGitProvider struct {
+	*AbstractGitProvider
+	username   string
+	token      string
+	baseApiUrl string
+}
+
+func NewGenericGitProvider(username string, token string, baseApiUrl string) *GenericGitProvider {
+	gitProvider := &GenericGitProvider{
+		AbstractGitProvider: &AbstractGitProvider{},
+		username:            username,
+		token:               token,
+		baseApiUrl:          baseApiUrl,
+	}
+	gitProvider.AbstractGitProvider.GitProvider = gitProvider
+
+	return gitProvider
+}
+
+func (g *GenericGitProvider) CanHandle(repoUrl string) (bool, error) {
+	if g.baseApiUrl == "" {
+		return false, nil
+	}
+
+	if isSshUrl(repoUrl) {
+		return false, ErrGenericSshNotSupported
+	}
+
+	source, _, err := parseGenericGitUrl(repoUrl)
+	if err != nil {
+		return false, err
+	}
+
+	baseSource, _, err := parseGenericGitUrl(g.baseApiUrl)
+	if err != nil {
+		return false, err
+	}
+
+	return source == baseSource, nil
+}
+
+func (g *GenericGitProvider) GetNamespaces(options ListOptions) ([]*GitNamespace, error) {
+	return nil, ErrGenericListingNotSupported
+}
+
+func (g *GenericGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
+	return nil, ErrGenericListingNotSupported
+}
+
+// There is no user API, so the config is only validated when saving it
+func (g *GenericGitProvider) GetUser() (*GitUser, error) {
+	if isSshUrl(g.baseApiUrl) {
+		return nil, ErrGenericSshNotSupported
+	}
+
+	username := g.username
+	if username == "" {
+		username = "git"
+	}
+
+	return &GitUser{
+		Id:       username,
+		Username: username,
+		Name:     username,
+	}, nil
+}
+
+// The repository ID of generic repositories is their clone URL
+func (g *GenericGitProvider) GetRepoBranches(repositoryId string, namespaceId string, options ListOptions) ([]*GitBranch, error) {
+	refs, err := g.listRemoteRefs(repositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []*GitBranch
+	for _, ref := range refs {
+		if !ref.Name().IsBranch() || ref.Type() != plumbing.HashReference {
+			continue
+		}
+		branches = append(branches, &GitBranch{
+			Name: ref.Name().Short(),
+			Sha:  ref.Hash().String(),
+		})
+	}
+
+	return branches, nil
+}
+
+func (g *GenericGitProvider) GetRepoPRs(repositoryId string, namespaceId string, options ListOptions) ([]*GitPullRequest, error) {
+	return []*GitPullRequest{}, nil
+}
+
+func (g *GenericGitProvider) GetPrContext(staticContext *StaticGitContext) (*StaticGitContext, error) {
+	return nil, errors.New("pull requests are not supported by generic git servers")
+}
+
+func (g *GenericGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*string, error) {
+	refs, err := g.listRemoteRefs(staticContext.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var head *plumbing.Reference
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD {
+			head = ref
+			break
+		}
+	}
+	if head == nil {
+		return nil, errors.New("repository has no HEAD")
+	}
+
+	if head.Type() == plumbing.SymbolicReference {
+		branch := head.Target().Short()
+		return &branch, nil
+	}
+
+	// Servers that do not advertise the HEAD symref only send its hash
+	for _, ref := range refs {
+		if ref.Name().IsBranch() && ref.Hash() == head.Hash() {
+			branch := ref.Name().Short()
+			return &branch, nil
+		}
+	}
+
+	return nil, errors.New("could not determine default branch")
+}
+
+func (g *GenericGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (string, error) {
+	if staticContext.Sha != nil && *staticContext.Sha != "" {
+		return *staticContext.Sha, nil
+	}
+
+	branch := staticContext.Branch
+	if branch == nil || *branch == "" {
+		defaultBranch, err := g.GetDefaultBranch(staticContext)
+		if err != nil {
+			return "", err
+		}
+		branch = defaultBranch
+	}
+
+	refs, err := g.listRemoteRefs(staticContext.Url)
+	if err != nil {
+		return "", err
+	}
+
+	refName := plumbing.NewBranchReferenceName(*branch)
+	for _, ref := range refs {
+		if ref.Name() == refName && ref.Type() == plumbing.HashReference {
+			return ref.Hash().String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("branch %s not found", *branch)
+}
+
+func (g *GenericGitProvider) GetBranchByCommit(staticContext *StaticGitContext) (string, error) {
+	if staticContext.Sha == nil {
+		return "", errors.New("commit SHA is required")
+	}
+
+	branches, err := g.GetRepoBranches(staticContext.Url, "", ListOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, branch := range branches {
+		if branch.Sha == *staticContext.Sha {
+			return branch.Name, nil
+		}
+	}
+
+	commitHash := plumbing.NewHash(*staticContext.Sha)
+	branchName := ""
+	err = g.searchHistory(staticContext.Url, branches, func(repo *git.Repository) (bool, error) {
+		var shallowErr error
+		for _, branch := range branches {
+			found := false
+			err := walkCommits(repo, plumbing.NewHash(branch.Sha), func(c *object.Commit) bool {
+				found = c.Hash == commitHash
+				return !found
+			})
+			if found {
+				branchName = branch.Name
+				return true, nil
+			}
+			if errors.Is(err, plumbing.ErrObjectNotFound) {
+				shallowErr = err
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+		}
+
+		return false, shallowErr
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if branchName == "" {
+		return "", fmt.Errorf("commit %s not found on any branch", *staticContext.Sha)
+	}
+
+	return branchName, nil
+}
+
+func (g *GenericGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
+	branches, err := g.GetRepoBranches(repo.Url, "", ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	// The current commit is usually a branch head so only the branches pointing at it are fetched
+	var currentBranches []*GitBranch
+	for _, branch := range branches {
+		if branch.Sha == currentSha {
+			currentBranches = append(currentBranches, branch)
+		}
+	}
+	if len(currentBranches) > 0 {
+		branches = currentBranches
+	}
+
+	initialHash := plumbing.NewHash(initialSha)
+	count := -1
+	err = g.searchHistory(repo.Url, branches, func(gitRepo *git.Repository) (bool, error) {
+		n := 0
+		err := walkCommits(gitRepo, plumbing.NewHash(currentSha), func(c *object.Commit) bool {
+			if c.Hash == initialHash {
+				count = n
+				return false
+			}
+			n++
+			return true
+		})
+		return count >= 0, err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if count < 0 {
+		return 0, fmt.Errorf("commit %s is not an ancestor of %s", initialSha, currentSha)
+	}
+
+	return count, nil
+}
+
+func (g *GenericGitProvider) GetUrlFromContext(repoContext *GetRepositoryContext) string {
+	return repoContext.Url
+}
+
+// Generic repositories are identified by their clone URL. The owner is everything between
+// the host and the repository name, which may be empty or span several segments.
+func (g *GenericGitProvider) ParseStaticGitContext(repoUrl string) (*StaticGitContext, error) {
+	source, repoPath, err := parseGenericGitUrl(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if repoPath == "" {
+		return nil, errors.New("cannot parse git URL: " + repoUrl)
+	}
+
+	owner := path.Dir(repoPath)
+	if owner == "." {
+		owner = ""
+	}
+
+	cloneUrl := strings.TrimSuffix(repoUrl, "/")
+
+	return &StaticGitContext{
+		Id:     cloneUrl,
+		Url:    cloneUrl,
+		Name:   strings.TrimSuffix(path.Base(repoPath), ".git"),
+		Owner:  owner,
+		Source: source,
+	}, nil
+}
+
+func (g *GenericGitProvider) FormatError(err error) error {
+	return fmt.Errorf("git server error: %w", err)
+}
+
+// The token is only sent over HTTP(S), SSH would require a private key that is not part of the provider config
+func (g *GenericGitProvider) getAuth(repoUrl string) (transport.AuthMethod, error) {
+	if isSshUrl(repoUrl) {
+		return nil, ErrGenericSshNotSupported
+	}
+
+	if g.token == "" || !strings.HasPrefix(repoUrl, "http") {
+		return nil, nil
+	}
+
+	username := g.username
+	if username == "" {
+		username = "git"
+	}
+
+	return &http.BasicAuth{
+		Username: username,
+		Password: g.token,
+	}, nil
+}
+
+func (g *GenericGitProvider) listRemoteRefs(repoUrl string) ([]*plumbing.Reference, error) {
+	auth, err := g.getAuth(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{repoUrl},
+	})
+
+	refs, err := remote.List(&git.ListOptions{
+		Auth: auth,
+	})
+	if err != nil {
+		return nil, g.FormatError(err)
+	}
+
+	return refs, nil
+}
+
+// searchHistory fetches the branches into a bare in-memory repository, deepening the fetch until search
+// finds what it looks for. search returns plumbing.ErrObjectNotFound when it reaches the end of a shallow history.
+func (g *GenericGitProvider) searchHistory(repoUrl string, branches []*GitBranch, search func(repo *git.Repository) (bool, error)) error {
+	if len(branches) == 0 {
+		return nil
+	}
+
+	for _, depth := range genericHistoryDepths {
+		repo, err := g.fetchBranches(repoUrl, branches, depth)
+		if err != nil {
+			return err
+		}
+
+		found, err := search(repo)
+		if found {
+			return nil
+		}
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			// Missing objects in the full history mean that the commit is not on the fetched branches
+			if depth == 0 {
+				return nil
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// The whole history has been searched
+		return nil
+	}
+
+	return nil
+}
+
+// A depth of 0 fetches the full history of the branches
+func (g *GenericGitProvider) fetchBranches(repoUrl string, branches []*GitBranch, depth int) (*git.Repository, error) {
+	auth, err := g.getAuth(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := repo.CreateRemote(&config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{repoUrl},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refSpecs := make([]config.RefSpec, 0, len(branches))
+	for _, branch := range branches {
+		refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+refs/heads/%s:refs/remotes/origin/%s", branch.Name, branch.Name)))
+	}
+
+	err = remote.Fetch(&git.FetchOptions{
+		RefSpecs: refSpecs,
+		Depth:    depth,
+		Auth:     auth,
+		Tags:     git.NoTags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, g.FormatError(err)
+	}
+
+	return repo, nil
+}
+
+func isSshUrl(repoUrl string) bool {
+	return strings.HasPrefix(repoUrl, "ssh://") || (!strings.Contains(repoUrl, "://") && scpLikeUrlRegex.MatchString(repoUrl))
+}
+
+// Returns the host and the repository path of HTTP(S), SSH, git and file URLs as well as scp-like SSH addresses
+func parseGenericGitUrl(repoUrl string) (string, string, error) {
+	if !strings.Contains(repoUrl, "://") {
+		matches := scpLikeUrlRegex.FindStringSubmatch(repoUrl)
+		if matches == nil {
+			return "", "", errors.New("cannot parse git URL: " + repoUrl)
+		}
+		return matches[1], strings.Trim(matches[2], "/"), nil
+	}
+
+	u, err := url.Parse(repoUrl)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "ssh", "git", "file":
+	default:
+		return "", "", errors.New("cannot parse git URL: " + repoUrl)
+	}
+
+	return u.Hostname(), strings.Trim(u.Path, "/"), nil
+}
+
+// walkCommits visits the history of from until visit returns false
+func walkCommits(repo *git.Repository, from plumbing.Hash, visit func(c *object.Commit) bool) error {
+	commits, err := repo.Log(&git.LogOptions{From: from})
+	if err != nil {
+		return err
+	}
+	defer commits.Close()
+
+	for {
+		c, err := commits.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !visit(c) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenericGitProviderTestSuite struct {
+	gitProvider *GenericGitProvider
+	repoUrl     string
+	mainShas    []string
+	featureSha  string
+	suite.Suite
+}
+
+func NewGenericGitProviderTestSuite() *GenericGitProviderTestSuite {
+	return &GenericGitProviderTestSuite{
+		gitProvider: NewGenericGitProvider("", "", "https://git.example.com"),
+	}
+}
+
+// Creates a bare repository with three commits on main and one commit on feature branched off the second one
+func (g *GenericGitProviderTestSuite) SetupSuite() {
+	require := g.Require()
+
+	tmpDir := g.T().TempDir()
+	barePath := filepath.Join(tmpDir, "repo.git")
+	workPath := filepath.Join(tmpDir, "work")
+
+	_, err := git.PlainInitWithOptions(barePath, &git.PlainInitOptions{
+		Bare:        true,
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.Main},
+	})
+	require.Nil(err)
+
+	repo, err := git.PlainInitWithOptions(workPath, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.Main},
+	})
+	require.Nil(err)
+
+	worktree, err := repo.Worktree()
+	require.Nil(err)
+
+	commit := func(message string) string {
+		err := os.WriteFile(filepath.Join(workPath, "file.txt"), []byte(message), 0644)
+		require.Nil(err)
+		_, err = worktree.Add("file.txt")
+		require.Nil(err)
+		hash, err := worktree.Commit(message, &git.CommitOptions{
+			Author: &object.Signature{Name: "daytona", Email: "daytona@daytona.io", When: time.Now()},
+		})
+		require.Nil(err)
+		return hash.String()
+	}
+
+	g.mainShas = append(g.mainShas, commit("first"), commit("second"))
+
+	err = worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("feature"), Create: true})
+	require.Nil(err)
+	g.featureSha = commit("feature")
+
+	err = worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.Main})
+	require.Nil(err)
+	g.mainShas = append(g.mainShas, commit("third"))
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{barePath}})
+	require.Nil(err)
+	err = repo.Push(&git.PushOptions{RefSpecs: []config.RefSpec{"refs/heads/*:refs/heads/*"}})
+	require.Nil(err)
+
+	g.repoUrl = "file://" + barePath
+}
+
+func (g *GenericGitProviderTestSuite) TestCanHandle() {
+	require := g.Require()
+
+	for _, repoUrl := range []string{
+		"https://git.example.com/team/project.git",
+		"git://git.example.com/team/project.git",
+	} {
+		canHandle, _ := g.gitProvider.CanHandle(repoUrl)
+		require.True(canHandle, repoUrl)
+	}
+}
+
+func (g *GenericGitProviderTestSuite) TestCanHandle_False() {
+	require := g.Require()
+
+	canHandle, _ := g.gitProvider.CanHandle("https://github.com/daytonaio/daytona")
+	require.False(canHandle)
+
+	canHandle, _ = NewGenericGitProvider("", "", "").CanHandle("https://git.example.com/team/project.git")
+	require.False(canHandle)
+
+	// SSH URLs can not be served with the token of the config
+	for _, repoUrl := range []string{
+		"ssh://git@git.example.com/srv/git/project.git",
+		"git@git.example.com:project.git",
+	} {
+		canHandle, err := g.gitProvider.CanHandle(repoUrl)
+		require.False(canHandle, repoUrl)
+		require.ErrorIs(err, ErrGenericSshNotSupported)
+	}
+}
+
+func (g *GenericGitProviderTestSuite) TestParseStaticGitContext_Https() {
+	require := g.Require()
+
+	staticContext, err := g.gitProvider.ParseStaticGitContext("https://git.example.com/cgit/team/project.git")
+
+	require.Nil(err)
+	require.Equal(&StaticGitContext{
+		Id:     "https://git.example.com/cgit/team/project.git",
+		Url:    "https://git.example.com/cgit/team/project.git",
+		Name:   "project",
+		Owner:  "cgit/team",
+		Source: "git.example.com",
+	}, staticContext)
+}
+
+func (g *GenericGitProviderTestSuite) TestParseStaticGitContext_Ssh() {
+	require := g.Require()
+
+	staticContext, err := g.gitProvider.ParseStaticGitContext("git@git.example.com:project.git")
+
+	require.Nil(err)
+	require.Equal(&StaticGitContext{
+		Id:     "git@git.example.com:project.git",
+		Url:    "git@git.example.com:project.git",
+		Name:   "project",
+		Owner:  "",
+		Source: "git.example.com",
+	}, staticContext)
+}
+
+func (g *GenericGitProviderTestSuite) TestGetRepoBranches() {
+	require := g.Require()
+
+	branches, err := g.gitProvider.GetRepoBranches(g.repoUrl, "", ListOptions{})
+
+	require.Nil(err)
+	require.ElementsMatch([]*GitBranch{
+		{Name: "main", Sha: g.mainShas[2]},
+		{Name: "feature", Sha: g.featureSha},
+	}, branches)
+}
+
+func (g *GenericGitProviderTestSuite) TestGetDefaultBranch() {
+	require := g.Require()
+
+	branch, err := g.gitProvider.GetDefaultBranch(&StaticGitContext{Url: g.repoUrl})
+
+	require.Nil(err)
+	require.Equal("main", *branch)
+}
+
+func (g *GenericGitProviderTestSuite) TestGetLastCommitSha() {
+	require := g.Require()
+
+	sha, err := g.gitProvider.GetLastCommitSha(&StaticGitContext{Url: g.repoUrl, Branch: util.Pointer("feature")})
+	require.Nil(err)
+	require.Equal(g.featureSha, sha)
+
+	sha, err = g.gitProvider.GetLastCommitSha(&StaticGitContext{Url: g.repoUrl})
+	require.Nil(err)
+	require.Equal(g.mainShas[2], sha)
+
+	_, err = g.gitProvider.GetLastCommitSha(&StaticGitContext{Url: g.repoUrl, Branch: util.Pointer("missing")})
+	require.NotNil(err)
+}
+
+func (g *GenericGitProviderTestSuite) TestGetBranchByCommit() {
+	require := g.Require()
+
+	branch, err := g.gitProvider.GetBranchByCommit(&StaticGitContext{Url: g.repoUrl, Sha: &g.featureSha})
+	require.Nil(err)
+	require.Equal("feature", branch)
+
+	branch, err = g.gitProvider.GetBranchByCommit(&StaticGitContext{Url: g.repoUrl, Sha: &g.mainShas[0]})
+	require.Nil(err)
+	require.Contains([]string{"main", "feature"}, branch)
+}
+
+func (g *GenericGitProviderTestSuite) TestGetCommitsRange() {
+	require := g.Require()
+
+	repo := &GitRepository{Url: g.repoUrl}
+
+	commitsRange, err := g.gitProvider.GetCommitsRange(repo, g.mainShas[0], g.mainShas[2])
+	require.Nil(err)
+	require.Equal(2, commitsRange)
+
+	_, err = g.gitProvider.GetCommitsRange(repo, g.featureSha, g.mainShas[2])
+	require.NotNil(err)
+}
+
+func (g *GenericGitProviderTestSuite) TestSearchHistory_Deepens() {
+	require := g.Require()
+
+	defaultDepths := genericHistoryDepths
+	genericHistoryDepths = []int{1, 0}
+	defer func() { genericHistoryDepths = defaultDepths }()
+
+	commitsRange, err := g.gitProvider.GetCommitsRange(&GitRepository{Url: g.repoUrl}, g.mainShas[0], g.mainShas[2])
+	require.Nil(err)
+	require.Equal(2, commitsRange)
+
+	branch, err := g.gitProvider.GetBranchByCommit(&StaticGitContext{Url: g.repoUrl, Sha: &g.mainShas[0]})
+	require.Nil(err)
+	require.Contains([]string{"main", "feature"}, branch)
+}
+
+func (g *GenericGitProviderTestSuite) TestSshUrlsAreRejected() {
+	require := g.Require()
+
+	_, err := g.gitProvider.GetRepoBranches("git@git.example.com:project.git", "", ListOptions{})
+	require.ErrorIs(err, ErrGenericSshNotSupported)
+
+	_, err = g.gitProvider.GetRepoBranches("ssh://git@git.example.com/project.git", "", ListOptions{})
+	require.ErrorIs(err, ErrGenericSshNotSupported)
+
+	// Configs with an SSH base URL are rejected when saved
+	_, err = NewGenericGitProvider("", "token", "git@git.example.com:").GetUser()
+	require.ErrorIs(err, ErrGenericSshNotSupported)
+}
+
+func TestGenericGitProvider(t *testing.T) {
+	suite.Run(t, NewGenericGitProviderTestSuite())
+}
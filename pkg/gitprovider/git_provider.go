@@ -14,6 +14,8 @@ import (
 
 const personalNamespaceId = "<PERSONAL>"
 
+var ErrCommitStatusNotSupported = errors.New("commit statuses not yet implemented for this git provider")
+
 type StaticGitContext struct {
 	Id       string  `json:"id" validate:"required"`
 	Url      string  `json:"url" validate:"required"`
@@ -24,6 +26,8 @@ type StaticGitContext struct {
 	PrNumber *uint32 `json:"prNumber,omitempty" validate:"optional"`
 	Source   string  `json:"source" validate:"required"`
 	Path     *string `json:"path,omitempty" validate:"optional"`
+	// Branch targeted by the pull request, set by GetPrContext if the provider exposes it
+	PrBaseBranch *string `json:"prBaseBranch,omitempty" validate:"optional"`
 } // @name StaticGitContext
 
 type GetRepositoryContext struct {
@@ -48,6 +52,8 @@ type GitProvider interface {
 	GetNamespaces(options ListOptions) ([]*GitNamespace, error)
 	GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error)
 	GetUser() (*GitUser, error)
+	// Returns nil if the provider does not expose any details of the token
+	GetTokenInfo() (*TokenInfo, error)
 	GetRepoBranches(repositoryId string, namespaceId string, options ListOptions) ([]*GitBranch, error)
 	GetRepoPRs(repositoryId string, namespaceId string, options ListOptions) ([]*GitPullRequest, error)
 
@@ -60,11 +66,19 @@ type GitProvider interface {
 	ParseStaticGitContext(repoUrl string) (*StaticGitContext, error)
 	GetDefaultBranch(staticContext *StaticGitContext) (*string, error)
 
-	RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error)
+	RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error)
 	GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error)
 	UnregisterPrebuildWebhook(repo *GitRepository, id string) error
+	// Returns the ID of the first pull request comment containing the marker or nil if there is none
+	GetPrComment(repo *GitRepository, prNumber uint32, marker string) (*string, error)
+	CreatePrComment(repo *GitRepository, prNumber uint32, body string) (string, error)
+	UpdatePrComment(repo *GitRepository, prNumber uint32, commentId string, body string) error
+	// Reports the status for the commit of the repository
+	SetCommitStatus(repo *GitRepository, status CommitStatus) error
 	GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error)
 	ParseEventData(request *http.Request) (*GitEventData, error)
+	// Returns ErrInvalidWebhookSignature if the event was not signed with the webhook secret
+	VerifyEventSignature(request *http.Request, payload []byte, secret string) error
 }
 
 type AbstractGitProvider struct {
@@ -77,6 +91,9 @@ func (a *AbstractGitProvider) GetRepositoryContext(repoContext GetRepositoryCont
 		return nil, err
 	}
 
+	// GetPrContext replaces the repository with the head repository of the pull request
+	baseContext := *staticContext
+
 	if repoContext.PrNumber != nil {
 		staticContext.PrNumber = repoContext.PrNumber
 		staticContext, err = a.GetPrContext(staticContext)
@@ -92,6 +109,8 @@ func (a *AbstractGitProvider) GetRepositoryContext(repoContext GetRepositoryCont
 		}
 	}
 
+	pullRequest := getPullRequestRefs(&baseContext, staticContext)
+
 	if repoContext.Branch != nil {
 		staticContext.Branch = repoContext.Branch
 	}
@@ -120,19 +139,40 @@ func (a *AbstractGitProvider) GetRepositoryContext(repoContext GetRepositoryCont
 	}
 
 	return &GitRepository{
-		Id:       staticContext.Id,
-		Name:     staticContext.Name,
-		Url:      staticContext.Url,
-		Branch:   *staticContext.Branch,
-		Sha:      *staticContext.Sha,
-		Owner:    staticContext.Owner,
-		PrNumber: staticContext.PrNumber,
-		Source:   staticContext.Source,
-		Path:     staticContext.Path,
-		Target:   target,
+		Id:          staticContext.Id,
+		Name:        staticContext.Name,
+		Url:         staticContext.Url,
+		Branch:      *staticContext.Branch,
+		Sha:         *staticContext.Sha,
+		Owner:       staticContext.Owner,
+		PrNumber:    staticContext.PrNumber,
+		Source:      staticContext.Source,
+		Path:        staticContext.Path,
+		Target:      target,
+		PullRequest: pullRequest,
 	}, nil
 }
 
+// Returns nil if the context is not of a pull request or the provider did not resolve its base branch
+func getPullRequestRefs(baseContext *StaticGitContext, prContext *StaticGitContext) *PullRequestRefs {
+	if prContext.PrNumber == nil || prContext.PrBaseBranch == nil || prContext.Branch == nil {
+		return nil
+	}
+
+	pullRequest := &PullRequestRefs{
+		BaseBranch:    *prContext.PrBaseBranch,
+		HeadBranch:    *prContext.Branch,
+		BaseRepoId:    baseContext.Id,
+		BaseRepoOwner: baseContext.Owner,
+	}
+
+	if prContext.Url != baseContext.Url {
+		pullRequest.ForkOrigin = &baseContext.Url
+	}
+
+	return pullRequest
+}
+
 func (a *AbstractGitProvider) ParseStaticGitContext(repoUrl string) (*StaticGitContext, error) {
 	isHttps := true
 	if strings.HasPrefix(repoUrl, "http://") {
@@ -174,11 +214,15 @@ func (a *AbstractGitProvider) ParseStaticGitContext(repoUrl string) (*StaticGitC
 	return repo, nil
 }
 
+func (g *AbstractGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	return nil, nil
+}
+
 func (g *AbstractGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error) {
 	return nil, errors.New("prebuilds not yet implemented for this git provider")
 }
 
-func (g *AbstractGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *AbstractGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	return "", errors.New("prebuilds not yet implemented for this git provider")
 }
 
@@ -186,6 +230,22 @@ func (g *AbstractGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id
 	return errors.New("prebuilds not yet implemented for this git provider")
 }
 
+func (g *AbstractGitProvider) GetPrComment(repo *GitRepository, prNumber uint32, marker string) (*string, error) {
+	return nil, errors.New("pull request comments not yet implemented for this git provider")
+}
+
+func (g *AbstractGitProvider) CreatePrComment(repo *GitRepository, prNumber uint32, body string) (string, error) {
+	return "", errors.New("pull request comments not yet implemented for this git provider")
+}
+
+func (g *AbstractGitProvider) UpdatePrComment(repo *GitRepository, prNumber uint32, commentId string, body string) error {
+	return errors.New("pull request comments not yet implemented for this git provider")
+}
+
+func (g *AbstractGitProvider) SetCommitStatus(repo *GitRepository, status CommitStatus) error {
+	return ErrCommitStatusNotSupported
+}
+
 func (g *AbstractGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	return 0, errors.New("prebuilds not yet implemented for this git provider")
 }
@@ -194,6 +254,10 @@ func (g *AbstractGitProvider) ParseEventData(request *http.Request) (*GitEventDa
 	return nil, errors.New("prebuilds not yet implemented for this git provider")
 }
 
+func (g *AbstractGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return errors.New("prebuilds not yet implemented for this git provider")
+}
+
 func (a *AbstractGitProvider) parseSshGitUrl(gitURL string) (*StaticGitContext, error) {
 	re := regexp.MustCompile(`git@([\w\.]+):(.+?)/(.+?)(?:\.git)?$`)
 	matches := re.FindStringSubmatch(gitURL)
@@ -84,6 +84,53 @@ func (a *AbstractGitProviderTestSuite) TestParseGitContext_HTTPWithPath() {
 	require.Equal(httpContext, contextWithPath)
 }
 
+func (a *AbstractGitProviderTestSuite) TestGetPullRequestRefs() {
+	require := a.Require()
+
+	baseContext := &StaticGitContext{
+		Id:    "daytona",
+		Owner: "daytonaio",
+		Url:   "https://github.com/daytonaio/daytona.git",
+	}
+	prContext := &StaticGitContext{
+		Id:           "daytona",
+		Owner:        "contributor",
+		Url:          "https://github.com/contributor/daytona.git",
+		Branch:       &[]string{"fix"}[0],
+		PrNumber:     &[]uint32{1}[0],
+		PrBaseBranch: &[]string{"main"}[0],
+	}
+
+	require.Equal(&PullRequestRefs{
+		BaseBranch:    "main",
+		HeadBranch:    "fix",
+		BaseRepoId:    "daytona",
+		BaseRepoOwner: "daytonaio",
+		ForkOrigin:    &baseContext.Url,
+	}, getPullRequestRefs(baseContext, prContext))
+
+	prContext.Url = baseContext.Url
+	require.Nil(getPullRequestRefs(baseContext, prContext).ForkOrigin)
+
+	prContext.PrBaseBranch = nil
+	require.Nil(getPullRequestRefs(baseContext, prContext))
+}
+
+func (a *AbstractGitProviderTestSuite) TestIsInSparseCheckout() {
+	require := a.Require()
+
+	sparsePaths := []string{"services/api/", "./libs"}
+
+	require.True(IsInSparseCheckout(nil, "services/web/main.go"))
+	require.True(IsInSparseCheckout(sparsePaths, "README.md"))
+	require.True(IsInSparseCheckout(sparsePaths, "services/api/main.go"))
+	require.True(IsInSparseCheckout(sparsePaths, "libs/util/util.go"))
+	require.False(IsInSparseCheckout(sparsePaths, "services/web/main.go"))
+	require.False(IsInSparseCheckout(sparsePaths, "services/api-gateway/main.go"))
+
+	require.Equal([]string{"services/api", "libs"}, NormalizeSparsePaths(append(sparsePaths, "/", "libs")))
+}
+
 func TestAbstractGitProvider(t *testing.T) {
 	suite.Run(t, NewAbstractGitProviderTestSuite())
 }
@@ -372,6 +372,7 @@ func (g *GiteaGitProvider) GetPrContext(staticContext *StaticGitContext) (*Stati
 	repo.Name = pr.Head.Repository.Name
 	repo.Id = pr.Head.Repository.Name
 	repo.Owner = pr.Head.Repository.Owner.UserName
+	repo.PrBaseBranch = &pr.Base.Ref
 
 	return &repo, nil
 }
@@ -440,7 +441,7 @@ func (g *GiteaGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*s
 	return &repo.DefaultBranch, nil
 }
 
-func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client, err := g.getApiClient()
 	if err != nil {
 		return "", fmt.Errorf("failed to get api client: %w", err)
@@ -451,8 +452,9 @@ func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpoint
 		Config: map[string]string{
 			"url":          endpointUrl,
 			"content_type": "json",
+			"secret":       secret,
 		},
-		Events: []string{"push"},
+		Events: []string{"push", "pull_request"},
 		Active: true,
 	}
 
@@ -505,6 +507,29 @@ func (g *GiteaGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id str
 	return g.FormatError(res, err)
 }
 
+func (g *GiteaGitProvider) SetCommitStatus(repo *GitRepository, status CommitStatus) error {
+	client, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	statusOption := gitea.CreateStatusOption{
+		State:       gitea.StatusState(status.State),
+		Context:     status.Context,
+		Description: status.Description,
+	}
+	if status.TargetUrl != nil {
+		statusOption.TargetURL = *status.TargetUrl
+	}
+
+	_, res, err := client.CreateStatus(repo.Owner, repo.Name, repo.Sha, statusOption)
+	if err != nil {
+		return g.FormatError(res, err)
+	}
+
+	return nil
+}
+
 func (g *GiteaGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	client, err := g.getApiClient()
 	if err != nil {
@@ -528,8 +553,13 @@ func (g *GiteaGitProvider) GetCommitsRange(repo *GitRepository, initialSha strin
 	return (len(currentCommits) - len(initialCommits)), nil
 }
 
+func (g *GiteaGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyHmacSignature(payload, secret, request.Header.Get("X-Gitea-Signature"))
+}
+
 func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Gitea-Event") != "push" {
+	eventKey := request.Header.Get("X-Gitea-Event")
+	if eventKey != "push" && eventKey != "pull_request" && eventKey != "pull_request_sync" {
 		return nil, errors.New("invalid event key")
 	}
 
@@ -538,11 +568,15 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 		return nil, err
 	}
 
-	event, err := hook.Parse(request, giteaWebhook.PushEvent)
+	event, err := hook.Parse(request, giteaWebhook.PushEvent, giteaWebhook.PullRequestEvent, giteaWebhook.PullRequestSyncEvent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse event: %w", err)
 	}
 
+	if pullRequestEvent, ok := event.(giteaWebhook.PullRequestPayload); ok {
+		return g.parsePullRequestEventData(pullRequestEvent), nil
+	}
+
 	pushEvent, ok := event.(giteaWebhook.PushPayload)
 	if !ok {
 		return nil, fmt.Errorf("failed to parse push event: %w", err)
@@ -551,12 +585,21 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 	owner := pushEvent.Repo.Owner.FullName
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Owner:  owner,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Repo.HTMLURL) + ".git",
 		Branch: strings.TrimPrefix(pushEvent.Ref, "refs/heads/"),
 		Sha:    pushEvent.After,
 	}
 
+	if strings.HasPrefix(pushEvent.Ref, "refs/tags/") {
+		tag := strings.TrimPrefix(pushEvent.Ref, "refs/tags/")
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		gitEventData.Branch = tag
+		return gitEventData, nil
+	}
+
 	for _, commit := range pushEvent.Commits {
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Added...)
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Modified...)
@@ -566,6 +609,39 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 	return gitEventData, nil
 }
 
+func (g *GiteaGitProvider) parsePullRequestEventData(event giteaWebhook.PullRequestPayload) *GitEventData {
+	if event.Action != "opened" && event.Action != "reopened" && event.Action != "synchronized" {
+		return nil
+	}
+
+	if event.PullRequest == nil || event.PullRequest.Head == nil || event.PullRequest.Base == nil || event.Repository == nil {
+		return nil
+	}
+
+	prNumber := uint32(event.Index)
+	targetBranch := event.PullRequest.Base.Ref
+
+	gitEventData := &GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          util.CleanUpRepositoryUrl(event.Repository.HTMLURL) + ".git",
+		Branch:       event.PullRequest.Head.Ref,
+		Sha:          event.PullRequest.Head.Sha,
+		PrNumber:     &prNumber,
+		TargetBranch: &targetBranch,
+	}
+
+	if event.Repository.Owner != nil {
+		gitEventData.Owner = event.Repository.Owner.UserName
+	}
+
+	if event.PullRequest.Head.Repository != nil {
+		headRepoUrl := util.CleanUpRepositoryUrl(event.PullRequest.Head.Repository.HTMLURL) + ".git"
+		gitEventData.HeadRepoUrl = &headRepoUrl
+	}
+
+	return gitEventData
+}
+
 func (g *GiteaGitProvider) FormatError(response *gitea.Response, err error) error {
 	return fmt.Errorf("status code: %d err: Request failed with %s", response.StatusCode, err.Error())
 }
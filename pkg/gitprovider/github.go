@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/google/go-github/github"
@@ -235,6 +236,38 @@ func (g *GitHubGitProvider) GetUser() (*GitUser, error) {
 	return response, nil
 }
 
+// The scopes of classic tokens and the expiry of expiring tokens are sent as response headers
+func (g *GitHubGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	client := g.getApiClient()
+
+	_, res, err := client.Users.Get(context.Background(), "")
+	if err != nil {
+		return nil, g.FormatError(err)
+	}
+
+	tokenInfo := &TokenInfo{}
+
+	scopes := res.Header.Get("X-OAuth-Scopes")
+	if scopes != "" {
+		for _, scope := range strings.Split(scopes, ",") {
+			tokenInfo.Scopes = append(tokenInfo.Scopes, strings.TrimSpace(scope))
+		}
+	}
+
+	expiration := res.Header.Get("GitHub-Authentication-Token-Expiration")
+	if expiration != "" {
+		for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+			expiresAt, err := time.Parse(layout, expiration)
+			if err == nil {
+				tokenInfo.ExpiresAt = &expiresAt
+				break
+			}
+		}
+	}
+
+	return tokenInfo, nil
+}
+
 func (g *GitHubGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (string, error) {
 	client := g.getApiClient()
 
@@ -362,6 +395,7 @@ func (g *GitHubGitProvider) GetPrContext(staticContext *StaticGitContext) (*Stat
 	repo.Id = *pr.Head.Repo.Name
 	repo.Name = *pr.Head.Repo.Name
 	repo.Owner = *pr.Head.Repo.Owner.Login
+	repo.PrBaseBranch = pr.Base.Ref
 
 	return &repo, nil
 }
@@ -423,15 +457,16 @@ func (g *GitHubGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl
 	return nil, nil
 }
 
-func (g *GitHubGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitHubGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
 	hook, _, err := client.Repositories.CreateHook(context.Background(), repo.Owner, repo.Name, &github.Hook{
 		Active: github.Bool(true),
-		Events: []string{"push"},
+		Events: []string{"push", "pull_request"},
 		Config: map[string]interface{}{
 			"url":          endpointUrl,
 			"content_type": "json",
+			"secret":       secret,
 		},
 	})
 
@@ -454,6 +489,80 @@ func (g *GitHubGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id st
 	return nil
 }
 
+func (g *GitHubGitProvider) GetPrComment(repo *GitRepository, prNumber uint32, marker string) (*string, error) {
+	client := g.getApiClient()
+
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		comments, resp, err := client.Issues.ListComments(context.Background(), repo.Owner, repo.Name, int(prNumber), opts)
+		if err != nil {
+			return nil, g.FormatError(err)
+		}
+
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				return util.Pointer(strconv.FormatInt(comment.GetID(), 10)), nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// Pull requests share the comment API with issues
+func (g *GitHubGitProvider) CreatePrComment(repo *GitRepository, prNumber uint32, body string) (string, error) {
+	client := g.getApiClient()
+
+	comment, _, err := client.Issues.CreateComment(context.Background(), repo.Owner, repo.Name, int(prNumber), &github.IssueComment{
+		Body: &body,
+	})
+	if err != nil {
+		return "", g.FormatError(err)
+	}
+
+	return strconv.FormatInt(comment.GetID(), 10), nil
+}
+
+func (g *GitHubGitProvider) UpdatePrComment(repo *GitRepository, prNumber uint32, commentId string, body string) error {
+	client := g.getApiClient()
+
+	id, err := strconv.ParseInt(commentId, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.Issues.EditComment(context.Background(), repo.Owner, repo.Name, id, &github.IssueComment{
+		Body: &body,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
+func (g *GitHubGitProvider) SetCommitStatus(repo *GitRepository, status CommitStatus) error {
+	client := g.getApiClient()
+
+	_, _, err := client.Repositories.CreateStatus(context.Background(), repo.Owner, repo.Name, repo.Sha, &github.RepoStatus{
+		State:       github.String(string(status.State)),
+		Context:     &status.Context,
+		Description: &status.Description,
+		TargetURL:   status.TargetUrl,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *GitHubGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	client := g.getApiClient()
 
@@ -473,7 +582,7 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 
 	webhookEventType := github.WebHookType(request)
 
-	if webhookEventType != "push" {
+	if webhookEventType != "push" && webhookEventType != "pull_request" {
 		return nil, nil
 	}
 
@@ -482,23 +591,41 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 		return nil, err
 	}
 
+	if pullRequestEvent, ok := data.(*github.PullRequestEvent); ok {
+		return g.parsePullRequestEventData(pullRequestEvent), nil
+	}
+
 	webhookData, ok := data.(*github.PushEvent)
 	if !ok {
 		return nil, fmt.Errorf("unexpected event type: %T", data)
 	}
 
+	// Branch and tag deletions have no head commit
+	if webhookData.GetDeleted() {
+		return nil, nil
+	}
+
 	var owner string
 	if webhookData.Repo != nil && webhookData.Repo.Owner != nil && webhookData.Repo.Owner.Name != nil {
 		owner = *webhookData.Repo.Owner.Name
 	}
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookData.Repo.GetHTMLURL()) + ".git",
 		Branch: strings.TrimPrefix(webhookData.GetRef(), "refs/heads/"),
 		Sha:    webhookData.HeadCommit.GetID(),
 		Owner:  owner,
 	}
 
+	if strings.HasPrefix(webhookData.GetRef(), "refs/tags/") {
+		tag := strings.TrimPrefix(webhookData.GetRef(), "refs/tags/")
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		gitEventData.Branch = tag
+		return gitEventData, nil
+	}
+
 	for _, commit := range webhookData.Commits {
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Added...)
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Modified...)
@@ -508,6 +635,38 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	return gitEventData, nil
 }
 
+func (g *GitHubGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyHmacSignature(payload, secret, request.Header.Get("X-Hub-Signature-256"))
+}
+
+func (g *GitHubGitProvider) parsePullRequestEventData(event *github.PullRequestEvent) *GitEventData {
+	action := event.GetAction()
+	if action != "opened" && action != "synchronize" && action != "reopened" {
+		return nil
+	}
+
+	pr := event.GetPullRequest()
+	prNumber := uint32(pr.GetNumber())
+	targetBranch := pr.GetBase().GetRef()
+
+	gitEventData := &GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          util.CleanUpRepositoryUrl(event.GetRepo().GetHTMLURL()) + ".git",
+		Branch:       pr.GetHead().GetRef(),
+		Sha:          pr.GetHead().GetSHA(),
+		Owner:        event.GetRepo().GetOwner().GetLogin(),
+		PrNumber:     &prNumber,
+		TargetBranch: &targetBranch,
+	}
+
+	if pr.GetHead().GetRepo().GetHTMLURL() != "" {
+		headRepoUrl := util.CleanUpRepositoryUrl(pr.GetHead().GetRepo().GetHTMLURL()) + ".git"
+		gitEventData.HeadRepoUrl = &headRepoUrl
+	}
+
+	return gitEventData
+}
+
 func (g *GitHubGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*string, error) {
 	client := g.getApiClient()
 
@@ -4,6 +4,11 @@
 package gitprovider
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -248,6 +253,87 @@ func (g *GitHubGitProviderTestSuite) TestGetUrlFromRepo_Commit() {
 	require.Equal("https://github.com/daytonaio/daytona/commit/COMMIT_SHA", url)
 }
 
+func (g *GitHubGitProviderTestSuite) TestParseEventData_PullRequest() {
+	body := `{
+		"action": "synchronize",
+		"pull_request": {
+			"number": 7,
+			"head": {"ref": "feature", "sha": "head-sha", "repo": {"html_url": "https://github.com/contributor/daytona"}},
+			"base": {"ref": "main"}
+		},
+		"repository": {"html_url": "https://github.com/daytonaio/daytona", "owner": {"login": "daytonaio"}},
+		"sender": {"login": "octocat"}
+	}`
+
+	request, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set("X-GitHub-Event", "pull_request")
+
+	require := g.Require()
+
+	eventData, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          "https://github.com/daytonaio/daytona.git",
+		Branch:       "feature",
+		Sha:          "head-sha",
+		Owner:        "daytonaio",
+		PrNumber:     util.Pointer(uint32(7)),
+		TargetBranch: util.Pointer("main"),
+		HeadRepoUrl:  util.Pointer("https://github.com/contributor/daytona.git"),
+	}, eventData)
+}
+
+func (g *GitHubGitProviderTestSuite) TestParseEventData_Tag() {
+	body := `{
+		"ref": "refs/tags/v1.0.0",
+		"head_commit": {"id": "tag-sha"},
+		"repository": {"html_url": "https://github.com/daytonaio/daytona", "owner": {"name": "daytonaio"}}
+	}`
+
+	request, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set("X-GitHub-Event", "push")
+
+	require := g.Require()
+
+	eventData, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:   GitEventTypeTag,
+		Url:    "https://github.com/daytonaio/daytona.git",
+		Branch: "v1.0.0",
+		Sha:    "tag-sha",
+		Owner:  "daytonaio",
+		Tag:    util.Pointer("v1.0.0"),
+	}, eventData)
+}
+
+func (g *GitHubGitProviderTestSuite) TestVerifyEventSignature() {
+	payload := []byte(`{"ref": "refs/heads/main"}`)
+	secret := "webhook-secret"
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	require := g.Require()
+
+	err := g.gitProvider.VerifyEventSignature(request, payload, secret)
+	require.ErrorIs(err, ErrInvalidWebhookSignature)
+
+	request.Header.Set("X-Hub-Signature-256", signature)
+	err = g.gitProvider.VerifyEventSignature(request, payload, secret)
+	require.Nil(err)
+
+	err = g.gitProvider.VerifyEventSignature(request, payload, "other-secret")
+	require.ErrorIs(err, ErrInvalidWebhookSignature)
+
+	err = g.gitProvider.VerifyEventSignature(request, []byte(`{"ref": "refs/heads/other"}`), secret)
+	require.ErrorIs(err, ErrInvalidWebhookSignature)
+}
+
 func TestGitHubGitProvider(t *testing.T) {
 	suite.Run(t, NewGitHubGitProviderTestSuite())
 }
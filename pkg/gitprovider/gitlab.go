@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -218,6 +219,27 @@ func (g *GitLabGitProvider) GetUser() (*GitUser, error) {
 	return response, nil
 }
 
+// Only personal, project and group access tokens can be inspected
+func (g *GitLabGitProvider) GetTokenInfo() (*TokenInfo, error) {
+	client := g.getApiClient()
+
+	token, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		return nil, g.FormatError(err)
+	}
+
+	tokenInfo := &TokenInfo{
+		Scopes: token.Scopes,
+	}
+
+	if token.ExpiresAt != nil {
+		expiresAt := time.Time(*token.ExpiresAt)
+		tokenInfo.ExpiresAt = &expiresAt
+	}
+
+	return tokenInfo, nil
+}
+
 func (g *GitLabGitProvider) GetBranchByCommit(staticContext *StaticGitContext) (string, error) {
 	client := g.getApiClient()
 
@@ -479,7 +501,8 @@ func (g *GitLabGitProvider) GetPrContext(staticContext *StaticGitContext) (*Stat
 		return nil, g.FormatError(err)
 	}
 
-	project, _, err := client.Projects.GetProject(staticContext.Id, nil)
+	// The source branch is in a fork if the source project differs from the target project
+	project, _, err := client.Projects.GetProject(pull.SourceProjectID, nil)
 	if err != nil {
 		return nil, g.FormatError(err)
 	}
@@ -487,7 +510,10 @@ func (g *GitLabGitProvider) GetPrContext(staticContext *StaticGitContext) (*Stat
 	repo := *staticContext
 	repo.Branch = &pull.SourceBranch
 	repo.Url = project.HTTPURLToRepo
-	repo.Owner = pull.Author.Username
+	repo.Id = project.PathWithNamespace
+	repo.Name = project.Path
+	repo.Owner = strings.TrimSuffix(project.PathWithNamespace, "/"+project.Path)
+	repo.PrBaseBranch = &pull.TargetBranch
 
 	return &repo, nil
 }
@@ -503,15 +529,17 @@ func (g *GitLabGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*
 	return &project.DefaultBranch, nil
 }
 
-func (g *GitLabGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitLabGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
-	pushEvents := true
 	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
 
 	hook, _, err := client.Projects.AddProjectHook(projectID, &gitlab.AddProjectHookOptions{
-		URL:        &endpointUrl,
-		PushEvents: &pushEvents,
+		URL:                 &endpointUrl,
+		PushEvents:          gitlab.Ptr(true),
+		TagPushEvents:       gitlab.Ptr(true),
+		MergeRequestsEvents: gitlab.Ptr(true),
+		Token:               &secret,
 	})
 	if err != nil {
 		return "", g.FormatError(err)
@@ -557,6 +585,93 @@ func (g *GitLabGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, hookI
 	return nil
 }
 
+func (g *GitLabGitProvider) GetPrComment(repo *GitRepository, prNumber uint32, marker string) (*string, error) {
+	client := g.getApiClient()
+
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	}
+
+	for {
+		notes, resp, err := client.Notes.ListMergeRequestNotes(projectID, int(prNumber), opts)
+		if err != nil {
+			return nil, g.FormatError(err)
+		}
+
+		for _, note := range notes {
+			if !note.System && strings.Contains(note.Body, marker) {
+				return util.Pointer(strconv.Itoa(note.ID)), nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GitLabGitProvider) CreatePrComment(repo *GitRepository, prNumber uint32, body string) (string, error) {
+	client := g.getApiClient()
+
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+	note, _, err := client.Notes.CreateMergeRequestNote(projectID, int(prNumber), &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return "", g.FormatError(err)
+	}
+
+	return strconv.Itoa(note.ID), nil
+}
+
+func (g *GitLabGitProvider) UpdatePrComment(repo *GitRepository, prNumber uint32, commentId string, body string) error {
+	client := g.getApiClient()
+
+	noteId, err := strconv.Atoi(commentId)
+	if err != nil {
+		return err
+	}
+
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+	_, _, err = client.Notes.UpdateMergeRequestNote(projectID, int(prNumber), noteId, &gitlab.UpdateMergeRequestNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
+func (g *GitLabGitProvider) SetCommitStatus(repo *GitRepository, status CommitStatus) error {
+	client := g.getApiClient()
+
+	var state gitlab.BuildStateValue
+	switch status.State {
+	case CommitStatusStatePending:
+		state = gitlab.Running
+	case CommitStatusStateSuccess:
+		state = gitlab.Success
+	default:
+		state = gitlab.Failed
+	}
+
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+	_, _, err := client.Commits.SetCommitStatus(projectID, repo.Sha, &gitlab.SetCommitStatusOptions{
+		State:       state,
+		Name:        &status.Context,
+		Description: &status.Description,
+		TargetURL:   status.TargetUrl,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *GitLabGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	client := g.getApiClient()
 
@@ -572,12 +687,23 @@ func (g *GitLabGitProvider) GetCommitsRange(repo *GitRepository, initialSha stri
 	return len(commits.Commits), nil
 }
 
+func (g *GitLabGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyWebhookToken(request.Header.Get("X-Gitlab-Token"), secret)
+}
+
 func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	payload, err := io.ReadAll(request.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	switch gitlab.HookEventType(request) {
+	case gitlab.EventTypeTagPush:
+		return g.parseTagEventData(payload)
+	case gitlab.EventTypeMergeRequest:
+		return g.parseMergeRequestEventData(payload)
+	}
+
 	var webhookData gitlab.PushEvent
 	err = json.Unmarshal(payload, &webhookData)
 	if err != nil {
@@ -593,6 +719,7 @@ func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	}
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookData.Project.WebURL) + ".git",
 		Branch: strings.TrimPrefix(webhookData.Ref, "refs/heads/"),
 		Sha:    webhookData.After,
@@ -608,6 +735,71 @@ func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	return gitEventData, nil
 }
 
+func (g *GitLabGitProvider) parseTagEventData(payload []byte) (*GitEventData, error) {
+	var webhookData gitlab.TagEvent
+	err := json.Unmarshal(payload, &webhookData)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tag deletions have no checkout SHA
+	if webhookData.CheckoutSHA == "" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(webhookData.Ref, "refs/tags/")
+
+	return &GitEventData{
+		Type:   GitEventTypeTag,
+		Url:    util.CleanUpRepositoryUrl(webhookData.Project.WebURL) + ".git",
+		Branch: tag,
+		Sha:    webhookData.CheckoutSHA,
+		Owner:  webhookData.Project.Namespace,
+		Tag:    &tag,
+	}, nil
+}
+
+func (g *GitLabGitProvider) parseMergeRequestEventData(payload []byte) (*GitEventData, error) {
+	var webhookData gitlab.MergeEvent
+	err := json.Unmarshal(payload, &webhookData)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := webhookData.ObjectAttributes
+
+	// Updates without an old revision only change the merge request metadata
+	switch attributes.Action {
+	case "open", "reopen":
+	case "update":
+		if attributes.OldRev == "" {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	prNumber := uint32(attributes.IID)
+	targetBranch := attributes.TargetBranch
+
+	gitEventData := &GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          util.CleanUpRepositoryUrl(webhookData.Project.WebURL) + ".git",
+		Branch:       attributes.SourceBranch,
+		Sha:          attributes.LastCommit.ID,
+		Owner:        webhookData.Project.Namespace,
+		PrNumber:     &prNumber,
+		TargetBranch: &targetBranch,
+	}
+
+	if attributes.Source != nil && attributes.Source.WebURL != "" {
+		headRepoUrl := util.CleanUpRepositoryUrl(attributes.Source.WebURL) + ".git"
+		gitEventData.HeadRepoUrl = &headRepoUrl
+	}
+
+	return gitEventData, nil
+}
+
 func (g *GitLabGitProvider) FormatError(err error) error {
 	re := regexp.MustCompile(`([A-Z]+)\s(https:\/\/\S+):\s(\d{3})\s(\{message:\s\d{3}\s.+\})`)
 	match := re.FindStringSubmatch(err.Error())
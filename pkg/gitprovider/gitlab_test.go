@@ -4,6 +4,8 @@
 package gitprovider
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -288,6 +290,82 @@ func (g *GitLabGitProviderTestSuite) TestGetUrlFromRepo_Commit() {
 	require.Equal("https://gitlab.com/daytonaio/daytona/-/commit/COMMIT_SHA", url)
 }
 
+func (g *GitLabGitProviderTestSuite) TestParseEventData_MergeRequest() {
+	body := `{
+		"object_kind": "merge_request",
+		"project": {"namespace": "daytonaio", "web_url": "https://gitlab.com/daytonaio/daytona"},
+		"object_attributes": {
+			"iid": 3,
+			"action": "open",
+			"source_branch": "feature",
+			"target_branch": "main",
+			"last_commit": {"id": "head-sha"},
+			"source": {"web_url": "https://gitlab.com/contributor/daytona"}
+		}
+	}`
+
+	request, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+
+	require := g.Require()
+
+	eventData, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:         GitEventTypePullRequest,
+		Url:          "https://gitlab.com/daytonaio/daytona.git",
+		Branch:       "feature",
+		Sha:          "head-sha",
+		Owner:        "daytonaio",
+		PrNumber:     util.Pointer(uint32(3)),
+		TargetBranch: util.Pointer("main"),
+		HeadRepoUrl:  util.Pointer("https://gitlab.com/contributor/daytona.git"),
+	}, eventData)
+}
+
+func (g *GitLabGitProviderTestSuite) TestParseEventData_Tag() {
+	body := `{
+		"object_kind": "tag_push",
+		"event_name": "tag_push",
+		"ref": "refs/tags/v1.0.0",
+		"checkout_sha": "tag-sha",
+		"project": {"namespace": "daytonaio", "web_url": "https://gitlab.com/daytonaio/daytona"}
+	}`
+
+	request, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set("X-Gitlab-Event", "Tag Push Hook")
+
+	require := g.Require()
+
+	eventData, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:   GitEventTypeTag,
+		Url:    "https://gitlab.com/daytonaio/daytona.git",
+		Branch: "v1.0.0",
+		Sha:    "tag-sha",
+		Owner:  "daytonaio",
+		Tag:    util.Pointer("v1.0.0"),
+	}, eventData)
+}
+
+func (g *GitLabGitProviderTestSuite) TestVerifyEventSignature() {
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	require := g.Require()
+
+	err := g.gitProvider.VerifyEventSignature(request, nil, "webhook-secret")
+	require.ErrorIs(err, ErrInvalidWebhookSignature)
+
+	request.Header.Set("X-Gitlab-Token", "other-secret")
+	err = g.gitProvider.VerifyEventSignature(request, nil, "webhook-secret")
+	require.ErrorIs(err, ErrInvalidWebhookSignature)
+
+	request.Header.Set("X-Gitlab-Token", "webhook-secret")
+	err = g.gitProvider.VerifyEventSignature(request, nil, "webhook-secret")
+	require.Nil(err)
+}
+
 func TestGitLabGitProvider(t *testing.T) {
 	suite.Run(t, NewGitLabGitProviderTestSuite())
 }
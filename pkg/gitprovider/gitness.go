@@ -299,14 +299,15 @@ func (g *GitnessGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (
 	return client.GetDefaultBranch(staticContext.Url)
 }
 
-func (g *GitnessGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitnessGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 	webhook, err := client.CreateWebhook(repo.Id, repo.Owner, gitnessclient.Webhook{
-		Triggers:    []string{"branch_updated"},
+		Triggers:    []string{"branch_updated", "tag_created", "tag_updated", "pullreq_created", "pullreq_reopened", "pullreq_branch_updated"},
 		Url:         endpointUrl,
 		Identifier:  "daytona-webhook_" + repo.Id,
 		DisplayName: "Daytona Webhook",
 		Enabled:     true,
+		Secret:      secret,
 	})
 	if err != nil {
 		return "", err
@@ -362,6 +363,10 @@ func (g *GitnessGitProvider) GetCommitsRange(repo *GitRepository, initialSha str
 	return commitLength, nil
 }
 
+func (g *GitnessGitProvider) VerifyEventSignature(request *http.Request, payload []byte, secret string) error {
+	return verifyHmacSignature(payload, secret, request.Header.Get("X-Gitness-Signature"))
+}
+
 func (g *GitnessGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	payload, err := io.ReadAll(request.Body)
 	if err != nil {
@@ -373,17 +378,33 @@ func (g *GitnessGitProvider) ParseEventData(request *http.Request) (*GitEventDat
 		return nil, err
 	}
 
-	if webhookEvent.Trigger != "branch_updated" {
-		return nil, nil
-	}
-
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookEvent.Repo.GitURL),
 		Branch: strings.TrimPrefix(webhookEvent.Ref.Name, "refs/heads/"),
 		Sha:    webhookEvent.Sha,
 		Owner:  webhookEvent.Principal.DisplayName,
 	}
 
+	switch webhookEvent.Trigger {
+	case "branch_updated":
+	case "tag_created", "tag_updated":
+		tag := strings.TrimPrefix(webhookEvent.Ref.Name, "refs/tags/")
+		gitEventData.Type = GitEventTypeTag
+		gitEventData.Tag = &tag
+		gitEventData.Branch = tag
+		return gitEventData, nil
+	case "pullreq_created", "pullreq_reopened", "pullreq_branch_updated":
+		prNumber := uint32(webhookEvent.PullReq.Number)
+		gitEventData.Type = GitEventTypePullRequest
+		gitEventData.Branch = webhookEvent.PullReq.SourceBranch
+		gitEventData.PrNumber = &prNumber
+		gitEventData.TargetBranch = &webhookEvent.PullReq.TargetBranch
+		return gitEventData, nil
+	default:
+		return nil, nil
+	}
+
 	for _, commit := range webhookEvent.Commits {
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Modified...)
 		gitEventData.AffectedFiles = append(gitEventData.AffectedFiles, commit.Added...)
@@ -203,6 +203,7 @@ type Webhook struct {
 	Insecure    bool     `json:"insecure"`
 	Triggers    []string `json:"triggers"`
 	HasSecret   bool     `json:"has_secret"`
+	Secret      string   `json:"secret,omitempty"`
 	Uid         string   `json:"uid"`
 }
 
@@ -281,6 +282,11 @@ type WebhookEventData struct {
 		UID         string `json:"uid"`
 		Updated     int64  `json:"updated"`
 	} `json:"principal"`
+	PullReq struct {
+		Number       int    `json:"number"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+	} `json:"pull_req"`
 	Ref struct {
 		Name string `json:"name"`
 		Repo struct {
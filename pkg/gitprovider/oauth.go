@@ -0,0 +1,275 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// Polling interval used when the server does not send one, as per RFC 8628
+const defaultDevicePollInterval = 5
+
+var ErrDeviceFlowNotSupported = errors.New("OAuth device flow is not supported for this git provider")
+
+var ErrDeviceFlowBaseUrlRequired = errors.New("OAuth device flow requires an absolute base API URL for self-managed git providers")
+
+type OAuthEndpoints struct {
+	DeviceAuthorizationUrl string
+	TokenUrl               string
+}
+
+type DeviceAuthorization struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationUri string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+type OAuthToken struct {
+	AccessToken  string
+	RefreshToken *string
+	// Nil if the access token does not expire
+	ExpiresAt *time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+// Self-managed providers only support the device flow with an absolute base API URL
+func SupportsDeviceFlow(providerId string, baseApiUrl *string) bool {
+	_, err := GetOAuthEndpoints(providerId, baseApiUrl)
+	return err == nil
+}
+
+// Returns the OAuth endpoints of the provider's instance
+// Self-managed providers are resolved from the base API URL
+func GetOAuthEndpoints(providerId string, baseApiUrl *string) (*OAuthEndpoints, error) {
+	baseUrl := ""
+	if baseApiUrl != nil {
+		baseUrl = strings.TrimSuffix(*baseApiUrl, "/")
+	}
+
+	switch providerId {
+	case "github":
+		baseUrl = "https://github.com"
+		fallthrough
+	case "github-enterprise-server":
+		if !isAbsoluteHttpUrl(baseUrl) {
+			return nil, ErrDeviceFlowBaseUrlRequired
+		}
+		baseUrl = strings.TrimSuffix(baseUrl, "/api/v3")
+		return &OAuthEndpoints{
+			DeviceAuthorizationUrl: baseUrl + "/login/device/code",
+			TokenUrl:               baseUrl + "/login/oauth/access_token",
+		}, nil
+	case "gitlab":
+		baseUrl = "https://gitlab.com"
+		fallthrough
+	case "gitlab-self-managed":
+		if !isAbsoluteHttpUrl(baseUrl) {
+			return nil, ErrDeviceFlowBaseUrlRequired
+		}
+		baseUrl = strings.TrimSuffix(baseUrl, "/api/v4")
+		return &OAuthEndpoints{
+			DeviceAuthorizationUrl: baseUrl + "/oauth/authorize_device",
+			TokenUrl:               baseUrl + "/oauth/token",
+		}, nil
+	case "codeberg":
+		baseUrl = "https://codeberg.org"
+		fallthrough
+	case "gitea":
+		if !isAbsoluteHttpUrl(baseUrl) {
+			return nil, ErrDeviceFlowBaseUrlRequired
+		}
+		return &OAuthEndpoints{
+			DeviceAuthorizationUrl: baseUrl + "/login/oauth/device/code",
+			TokenUrl:               baseUrl + "/login/oauth/access_token",
+		}, nil
+	default:
+		return nil, ErrDeviceFlowNotSupported
+	}
+}
+
+func isAbsoluteHttpUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// Starts the device authorization flow. The user has to enter the returned user code at the verification URI.
+func RequestDeviceAuthorization(ctx context.Context, endpoints *OAuthEndpoints, clientId string, scopes string) (*DeviceAuthorization, error) {
+	res, err := postOAuthForm(ctx, endpoints.DeviceAuthorizationUrl, url.Values{
+		"client_id": {clientId},
+		"scope":     {scopes},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("failed to request device authorization: %s: %s", res.Status, string(body))
+	}
+
+	var authorization DeviceAuthorization
+	err = json.NewDecoder(res.Body).Decode(&authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorization.Interval == 0 {
+		authorization.Interval = defaultDevicePollInterval
+	}
+
+	return &authorization, nil
+}
+
+// Polls the token endpoint until the user authorizes the device, denies access or the device code expires
+func PollDeviceAccessToken(ctx context.Context, endpoints *OAuthEndpoints, clientId string, authorization *DeviceAuthorization) (*OAuthToken, error) {
+	interval := time.Duration(authorization.Interval) * time.Second
+
+	if authorization.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(authorization.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("device authorization expired")
+		case <-time.After(interval):
+		}
+
+		tokenResponse, err := requestOAuthToken(ctx, endpoints.TokenUrl, url.Values{
+			"client_id":   {clientId},
+			"device_code": {authorization.DeviceCode},
+			"grant_type":  {deviceCodeGrantType},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		switch tokenResponse.Error {
+		case "":
+			return tokenResponse.toOAuthToken(), nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			if tokenResponse.Interval > 0 {
+				interval = time.Duration(tokenResponse.Interval) * time.Second
+			} else {
+				interval += 5 * time.Second
+			}
+		case "access_denied":
+			return nil, errors.New("device authorization was denied")
+		case "expired_token":
+			return nil, errors.New("device authorization expired")
+		default:
+			return nil, tokenResponse.error()
+		}
+	}
+}
+
+// Exchanges the refresh token for a new access token
+func RefreshOAuthToken(ctx context.Context, endpoints *OAuthEndpoints, clientId string, refreshToken string) (*OAuthToken, error) {
+	tokenResponse, err := requestOAuthToken(ctx, endpoints.TokenUrl, url.Values{
+		"client_id":     {clientId},
+		"refresh_token": {refreshToken},
+		"grant_type":    {"refresh_token"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenResponse.Error != "" {
+		return nil, tokenResponse.error()
+	}
+
+	token := tokenResponse.toOAuthToken()
+	// Providers that do not rotate refresh tokens omit them from the response
+	if token.RefreshToken == nil {
+		token.RefreshToken = &refreshToken
+	}
+
+	return token, nil
+}
+
+func requestOAuthToken(ctx context.Context, tokenUrl string, form url.Values) (*oauthTokenResponse, error) {
+	res, err := postOAuthForm(ctx, tokenUrl, form)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var tokenResponse oauthTokenResponse
+	err = json.NewDecoder(res.Body).Decode(&tokenResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token: %s", res.Status)
+	}
+
+	if tokenResponse.Error == "" && tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("failed to request token: %s", res.Status)
+	}
+
+	return &tokenResponse, nil
+}
+
+func postOAuthForm(ctx context.Context, endpoint string, form url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub responds with a form encoded body unless JSON is requested
+	req.Header.Set("Accept", "application/json")
+
+	return http.DefaultClient.Do(req)
+}
+
+func (r *oauthTokenResponse) toOAuthToken() *OAuthToken {
+	token := &OAuthToken{
+		AccessToken: r.AccessToken,
+	}
+
+	if r.RefreshToken != "" {
+		token.RefreshToken = &r.RefreshToken
+	}
+
+	if r.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+		token.ExpiresAt = &expiresAt
+	}
+
+	return token
+}
+
+func (r *oauthTokenResponse) error() error {
+	if r.ErrorDescription != "" {
+		return fmt.Errorf("%s: %s", r.Error, r.ErrorDescription)
+	}
+	return errors.New(r.Error)
+}
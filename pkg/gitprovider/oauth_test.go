@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type OAuthTestSuite struct {
+	server       *httptest.Server
+	endpoints    *OAuthEndpoints
+	pendingPolls int
+	suite.Suite
+}
+
+func (s *OAuthTestSuite) SetupTest() {
+	s.pendingPolls = 1
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device/code", func(w http.ResponseWriter, r *http.Request) {
+		s.Require().Nil(r.ParseForm())
+		s.Require().Equal("client-id", r.Form.Get("client_id"))
+		s.Require().Equal("repo read:user", r.Form.Get("scope"))
+
+		writeJson(w, map[string]interface{}{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-1234",
+			"verification_uri": "https://git.example.com/device",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		s.Require().Nil(r.ParseForm())
+		s.Require().Equal("client-id", r.Form.Get("client_id"))
+
+		switch r.Form.Get("grant_type") {
+		case deviceCodeGrantType:
+			s.Require().Equal("device-code", r.Form.Get("device_code"))
+			if s.pendingPolls > 0 {
+				s.pendingPolls--
+				writeJson(w, map[string]interface{}{"error": "authorization_pending"})
+				return
+			}
+			writeJson(w, map[string]interface{}{
+				"access_token":  "access-token",
+				"refresh_token": "refresh-token",
+				"expires_in":    7200,
+			})
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh-token" {
+				w.WriteHeader(http.StatusBadRequest)
+				writeJson(w, map[string]interface{}{"error": "invalid_grant", "error_description": "refresh token revoked"})
+				return
+			}
+			writeJson(w, map[string]interface{}{
+				"access_token": "new-access-token",
+				"expires_in":   7200,
+			})
+		}
+	})
+
+	s.server = httptest.NewServer(mux)
+	s.endpoints = &OAuthEndpoints{
+		DeviceAuthorizationUrl: s.server.URL + "/device/code",
+		TokenUrl:               s.server.URL + "/token",
+	}
+}
+
+func (s *OAuthTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *OAuthTestSuite) TestDeviceFlow() {
+	require := s.Require()
+
+	authorization, err := RequestDeviceAuthorization(context.Background(), s.endpoints, "client-id", "repo read:user")
+	require.Nil(err)
+	require.Equal("ABCD-1234", authorization.UserCode)
+	require.Equal("https://git.example.com/device", authorization.VerificationUri)
+
+	token, err := PollDeviceAccessToken(context.Background(), s.endpoints, "client-id", authorization)
+	require.Nil(err)
+	require.Equal(0, s.pendingPolls)
+	require.Equal("access-token", token.AccessToken)
+	require.Equal("refresh-token", *token.RefreshToken)
+	require.WithinDuration(time.Now().Add(2*time.Hour), *token.ExpiresAt, time.Minute)
+}
+
+func (s *OAuthTestSuite) TestRefreshOAuthToken() {
+	require := s.Require()
+
+	token, err := RefreshOAuthToken(context.Background(), s.endpoints, "client-id", "refresh-token")
+	require.Nil(err)
+	require.Equal("new-access-token", token.AccessToken)
+	// The refresh token is kept if the provider does not rotate it
+	require.Equal("refresh-token", *token.RefreshToken)
+
+	_, err = RefreshOAuthToken(context.Background(), s.endpoints, "client-id", "revoked")
+	require.EqualError(err, "invalid_grant: refresh token revoked")
+}
+
+func (s *OAuthTestSuite) TestGetOAuthEndpoints() {
+	require := s.Require()
+
+	endpoints, err := GetOAuthEndpoints("gitlab-self-managed", util.Pointer("https://gitlab.example.com/api/v4/"))
+	require.Nil(err)
+	require.Equal("https://gitlab.example.com/oauth/authorize_device", endpoints.DeviceAuthorizationUrl)
+	require.Equal("https://gitlab.example.com/oauth/token", endpoints.TokenUrl)
+
+	endpoints, err = GetOAuthEndpoints("github", nil)
+	require.Nil(err)
+	require.Equal("https://github.com/login/device/code", endpoints.DeviceAuthorizationUrl)
+
+	// Self-managed instances can not be resolved without an absolute base API URL
+	_, err = GetOAuthEndpoints("gitea", nil)
+	require.ErrorIs(err, ErrDeviceFlowBaseUrlRequired)
+
+	_, err = GetOAuthEndpoints("gitea", util.Pointer("gitea.example.com"))
+	require.ErrorIs(err, ErrDeviceFlowBaseUrlRequired)
+
+	require.True(SupportsDeviceFlow("codeberg", nil))
+	require.True(SupportsDeviceFlow("gitea", util.Pointer("https://gitea.example.com")))
+	require.False(SupportsDeviceFlow("gitea", nil))
+	require.False(SupportsDeviceFlow("bitbucket", nil))
+}
+
+func writeJson(w http.ResponseWriter, body map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestOAuth(t *testing.T) {
+	suite.Run(t, new(OAuthTestSuite))
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// NormalizeSparsePaths cleans up the directories of a cone mode sparse checkout.
+// Duplicates and the repository root are removed as the root is always checked out.
+func NormalizeSparsePaths(sparsePaths []string) []string {
+	var result []string
+
+	for _, sparsePath := range sparsePaths {
+		sparsePath = strings.Trim(path.Clean("/"+strings.TrimSpace(sparsePath)), "/")
+		if sparsePath == "" || slices.Contains(result, sparsePath) {
+			continue
+		}
+		result = append(result, sparsePath)
+	}
+
+	return result
+}
+
+// IsInSparseCheckout checks if a file is checked out by a cone mode sparse checkout of the given directories.
+// All files are checked out if no directories are set.
+func IsInSparseCheckout(sparsePaths []string, filePath string) bool {
+	if len(sparsePaths) == 0 {
+		return true
+	}
+
+	filePath = strings.TrimPrefix(path.Clean("/"+filePath), "/")
+
+	// Files in the repository root are always included in cone mode
+	if !strings.Contains(filePath, "/") {
+		return true
+	}
+
+	for _, sparsePath := range NormalizeSparsePaths(sparsePaths) {
+		if strings.HasPrefix(filePath, sparsePath+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddSparsePath adds a directory to the paths of a sparse checkout.
+// Nothing is added if the whole repository is checked out.
+func AddSparsePath(sparsePaths []string, dir string) []string {
+	if len(sparsePaths) == 0 {
+		return sparsePaths
+	}
+
+	return NormalizeSparsePaths(append(slices.Clone(sparsePaths), dir))
+}
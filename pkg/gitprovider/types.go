@@ -3,6 +3,8 @@
 
 package gitprovider
 
+import "time"
+
 type GitUser struct {
 	Id       string `json:"id" validate:"required"`
 	Username string `json:"username" validate:"required"`
@@ -10,11 +12,18 @@ type GitUser struct {
 	Email    string `json:"email" validate:"required"`
 } // @name GitUser
 
+// TokenInfo holds the details of the access token that the git provider exposes
+type TokenInfo struct {
+	Scopes    []string   `json:"scopes,omitempty" validate:"optional"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
+} // @name GitTokenInfo
+
 type CloneTarget string // @name CloneTarget
 
 const (
 	CloneTargetBranch CloneTarget = "branch"
 	CloneTargetCommit CloneTarget = "commit"
+	CloneTargetTag    CloneTarget = "tag"
 )
 
 type GitRepository struct {
@@ -28,8 +37,33 @@ type GitRepository struct {
 	Source   string      `json:"source" validate:"required"`
 	Path     *string     `json:"path,omitempty" validate:"optional"`
 	Target   CloneTarget `json:"cloneTarget,omitempty" validate:"optional"`
+	// Set if the repository context was created from a pull request
+	PullRequest *PullRequestRefs `json:"pullRequest,omitempty" validate:"optional"`
+	// Directories checked out in cone mode, the whole repository is checked out if empty
+	SparsePaths []string `json:"sparsePaths,omitempty" validate:"optional"`
+	// Git LFS objects are only fetched if set
+	Lfs *LfsConfig `json:"lfs,omitempty" validate:"optional"`
 } // @name GitRepository
 
+// LfsConfig holds the filters of the Git LFS objects fetched when cloning a repository
+type LfsConfig struct {
+	// Paths of the LFS objects to fetch, all objects are fetched if empty
+	Include []string `json:"include,omitempty" validate:"optional"`
+	// Paths of the LFS objects to skip
+	Exclude []string `json:"exclude,omitempty" validate:"optional"`
+} // @name GitLfsConfig
+
+// PullRequestRefs holds the refs of the pull request that a repository context was created from
+type PullRequestRefs struct {
+	BaseBranch string `json:"baseBranch" validate:"required"`
+	HeadBranch string `json:"headBranch" validate:"required"`
+	// ID and owner of the repository the pull request targets
+	BaseRepoId    string `json:"baseRepoId" validate:"required"`
+	BaseRepoOwner string `json:"baseRepoOwner" validate:"required"`
+	// Clone URL of the repository the pull request targets, only set if the head branch is in a fork
+	ForkOrigin *string `json:"forkOrigin,omitempty" validate:"optional"`
+} // @name PullRequestRefs
+
 type GitNamespace struct {
 	Id   string `json:"id" validate:"required"`
 	Name string `json:"name" validate:"required"`
@@ -50,10 +84,45 @@ type GitPullRequest struct {
 	SourceRepoName  string `json:"sourceRepoName" validate:"required"`
 } // @name GitPullRequest
 
+type CommitStatusState string // @name CommitStatusState
+
+const (
+	CommitStatusStatePending CommitStatusState = "pending"
+	CommitStatusStateSuccess CommitStatusState = "success"
+	CommitStatusStateFailure CommitStatusState = "failure"
+)
+
+// CommitStatus is reported back to the repository for a commit.
+// Statuses with the same context replace each other.
+type CommitStatus struct {
+	State       CommitStatusState
+	Context     string
+	Description string
+	// Optional link to the details of the status
+	TargetUrl *string
+}
+
+type GitEventType string // @name GitEventType
+
+const (
+	GitEventTypePush        GitEventType = "push"
+	GitEventTypeTag         GitEventType = "tag"
+	GitEventTypePullRequest GitEventType = "pull_request"
+)
+
+// GitEventData describes a push, tag push or pull request event.
+// For tag events, Branch holds the tag name. For pull request events, Branch and Sha
+// point to the head of the pull request and TargetBranch holds the branch it targets.
 type GitEventData struct {
-	Url           string   `json:"url" validate:"required"`
-	Branch        string   `json:"branch" validate:"required"`
-	Sha           string   `json:"sha" validate:"required"`
-	Owner         string   `json:"user" validate:"required"`
-	AffectedFiles []string `json:"affectedFiles" validate:"required"`
+	Type          GitEventType `json:"type" validate:"required"`
+	Url           string       `json:"url" validate:"required"`
+	Branch        string       `json:"branch" validate:"required"`
+	Sha           string       `json:"sha" validate:"required"`
+	Owner         string       `json:"user" validate:"required"`
+	AffectedFiles []string     `json:"affectedFiles" validate:"required"`
+	Tag           *string      `json:"tag,omitempty" validate:"optional"`
+	PrNumber      *uint32      `json:"prNumber,omitempty" validate:"optional"`
+	TargetBranch  *string      `json:"targetBranch,omitempty" validate:"optional"`
+	// Repository the head branch of a pull request is in, differs from Url for pull requests from forks
+	HeadRepoUrl *string `json:"headRepoUrl,omitempty" validate:"optional"`
 } //	@name	GitEventData
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidWebhookSignature is returned for webhook events that are unsigned or signed with an unknown secret
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// verifyHmacSignature checks a hex encoded HMAC-SHA256 signature of the payload.
+// The signature may be prefixed with the algorithm, e.g. "sha256=<signature>"
+func verifyHmacSignature(payload []byte, secret, signature string) error {
+	signature = strings.TrimPrefix(signature, "sha256=")
+	if signature == "" || secret == "" {
+		return ErrInvalidWebhookSignature
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
+
+// verifyWebhookToken checks a plain token that the git provider sends along with the event
+func verifyWebhookToken(token, secret string) error {
+	if token == "" || secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
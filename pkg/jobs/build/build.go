@@ -18,6 +18,7 @@ type BuildJob struct {
 	models.Job
 
 	findBuild            func(ctx context.Context, buildId string) (*services.BuildDTO, error)
+	updateBuildResult    func(ctx context.Context, buildId string, result services.UpdateBuildResultDTO) error
 	listSuccessfulBuilds func(ctx context.Context, repoUrl string) ([]*models.Build, error)
 	listConfigsForUrl    func(ctx context.Context, repoUrl string) ([]*models.GitProviderConfig, error)
 	checkImageExists     func(ctx context.Context, image string) bool
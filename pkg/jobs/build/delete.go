@@ -16,6 +16,9 @@ func (bj *BuildJob) delete(ctx context.Context, j *models.Job, force bool) error
 	}
 
 	if b.Image != nil {
+		// The layer cache image only exists for some builders
+		_ = bj.deleteImage(ctx, models.GetBuildCacheImage(*b.Image), force)
+
 		return bj.deleteImage(ctx, *b.Image, force)
 	}
 
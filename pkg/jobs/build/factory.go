@@ -24,6 +24,7 @@ type BuildJobFactory struct {
 
 type BuildJobFactoryConfig struct {
 	FindBuild            func(ctx context.Context, buildId string) (*services.BuildDTO, error)
+	UpdateBuildResult    func(ctx context.Context, buildId string, result services.UpdateBuildResultDTO) error
 	ListSuccessfulBuilds func(ctx context.Context, repoUrl string) ([]*models.Build, error)
 	ListConfigsForUrl    func(ctx context.Context, repoUrl string) ([]*models.GitProviderConfig, error)
 	CheckImageExists     func(ctx context.Context, image string) bool
@@ -47,6 +48,7 @@ func (f *BuildJobFactory) Create(job models.Job) jobs.IJob {
 		Job: job,
 
 		findBuild:            f.config.FindBuild,
+		updateBuildResult:    f.config.UpdateBuildResult,
 		listSuccessfulBuilds: f.config.ListSuccessfulBuilds,
 		listConfigsForUrl:    f.config.ListConfigsForUrl,
 		checkImageExists:     f.config.CheckImageExists,
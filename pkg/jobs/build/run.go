@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/services"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
@@ -55,6 +56,16 @@ func (bj *BuildJob) run(ctx context.Context, j *models.Job) error {
 	}
 
 	b.BuildConfig.CachedBuild = models.GetCachedBuild(&b.Build, successfulBuilds)
+	if b.BuildConfig.CachedBuild == nil {
+		// Layers can still be reused from builds of other commits, branches or environment variables
+		cacheBuild := models.GetLayerCacheBuild(&b.Build, successfulBuilds)
+		if cacheBuild != nil && cacheBuild.User != nil {
+			b.BuildConfig.CachedBuild = &models.CachedBuild{
+				Image: *cacheBuild.Image,
+				User:  *cacheBuild.User,
+			}
+		}
+	}
 
 	err = bj.runBuildProcess(ctx, BuildProcessConfig{
 		Builder: builder,
@@ -75,6 +86,19 @@ func (bj *BuildJob) run(ctx context.Context, j *models.Job) error {
 		return bj.handleBuildResult(b.Build, builder, buildLogger, err)
 	}
 
+	err = bj.updateBuildResult(ctx, b.Id, services.UpdateBuildResultDTO{
+		Image:      *b.Image,
+		User:       *b.User,
+		CacheStats: b.CacheStats,
+	})
+	if err != nil {
+		return bj.handleBuildResult(b.Build, builder, buildLogger, err)
+	}
+
+	if b.CacheStats != nil && b.CacheStats.TotalSteps > 0 {
+		buildLogger.Write([]byte(fmt.Sprintf("Build cache: %d/%d steps reused\n", b.CacheStats.CachedSteps, b.CacheStats.TotalSteps)))
+	}
+
 	err = builder.CleanUp()
 	if err != nil {
 		errMsg := fmt.Sprintf("Error cleaning up build: %s\n", err.Error())
@@ -115,6 +139,7 @@ func (bj *BuildJob) runBuildProcess(ctx context.Context, config BuildProcessConf
 
 	config.Build.Image = &image
 	config.Build.User = &user
+	config.Build.CacheStats = config.Builder.GetCacheStats()
 
 	return nil
 }
@@ -32,13 +32,24 @@ func (wj *WorkspaceJob) stop(ctx context.Context, j *models.Job) error {
 		return err
 	}
 
-	_, err = (*p).StopWorkspace(&provider.WorkspaceRequest{
+	req := &provider.WorkspaceRequest{
 		Workspace: w,
-	})
+	}
+
+	_, err = (*p).StopWorkspace(req)
 	if err != nil {
 		return err
 	}
 
+	// Refresh the provider metadata so that it reflects the stopped sidecar services
+	providerMetadata, err := (*p).GetWorkspaceProviderMetadata(req)
+	if err == nil {
+		err = wj.updateWorkspaceProviderMetadata(ctx, w.Id, providerMetadata)
+	}
+	if err != nil {
+		workspaceLogger.Write([]byte(fmt.Sprintf("Failed to update provider metadata: %s\n", err)))
+	}
+
 	workspaceLogger.Write([]byte(views.GetPrettyLogLine(fmt.Sprintf("Workspace %s stopped", w.Name))))
 
 	return nil
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+// ToolboxAuditEntry describes a single operation performed through the workspace toolbox API
+type ToolboxAuditEntry struct {
+	Time string `json:"time"`
+	// Credential the toolbox authenticated the request with, "unauthenticated" if it was rejected
+	Caller string `json:"caller"`
+	// Caller reported by the request, the server sets it to the name of its API key when proxying.
+	// It is not verified by the toolbox and must not be trusted on its own.
+	ReportedCaller string `json:"reportedCaller,omitempty"`
+	RemoteAddr     string `json:"remoteAddr"`
+	Method         string `json:"method"`
+	Operation      string `json:"operation"`
+	Path           string `json:"path,omitempty"`
+	Command        string `json:"command,omitempty"`
+	Status         int    `json:"status"`
+	Error          string `json:"error,omitempty"`
+	DurationMs     int64  `json:"durationMs"`
+}
@@ -25,6 +25,7 @@ const (
 	LogSourceProvider LogSource = "provider"
 	LogSourceBuilder  LogSource = "builder"
 	LogSourceRunner   LogSource = "runner"
+	LogSourceAgent    LogSource = "agent"
 )
 
 type LogEntry struct {
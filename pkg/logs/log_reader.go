@@ -40,6 +40,15 @@ func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byt
 }
 
 func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
+	readJSONEntries[LogEntry](ctx, logReader, follow, c, errChan)
+}
+
+func ReadToolboxAuditLog(ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
+	readJSONEntries[ToolboxAuditEntry](ctx, logReader, follow, c, errChan)
+}
+
+// readJSONEntries reads delimited JSON entries of type T from the logReader
+func readJSONEntries[T any](ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
 	reader := bufio.NewReader(logReader)
 	for {
 		select {
@@ -49,7 +58,7 @@ func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan i
 			line, readErr := reader.ReadString('\n')
 			if line != "" {
 				stripped := strings.TrimSuffix(line, LogDelimiter)
-				var logEntry LogEntry
+				var logEntry T
 
 				err := json.Unmarshal([]byte(stripped), &logEntry)
 				if err != nil {
@@ -59,11 +68,12 @@ func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan i
 				c <- logEntry
 			}
 			if readErr != nil {
+				var empty T
 				if readErr != io.EOF {
-					c <- LogEntry{}
+					c <- empty
 					errChan <- readErr
 				} else if !follow {
-					c <- LogEntry{}
+					c <- empty
 					errChan <- io.EOF
 					return
 				}
@@ -29,10 +29,11 @@ type RemoteLoggerFactoryConfig struct {
 type ApiBasePath string
 
 var (
-	ApiBasePathWorkspace ApiBasePath = "/log/workspace"
-	ApiBasePathBuild     ApiBasePath = "/log/build"
-	ApiBasePathRunner    ApiBasePath = "/log/runner"
-	ApiBasePathTarget    ApiBasePath = "/log/target"
+	ApiBasePathWorkspace    ApiBasePath = "/log/workspace"
+	ApiBasePathBuild        ApiBasePath = "/log/build"
+	ApiBasePathRunner       ApiBasePath = "/log/runner"
+	ApiBasePathTarget       ApiBasePath = "/log/target"
+	ApiBasePathToolboxAudit ApiBasePath = "/log/toolbox-audit"
 )
 
 func (r *remoteLoggerFactory) CreateLogger(id, label string, source LogSource) (Logger, error) {
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/gorilla/websocket"
+)
+
+// RemoteLogWriter sends every write as is to the log write endpoint of the server.
+// The connection is opened on the first write and reopened on the next write after a failure.
+type RemoteLogWriter struct {
+	apiUrl string
+	apiKey string
+	path   string
+	conn   *websocket.Conn
+	mutex  sync.Mutex
+}
+
+func NewRemoteLogWriter(apiUrl, apiKey string, apiBasePath ApiBasePath, id string) *RemoteLogWriter {
+	return &RemoteLogWriter{
+		apiUrl: apiUrl,
+		apiKey: apiKey,
+		path:   fmt.Sprintf("%s/%s/write", apiBasePath, id),
+	}
+}
+
+func (r *RemoteLogWriter) Write(p []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.conn == nil {
+		conn, _, err := util.GetWebsocketConn(context.Background(), r.path, r.apiUrl, r.apiKey, nil)
+		if err != nil {
+			return 0, err
+		}
+		r.conn = conn
+	}
+
+	err := r.conn.WriteMessage(websocket.TextMessage, p)
+	if err != nil {
+		r.conn.Close()
+		r.conn = nil
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (r *RemoteLogWriter) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.conn == nil {
+		return nil
+	}
+
+	err := r.conn.Close()
+	r.conn = nil
+	return err
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
 
+// Tag suffix of the images holding the layer cache of build images
+const BUILD_CACHE_TAG_SUFFIX = "-cache"
+
 type Build struct {
 	Id              string                     `json:"id" validate:"required" gorm:"primaryKey"`
 	Image           *string                    `json:"image" validate:"optional"`
@@ -22,6 +25,7 @@ type Build struct {
 	LastJobId       *string                    `json:"lastJobId" validate:"optional"`
 	LastJob         *Job                       `json:"lastJob" validate:"optional" gorm:"foreignKey:LastJobId;references:Id"`
 	PrebuildId      *string                    `json:"prebuildId" validate:"optional"`
+	CacheStats      *BuildCacheStats           `json:"cacheStats" validate:"optional" gorm:"serializer:json"`
 	CreatedAt       time.Time                  `json:"createdAt" validate:"required" gorm:"not null"`
 	UpdatedAt       time.Time                  `json:"updatedAt" validate:"required" gorm:"not null"`
 } // @name Build
@@ -30,6 +34,16 @@ func (w *Build) GetState() ResourceState {
 	return getResourceStateFromJob(w.LastJob)
 }
 
+// Build layer cache usage of a build run
+type BuildCacheStats struct {
+	// Images the build layers were reused from
+	CacheFrom []string `json:"cacheFrom" validate:"required"`
+	// Number of build steps that were reused from the cache
+	CachedSteps int `json:"cachedSteps" validate:"required"`
+	// Number of build steps of the image build
+	TotalSteps int `json:"totalSteps" validate:"required"`
+} // @name BuildCacheStats
+
 type ContainerConfig struct {
 	Image string `json:"image" validate:"required" gorm:"not null"`
 	User  string `json:"user" validate:"required" gorm:"not null"`
@@ -63,14 +77,18 @@ func (b *Build) Compare(other *Build) (bool, error) {
 
 // GetBuildHash returns a SHA-256 hash of the build's configuration, repository branch and environment variables.
 func (b *Build) GetBuildHash() (string, error) {
-	var buildJson []byte
-	var err error
-	if b.BuildConfig != nil && b.BuildConfig.Devcontainer != nil {
-		buildJson, err = json.Marshal(b.BuildConfig.Devcontainer)
-		if err != nil {
-			return "", err
-		}
+	// The cached build is an output of the build and must not affect its hash
+	var buildConfig BuildConfig
+	if b.BuildConfig != nil {
+		buildConfig = *b.BuildConfig
+		buildConfig.CachedBuild = nil
+	}
+
+	buildJson, err := json.Marshal(buildConfig)
+	if err != nil {
+		return "", err
 	}
+
 	envVarsJson, err := json.Marshal(b.EnvVars)
 	if err != nil {
 		return "", err
@@ -127,3 +145,46 @@ func GetCachedBuild(build *Build, builds []*Build) *CachedBuild {
 
 	return nil
 }
+
+// GetLayerCacheBuild returns the most recent successful build of the same repository and devcontainer config.
+// Unlike GetCachedBuild, the branch and environment variables may differ since only the build layers are reused.
+func GetLayerCacheBuild(build *Build, builds []*Build) *Build {
+	var cacheBuild *Build
+
+	devcontainerJson, err := getDevcontainerJson(build)
+	if err != nil {
+		return nil
+	}
+
+	for _, existingBuild := range builds {
+		if existingBuild.Id == build.Id || existingBuild.Image == nil || existingBuild.GetState().Name != ResourceStateNameRunSuccessful {
+			continue
+		}
+		if existingBuild.Repository == nil || existingBuild.Repository.Url != build.Repository.Url {
+			continue
+		}
+		existingDevcontainerJson, err := getDevcontainerJson(existingBuild)
+		if err != nil || existingDevcontainerJson != devcontainerJson {
+			continue
+		}
+		if cacheBuild == nil || existingBuild.CreatedAt.After(cacheBuild.CreatedAt) {
+			cacheBuild = existingBuild
+		}
+	}
+
+	return cacheBuild
+}
+
+// GetBuildCacheImage returns the name of the image holding the layer cache of a build image
+func GetBuildCacheImage(image string) string {
+	return image + BUILD_CACHE_TAG_SUFFIX
+}
+
+func getDevcontainerJson(build *Build) (string, error) {
+	if build.BuildConfig == nil || build.BuildConfig.Devcontainer == nil {
+		return "", nil
+	}
+
+	devcontainerJson, err := json.Marshal(build.BuildConfig.Devcontainer)
+	return string(devcontainerJson), err
+}
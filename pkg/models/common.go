@@ -37,9 +37,32 @@ const (
 
 type BuildConfig struct {
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty" validate:"optional"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty" validate:"optional"`
+	Nix          *NixConfig          `json:"nix,omitempty" validate:"optional"`
+	Buildpacks   *BuildpacksConfig   `json:"buildpacks,omitempty" validate:"optional"`
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty" validate:"optional"`
 } // @name BuildConfig
 
 type DevcontainerConfig struct {
 	FilePath string `json:"filePath" validate:"required"`
 } // @name DevcontainerConfig
+
+type DockerfileConfig struct {
+	// Path to the Dockerfile relative to the repository root
+	FilePath string `json:"filePath" validate:"required"`
+	// Build context directory relative to the repository root, defaults to the repository root
+	Context string            `json:"context,omitempty" validate:"optional"`
+	Args    map[string]string `json:"args,omitempty" validate:"optional"`
+	// Target stage of a multi-stage Dockerfile
+	Target string `json:"target,omitempty" validate:"optional"`
+} // @name DockerfileConfig
+
+type NixConfig struct {
+	// Path to the flake.nix, devenv.nix or shell.nix file relative to the repository root
+	FilePath string `json:"filePath" validate:"required"`
+} // @name NixConfig
+
+type BuildpacksConfig struct {
+	// Buildpacks builder image, defaults to the Paketo Jammy base builder
+	Builder string `json:"builder,omitempty" validate:"optional"`
+} // @name BuildpacksConfig
@@ -3,6 +3,8 @@
 
 package models
 
+import "time"
+
 type SigningMethod string // @name SigningMethod
 
 const (
@@ -19,4 +21,23 @@ type GitProviderConfig struct {
 	Alias         string         `json:"alias" validate:"required" gorm:"uniqueIndex;not null"`
 	SigningKey    *string        `json:"signingKey,omitempty" validate:"optional"`
 	SigningMethod *SigningMethod `json:"signingMethod,omitempty" validate:"optional"`
+	// Set if the token was obtained with the OAuth device flow
+	OAuthClientId *string `json:"oauthClientId,omitempty" validate:"optional"`
+	// The token is refreshed by the server before it expires
+	RefreshToken   *string    `json:"-"`
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty" validate:"optional"`
+	// Result of the last token health check
+	TokenStatus *TokenStatus `json:"tokenStatus,omitempty" validate:"optional" gorm:"serializer:json"`
+	// Secrets of the registered prebuild webhooks mapped by webhook ID
+	WebhookSecrets map[string]string `json:"-" gorm:"serializer:json"`
 } // @name GitProvider
+
+type TokenStatus struct {
+	Valid bool `json:"valid" validate:"required"`
+	// Error returned by the git provider if the token is not valid
+	Error *string `json:"error,omitempty" validate:"optional"`
+	// Scopes and expiry are only set if the git provider exposes them
+	Scopes    []string   `json:"scopes,omitempty" validate:"optional"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
+	CheckedAt time.Time  `json:"checkedAt" validate:"required"`
+} // @name GitProviderTokenStatus
@@ -27,13 +27,27 @@ type Workspace struct {
 	LastJobId           *string                    `json:"lastJobId" validate:"optional"`
 	LastJob             *Job                       `json:"lastJob" validate:"optional" gorm:"foreignKey:LastJobId;references:Id"`
 	ProviderMetadata    *string                    `json:"providerMetadata,omitempty" validate:"optional"`
+	// Repositories cloned next to the main repository of the workspace
+	AdditionalRepositories []*WorkspaceRepository `json:"additionalRepositories,omitempty" validate:"optional" gorm:"serializer:json"`
 } // @name Workspace
 
+// WorkspaceRepository is an additional repository of a workspace, cloned by the agent next to the main repository
+type WorkspaceRepository struct {
+	Repository *gitprovider.GitRepository `json:"repository" validate:"required"`
+	// Directory of the clone relative to the parent directory of the main repository
+	ClonePath string `json:"clonePath" validate:"required"`
+} // @name WorkspaceRepository
+
 type WorkspaceMetadata struct {
-	WorkspaceId string     `json:"workspaceId" validate:"required" gorm:"primaryKey"`
-	UpdatedAt   time.Time  `json:"updatedAt" validate:"required" gorm:"not null"`
-	Uptime      uint64     `json:"uptime" validate:"required" gorm:"not null"`
-	GitStatus   *GitStatus `json:"gitStatus" validate:"optional" gorm:"serializer:json"`
+	WorkspaceId    string                `json:"workspaceId" validate:"required" gorm:"primaryKey"`
+	UpdatedAt      time.Time             `json:"updatedAt" validate:"required" gorm:"not null"`
+	Uptime         uint64                `json:"uptime" validate:"required" gorm:"not null"`
+	GitStatus      *GitStatus            `json:"gitStatus" validate:"optional" gorm:"serializer:json"`
+	LifecycleHooks []LifecycleHookStatus `json:"lifecycleHooks,omitempty" validate:"optional" gorm:"serializer:json"`
+	ForwardPorts   []WorkspacePort       `json:"forwardPorts,omitempty" validate:"optional" gorm:"serializer:json"`
+	Services       []WorkspaceService    `json:"services,omitempty" validate:"optional" gorm:"serializer:json"`
+	// Git status of each additional repository keyed by its clone path
+	AdditionalGitStatuses map[string]*GitStatus `json:"additionalGitStatuses,omitempty" validate:"optional" gorm:"serializer:json"`
 } // @name WorkspaceMetadata
 
 func (w *Workspace) WorkspaceFolderName() string {
@@ -91,3 +105,45 @@ const (
 	Copied             Status = "Copied"
 	UpdatedButUnmerged Status = "Updated but unmerged"
 )
+
+// LifecycleHookStatus is the result of a devcontainer lifecycle command run by the agent on workspace start
+type LifecycleHookStatus struct {
+	Name       string             `json:"name" validate:"required"`
+	State      LifecycleHookState `json:"state" validate:"required"`
+	Error      *string            `json:"error,omitempty" validate:"optional"`
+	StartedAt  time.Time          `json:"startedAt" validate:"required"`
+	FinishedAt *time.Time         `json:"finishedAt,omitempty" validate:"optional"`
+} // @name LifecycleHookStatus
+
+type LifecycleHookState string // @name LifecycleHookState
+
+const (
+	LifecycleHookStateRunning   LifecycleHookState = "running"
+	LifecycleHookStateSucceeded LifecycleHookState = "succeeded"
+	LifecycleHookStateFailed    LifecycleHookState = "failed"
+)
+
+// WorkspacePort is a port the devcontainer config asks to forward to the local machine
+type WorkspacePort struct {
+	Port             uint16  `json:"port" validate:"required"`
+	Label            *string `json:"label,omitempty" validate:"optional"`
+	OnAutoForward    *string `json:"onAutoForward,omitempty" validate:"optional"`
+	RequireLocalPort bool    `json:"requireLocalPort" validate:"optional"`
+} // @name WorkspacePort
+
+// Values of the devcontainer onAutoForward port attribute acted on by the CLI
+const (
+	PortOnAutoForwardNotify = "notify"
+	PortOnAutoForwardSilent = "silent"
+	PortOnAutoForwardIgnore = "ignore"
+)
+
+// WorkspaceService is a sidecar service running next to the workspace container, e.g. a compose service
+type WorkspaceService struct {
+	Name   string `json:"name" validate:"required"`
+	State  string `json:"state" validate:"required"`
+	Status string `json:"status,omitempty" validate:"optional"`
+} // @name WorkspaceService
+
+// Providers report the sidecar services of a workspace as a JSON encoded list under this provider metadata key
+const WorkspaceServicesProviderMetadataKey = "daytona.services"
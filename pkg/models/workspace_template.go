@@ -6,8 +6,10 @@ package models
 import (
 	"encoding/json"
 	"errors"
+	"path"
 	"sort"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/docker/docker/pkg/stringid"
 )
 
@@ -22,15 +24,22 @@ type WorkspaceTemplate struct {
 	IsDefault           bool              `json:"default" validate:"required" gorm:"not null"`
 	Prebuilds           []*PrebuildConfig `json:"prebuilds" validate:"optional" gorm:"serializer:json"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId" validate:"optional"`
+	// Directories checked out in cone mode, the whole repository is checked out if empty
+	SparsePaths []string `json:"sparsePaths,omitempty" validate:"optional" gorm:"serializer:json"`
+	// Git LFS objects are only fetched if set
+	Lfs *gitprovider.LfsConfig `json:"lfs,omitempty" validate:"optional" gorm:"serializer:json"`
 } // @name WorkspaceTemplate
 
 func (wt *WorkspaceTemplate) SetPrebuild(p *PrebuildConfig) error {
 	newPrebuild := PrebuildConfig{
-		Id:             p.Id,
-		Branch:         p.Branch,
-		CommitInterval: p.CommitInterval,
-		TriggerFiles:   p.TriggerFiles,
-		Retention:      p.Retention,
+		Id:                 p.Id,
+		Branch:             p.Branch,
+		CommitInterval:     p.CommitInterval,
+		TriggerFiles:       p.TriggerFiles,
+		PullRequestTargets: p.PullRequestTargets,
+		TagPatterns:        p.TagPatterns,
+		Schedule:           p.Schedule,
+		Retention:          p.Retention,
 	}
 
 	for _, pb := range wt.Prebuilds {
@@ -84,12 +93,17 @@ func (wt *WorkspaceTemplate) DeletePrebuild(id string) error {
 }
 
 // PrebuildConfig holds configuration for the prebuild process
+// Branch, PullRequestTargets and TagPatterns support glob patterns (e.g. "release/*")
+// Schedule is a cron expression (e.g. "0 3 * * *") on which the branch is rebuilt regardless of git events
 type PrebuildConfig struct {
-	Id             string   `json:"id" validate:"required" gorm:"not null"`
-	Branch         string   `json:"branch" validate:"required" gorm:"not null"`
-	CommitInterval *int     `json:"commitInterval" validate:"optional"`
-	TriggerFiles   []string `json:"triggerFiles" validate:"required" gorm:"not null"`
-	Retention      int      `json:"retention" validate:"required" gorm:"not null"`
+	Id                 string   `json:"id" validate:"required" gorm:"not null"`
+	Branch             string   `json:"branch" validate:"required" gorm:"not null"`
+	CommitInterval     *int     `json:"commitInterval" validate:"optional"`
+	TriggerFiles       []string `json:"triggerFiles" validate:"required" gorm:"not null"`
+	PullRequestTargets []string `json:"pullRequestTargets" validate:"optional"`
+	TagPatterns        []string `json:"tagPatterns" validate:"optional"`
+	Schedule           *string  `json:"schedule" validate:"optional"`
+	Retention          int      `json:"retention" validate:"required" gorm:"not null"`
 } // @name PrebuildConfig
 
 func (p *PrebuildConfig) GenerateId() error {
@@ -100,6 +114,36 @@ func (p *PrebuildConfig) GenerateId() error {
 	return nil
 }
 
+// MatchesBranch returns true if pushes to the branch can trigger the prebuild
+func (p *PrebuildConfig) MatchesBranch(branch string) bool {
+	return matchesAnyPattern([]string{p.Branch}, branch)
+}
+
+// MatchesPullRequestTarget returns true if pull requests opened against the target branch trigger the prebuild
+func (p *PrebuildConfig) MatchesPullRequestTarget(targetBranch string) bool {
+	return matchesAnyPattern(p.PullRequestTargets, targetBranch)
+}
+
+// MatchesTag returns true if pushing the tag triggers the prebuild
+func (p *PrebuildConfig) MatchesTag(tag string) bool {
+	return matchesAnyPattern(p.TagPatterns, tag)
+}
+
+func matchesAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+
+		matched, err := path.Match(pattern, value)
+		if err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
 type MatchParams struct {
 	WorkspaceTemplateName *string
 	Id                    *string
@@ -30,6 +30,7 @@ import (
 )
 
 const RUNNER_METADATA_UPDATE_INTERVAL = 2 * time.Second
+const WORKSPACE_SERVICES_UPDATE_INTERVAL = 10 * time.Second
 
 type IRunner interface {
 	Start(ctx context.Context) error
@@ -50,6 +51,9 @@ type RunnerConfig struct {
 	SetRunnerMetadata   func(ctx context.Context, runnerId string, metadata models.RunnerMetadata) error
 	TrackTelemetryEvent func(event telemetry.Event, clientId string) error
 
+	ListWorkspaces                  func(ctx context.Context) ([]*models.Workspace, error)
+	UpdateWorkspaceProviderMetadata func(ctx context.Context, workspaceId, metadata string) error
+
 	WorkspaceJobFactory workspace.IWorkspaceJobFactory
 	TargetJobFactory    target.ITargetJobFactory
 	BuildJobFactory     build.IBuildJobFactory
@@ -70,6 +74,9 @@ func NewRunner(config RunnerConfig) IRunner {
 		setRunnerMetadata:   config.SetRunnerMetadata,
 		trackTelemetryEvent: config.TrackTelemetryEvent,
 
+		listWorkspaces:                  config.ListWorkspaces,
+		updateWorkspaceProviderMetadata: config.UpdateWorkspaceProviderMetadata,
+
 		workspaceJobFactory: config.WorkspaceJobFactory,
 		targetJobFactory:    config.TargetJobFactory,
 		buildJobFactory:     config.BuildJobFactory,
@@ -91,6 +98,9 @@ type Runner struct {
 	setRunnerMetadata   func(ctx context.Context, runnerId string, metadata models.RunnerMetadata) error
 	trackTelemetryEvent func(event telemetry.Event, clientId string) error
 
+	listWorkspaces                  func(ctx context.Context) ([]*models.Workspace, error)
+	updateWorkspaceProviderMetadata func(ctx context.Context, workspaceId, metadata string) error
+
 	workspaceJobFactory workspace.IWorkspaceJobFactory
 	targetJobFactory    target.ITargetJobFactory
 	buildJobFactory     build.IBuildJobFactory
@@ -168,6 +178,16 @@ func (r *Runner) Start(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		for {
+			err := r.refreshWorkspaceServices(ctx)
+			if err != nil {
+				r.logger.Debug(fmt.Errorf("failed to refresh workspace services: %w", err))
+			}
+			time.Sleep(WORKSPACE_SERVICES_UPDATE_INTERVAL)
+		}
+	}()
+
 	r.logger.Info("Runner started")
 
 	select {
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+// refreshWorkspaceServices asks the providers for the provider metadata of the started workspaces that
+// report sidecar services, so the service states follow the containers instead of only being updated
+// when the workspace is started or stopped. Workspaces without services are skipped to avoid polling
+// providers that don't report any.
+func (r *Runner) refreshWorkspaceServices(ctx context.Context) error {
+	workspaces, err := r.listWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if w.Target.TargetConfig.ProviderInfo.RunnerId != r.Config.Id {
+			continue
+		}
+
+		if w.Metadata == nil || len(w.Metadata.Services) == 0 || w.GetState().Name != models.ResourceStateNameStarted {
+			continue
+		}
+
+		p, err := r.providerManager.GetProvider(w.Target.TargetConfig.ProviderInfo.Name)
+		if err != nil {
+			r.logger.Debug(fmt.Errorf("failed to get provider for workspace %s: %w", w.Name, err))
+			continue
+		}
+
+		providerMetadata, err := (*p).GetWorkspaceProviderMetadata(&provider.WorkspaceRequest{
+			Workspace: w,
+		})
+		if err != nil {
+			r.logger.Debug(fmt.Errorf("failed to get provider metadata of workspace %s: %w", w.Name, err))
+			continue
+		}
+
+		err = r.updateWorkspaceProviderMetadata(ctx, w.Id, providerMetadata)
+		if err != nil {
+			r.logger.Debug(fmt.Errorf("failed to update provider metadata of workspace %s: %w", w.Name, err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Parser for user provided schedules.
+// Accepts standard cron expressions, an optional leading seconds field and descriptors such as "@daily".
+var scheduleParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ValidateSchedule returns an error if the schedule is not a valid cron expression
+func ValidateSchedule(schedule string) error {
+	_, err := scheduleParser.Parse(schedule)
+	return err
+}
+
+// IsScheduleDue returns true if the schedule has an activation in the (from, to] time range
+func IsScheduleDue(schedule string, from, to time.Time) (bool, error) {
+	s, err := scheduleParser.Parse(schedule)
+	if err != nil {
+		return false, err
+	}
+
+	return !s.Next(from).After(to), nil
+}
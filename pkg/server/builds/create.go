@@ -5,7 +5,9 @@ package builds
 
 import (
 	"context"
+	"path"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/daytonaio/daytona/pkg/services"
 	"github.com/daytonaio/daytona/pkg/telemetry"
@@ -28,11 +30,27 @@ func (s *BuildService) Create(ctx context.Context, b services.CreateBuildDTO) (s
 		return "", s.handleCreateError(ctx, nil, err)
 	}
 
-	repo, err := s.getRepositoryContext(ctx, workspaceTemplate.RepositoryUrl, b.Branch)
+	branch := b.Branch
+	if b.Tag != nil {
+		branch = *b.Tag
+	}
+
+	repo, err := s.getRepositoryContext(ctx, workspaceTemplate.RepositoryUrl, branch, b.PrNumber)
 	if err != nil {
 		return "", s.handleCreateError(ctx, nil, err)
 	}
 
+	if b.Tag != nil {
+		repo.Target = gitprovider.CloneTargetTag
+	}
+
+	repo.Lfs = workspaceTemplate.Lfs
+	repo.SparsePaths = workspaceTemplate.SparsePaths
+	// The builder reads the devcontainer configuration from the cloned repository
+	if workspaceTemplate.BuildConfig != nil && workspaceTemplate.BuildConfig.Devcontainer != nil {
+		repo.SparsePaths = gitprovider.AddSparsePath(repo.SparsePaths, path.Dir(workspaceTemplate.BuildConfig.Devcontainer.FilePath))
+	}
+
 	newBuild := models.Build{
 		Id: id,
 		ContainerConfig: models.ContainerConfig{
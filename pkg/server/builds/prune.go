@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"context"
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/services"
+)
+
+// Prune removes the build images that are no longer referenced from the builder registry.
+// Images used by workspaces, the latest [retention] successful builds of each prebuild
+// and builds that were not started by a prebuild are kept.
+func (s *BuildService) Prune(ctx context.Context, dryRun bool) (*services.BuildPruneResult, error) {
+	builds, err := s.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range builds {
+		switch b.State.Name {
+		case models.ResourceStateNamePendingRun, models.ResourceStateNameRunning:
+			// Images of running builds are not pushed yet and would not be accounted for
+			return nil, services.ErrBuildPruneInProgress
+		}
+	}
+
+	referencedImages, err := s.getReferencedImages(ctx, builds)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pruneRegistry(ctx, referencedImages, dryRun)
+}
+
+func (s *BuildService) getReferencedImages(ctx context.Context, builds []*services.BuildDTO) ([]string, error) {
+	referencedImages, err := s.listWorkspaceImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retentions, err := s.listPrebuildRetentions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prebuildBuilds := map[string][]*services.BuildDTO{}
+
+	for _, b := range builds {
+		if b.Image == nil {
+			continue
+		}
+
+		if b.PrebuildId == nil || *b.PrebuildId == "" {
+			referencedImages = append(referencedImages, *b.Image)
+			continue
+		}
+
+		if b.State.Name == models.ResourceStateNameRunSuccessful {
+			prebuildBuilds[*b.PrebuildId] = append(prebuildBuilds[*b.PrebuildId], b)
+		}
+	}
+
+	for prebuildId, associatedBuilds := range prebuildBuilds {
+		retention, ok := retentions[prebuildId]
+		if !ok {
+			// The prebuild was deleted
+			continue
+		}
+
+		// Sort the builds by creation time in descending order (newest first)
+		sort.Slice(associatedBuilds, func(i, j int) bool {
+			return associatedBuilds[i].CreatedAt.After(associatedBuilds[j].CreatedAt)
+		})
+
+		for i := 0; i < retention && i < len(associatedBuilds); i++ {
+			referencedImages = append(referencedImages, *associatedBuilds[i].Image)
+		}
+	}
+
+	return referencedImages, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/services"
+)
+
+func (s *BuildServiceTestSuite) savePrebuildBuild(id, prebuildId string, createdAt time.Time, jobState models.JobState) {
+	require := s.Require()
+
+	err := s.buildStore.Save(context.TODO(), &models.Build{
+		Id:         id,
+		Image:      util.Pointer(fmt.Sprintf("registry.example.com/w-repo:%s", id)),
+		User:       util.Pointer("user"),
+		PrebuildId: util.Pointer(prebuildId),
+		Repository: &gitprovider.GitRepository{},
+		CreatedAt:  createdAt,
+	})
+	require.Nil(err)
+
+	err = s.jobStore.Save(context.TODO(), &models.Job{
+		Id:           id,
+		ResourceId:   id,
+		ResourceType: models.ResourceTypeBuild,
+		Action:       models.JobActionRun,
+		State:        jobState,
+	})
+	require.Nil(err)
+}
+
+func (s *BuildServiceTestSuite) TestPrune() {
+	require := s.Require()
+
+	now := time.Now()
+	s.savePrebuildBuild("prebuild-old", "prebuild1", now.Add(-2*time.Hour), models.JobStateSuccess)
+	s.savePrebuildBuild("prebuild-new", "prebuild1", now.Add(-time.Hour), models.JobStateSuccess)
+	s.savePrebuildBuild("prebuild-failed", "prebuild1", now, models.JobStateError)
+	s.savePrebuildBuild("prebuild-deleted", "prebuild2", now, models.JobStateSuccess)
+
+	s.prebuildRetention = map[string]int{"prebuild1": 1}
+	s.workspaceImages = []string{"registry.example.com/w-repo:workspace"}
+
+	result, err := s.buildService.Prune(context.TODO(), true)
+	require.Nil(err)
+	require.True(result.DryRun)
+
+	require.ElementsMatch([]string{
+		"registry.example.com/w-repo:workspace",
+		"registry.example.com/w-repo:prebuild-new",
+	}, s.prunedWithImages)
+}
+
+func (s *BuildServiceTestSuite) TestPruneWithBuildInProgress() {
+	require := s.Require()
+
+	s.savePrebuildBuild("prebuild-running", "prebuild1", time.Now(), models.JobStateRunning)
+
+	_, err := s.buildService.Prune(context.TODO(), false)
+	require.ErrorIs(err, services.ErrBuildPruneInProgress)
+	require.Nil(s.prunedWithImages)
+}
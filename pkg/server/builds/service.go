@@ -18,31 +18,40 @@ import (
 )
 
 type BuildServiceConfig struct {
-	BuildStore            stores.BuildStore
-	FindWorkspaceTemplate func(ctx context.Context, name string) (*models.WorkspaceTemplate, error)
-	GetRepositoryContext  func(ctx context.Context, url, branch string) (*gitprovider.GitRepository, error)
-	CreateJob             func(ctx context.Context, buildId string, action models.JobAction) error
-	TrackTelemetryEvent   func(event telemetry.Event, clientId string) error
-	LoggerFactory         logs.ILoggerFactory
+	BuildStore             stores.BuildStore
+	FindWorkspaceTemplate  func(ctx context.Context, name string) (*models.WorkspaceTemplate, error)
+	GetRepositoryContext   func(ctx context.Context, url, branch string, prNumber *uint32) (*gitprovider.GitRepository, error)
+	CreateJob              func(ctx context.Context, buildId string, action models.JobAction) error
+	ListWorkspaceImages    func(ctx context.Context) ([]string, error)
+	ListPrebuildRetentions func(ctx context.Context) (map[string]int, error)
+	PruneRegistry          func(ctx context.Context, referencedImages []string, dryRun bool) (*services.BuildPruneResult, error)
+	TrackTelemetryEvent    func(event telemetry.Event, clientId string) error
+	LoggerFactory          logs.ILoggerFactory
 }
 
 type BuildService struct {
-	buildStore            stores.BuildStore
-	findWorkspaceTemplate func(ctx context.Context, name string) (*models.WorkspaceTemplate, error)
-	getRepositoryContext  func(ctx context.Context, url, branch string) (*gitprovider.GitRepository, error)
-	createJob             func(ctx context.Context, buildId string, action models.JobAction) error
-	trackTelemetryEvent   func(event telemetry.Event, clientId string) error
-	loggerFactory         logs.ILoggerFactory
+	buildStore             stores.BuildStore
+	findWorkspaceTemplate  func(ctx context.Context, name string) (*models.WorkspaceTemplate, error)
+	getRepositoryContext   func(ctx context.Context, url, branch string, prNumber *uint32) (*gitprovider.GitRepository, error)
+	createJob              func(ctx context.Context, buildId string, action models.JobAction) error
+	listWorkspaceImages    func(ctx context.Context) ([]string, error)
+	listPrebuildRetentions func(ctx context.Context) (map[string]int, error)
+	pruneRegistry          func(ctx context.Context, referencedImages []string, dryRun bool) (*services.BuildPruneResult, error)
+	trackTelemetryEvent    func(event telemetry.Event, clientId string) error
+	loggerFactory          logs.ILoggerFactory
 }
 
 func NewBuildService(config BuildServiceConfig) services.IBuildService {
 	return &BuildService{
-		buildStore:            config.BuildStore,
-		findWorkspaceTemplate: config.FindWorkspaceTemplate,
-		getRepositoryContext:  config.GetRepositoryContext,
-		loggerFactory:         config.LoggerFactory,
-		createJob:             config.CreateJob,
-		trackTelemetryEvent:   config.TrackTelemetryEvent,
+		buildStore:             config.BuildStore,
+		findWorkspaceTemplate:  config.FindWorkspaceTemplate,
+		getRepositoryContext:   config.GetRepositoryContext,
+		loggerFactory:          config.LoggerFactory,
+		createJob:              config.CreateJob,
+		listWorkspaceImages:    config.ListWorkspaceImages,
+		listPrebuildRetentions: config.ListPrebuildRetentions,
+		pruneRegistry:          config.PruneRegistry,
+		trackTelemetryEvent:    config.TrackTelemetryEvent,
 	}
 }
 
@@ -174,6 +183,23 @@ func (s *BuildService) UpdateLastJob(ctx context.Context, buildId, jobId string)
 	return s.buildStore.Save(ctx, b)
 }
 
+func (s *BuildService) UpdateResult(ctx context.Context, buildId string, result services.UpdateBuildResultDTO) error {
+	b, err := s.buildStore.Find(ctx, &stores.BuildFilter{
+		Id: &buildId,
+	})
+	if err != nil {
+		return err
+	}
+
+	b.Image = &result.Image
+	b.User = &result.User
+	b.CacheStats = result.CacheStats
+	// Make sure the old relation doesn't get saved to the store
+	b.LastJob = nil
+
+	return s.buildStore.Save(ctx, b)
+}
+
 func (s *BuildService) GetBuildLogReader(ctx context.Context, buildId string) (io.Reader, error) {
 	return s.loggerFactory.CreateLogReader(buildId)
 }
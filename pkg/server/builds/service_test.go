@@ -85,8 +85,12 @@ var expectedFilteredBuildsMap map[string]*models.Build
 
 type BuildServiceTestSuite struct {
 	suite.Suite
-	buildService services.IBuildService
-	buildStore   stores.BuildStore
+	buildService      services.IBuildService
+	buildStore        stores.BuildStore
+	jobStore          stores.JobStore
+	prunedWithImages  []string
+	workspaceImages   []string
+	prebuildRetention map[string]int
 }
 
 func NewBuildServiceTestSuite() *BuildServiceTestSuite {
@@ -114,6 +118,10 @@ func (s *BuildServiceTestSuite) SetupTest() {
 	}
 
 	jobStore := job.NewInMemoryJobStore()
+	s.jobStore = jobStore
+	s.prunedWithImages = nil
+	s.workspaceImages = []string{}
+	s.prebuildRetention = map[string]int{}
 
 	s.buildStore = build_internal.NewInMemoryBuildStore(jobStore)
 	s.buildService = builds.NewBuildService(builds.BuildServiceConfig{
@@ -124,7 +132,7 @@ func (s *BuildServiceTestSuite) SetupTest() {
 		FindWorkspaceTemplate: func(ctx context.Context, name string) (*models.WorkspaceTemplate, error) {
 			return workspaceTemplate, nil
 		},
-		GetRepositoryContext: func(ctx context.Context, url, branch string) (*gitprovider.GitRepository, error) {
+		GetRepositoryContext: func(ctx context.Context, url, branch string, prNumber *uint32) (*gitprovider.GitRepository, error) {
 			return &gitprovider.GitRepository{
 				Url:    url,
 				Branch: branch,
@@ -139,6 +147,16 @@ func (s *BuildServiceTestSuite) SetupTest() {
 				State:        models.JobStateSuccess,
 			})
 		},
+		ListWorkspaceImages: func(ctx context.Context) ([]string, error) {
+			return s.workspaceImages, nil
+		},
+		ListPrebuildRetentions: func(ctx context.Context) (map[string]int, error) {
+			return s.prebuildRetention, nil
+		},
+		PruneRegistry: func(ctx context.Context, referencedImages []string, dryRun bool) (*services.BuildPruneResult, error) {
+			s.prunedWithImages = referencedImages
+			return &services.BuildPruneResult{DryRun: dryRun}, nil
+		},
 	})
 
 	for _, b := range expectedBuilds {
@@ -213,3 +231,37 @@ func (s *BuildServiceTestSuite) TestHandleSuccessfulRemoval() {
 	require.Nil(err)
 	require.NotContains(builds, build3)
 }
+
+func (s *BuildServiceTestSuite) TestUpdateResult() {
+	require := s.Require()
+
+	cacheStats := &models.BuildCacheStats{
+		CacheFrom:   []string{"image0-cache"},
+		CachedSteps: 3,
+		TotalSteps:  4,
+	}
+
+	b := &models.Build{
+		Id:         "id5",
+		Repository: &gitprovider.GitRepository{},
+	}
+	err := s.buildStore.Save(context.TODO(), b)
+	require.Nil(err)
+
+	err = s.buildService.UpdateResult(context.TODO(), b.Id, services.UpdateBuildResultDTO{
+		Image:      "image5",
+		User:       "user5",
+		CacheStats: cacheStats,
+	})
+	require.Nil(err)
+
+	updated, err := s.buildService.Find(context.TODO(), &services.BuildFilter{
+		StoreFilter: stores.BuildFilter{
+			Id: &b.Id,
+		},
+	})
+	require.Nil(err)
+	require.Equal("image5", *updated.Image)
+	require.Equal("user5", *updated.User)
+	require.Equal(cacheStats, updated.CacheStats)
+}
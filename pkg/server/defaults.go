@@ -116,6 +116,7 @@ func getDefaultConfig() (*Config, error) {
 		BuilderRegistryServer:     defaultBuilderRegistryServer,
 		BuildImageNamespace:       defaultBuildImageNamespace,
 		SamplesIndexUrl:           defaultSamplesIndexUrl,
+		PrBotEnabled:              util.Pointer(false),
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
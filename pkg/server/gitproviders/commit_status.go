@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+// Statuses of all prebuilds share the context so the latest build of a commit replaces the previous status
+const prebuildCommitStatusContext = "daytona/prebuild"
+
+// SetPrebuildCommitStatus reports the state of the build job as the status of the commit the build was created from.
+// Git providers that do not support commit statuses are skipped.
+func (s *GitProviderService) SetPrebuildCommitStatus(ctx context.Context, repo *gitprovider.GitRepository, buildId string, jobState models.JobState) error {
+	if repo == nil || repo.Sha == "" {
+		return nil
+	}
+
+	gitProvider, _, err := s.GetGitProviderForUrl(ctx, repo.Url)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	status := gitprovider.CommitStatus{
+		Context: prebuildCommitStatusContext,
+	}
+
+	// The build logs are only served to authenticated clients, so the status links to the configured build page instead
+	if s.buildLinkTemplate != "" {
+		targetUrl := strings.ReplaceAll(s.buildLinkTemplate, "{buildId}", url.PathEscape(buildId))
+		status.TargetUrl = &targetUrl
+	}
+
+	switch jobState {
+	case models.JobStatePending, models.JobStateRunning:
+		status.State = gitprovider.CommitStatusStatePending
+		status.Description = "Prebuild in progress"
+	case models.JobStateSuccess:
+		status.State = gitprovider.CommitStatusStateSuccess
+		status.Description = "Prebuild succeeded"
+	case models.JobStateError:
+		status.State = gitprovider.CommitStatusStateFailure
+		status.Description = fmt.Sprintf("Prebuild failed, run 'daytona build logs %s' for details", buildId)
+	default:
+		return nil
+	}
+
+	err = gitProvider.SetCommitStatus(repo, status)
+	if err != nil && !errors.Is(err, gitprovider.ErrCommitStatusNotSupported) {
+		return fmt.Errorf("failed to set commit status: %s", err.Error())
+	}
+
+	return nil
+}
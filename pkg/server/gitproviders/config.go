@@ -17,7 +17,17 @@ import (
 )
 
 func (s *GitProviderService) FindConfig(ctx context.Context, id string) (*models.GitProviderConfig, error) {
-	return s.configStore.Find(ctx, id)
+	providerConfig, err := s.configStore.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.refreshTokenIfExpired(ctx, providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerConfig, nil
 }
 
 func (s *GitProviderService) ListConfigs(ctx context.Context) ([]*models.GitProviderConfig, error) {
@@ -33,6 +43,12 @@ func (s *GitProviderService) ListConfigsForUrl(ctx context.Context, repoUrl stri
 	}
 
 	for _, p := range gitProviders {
+		// The token is returned to clone the repository, so it has to be valid
+		err = s.refreshTokenIfExpired(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
 		p.Token = url.QueryEscape(p.Token)
 		p.Username = url.QueryEscape(p.Username)
 
@@ -66,10 +82,25 @@ func (s *GitProviderService) SaveConfig(ctx context.Context, providerConfig *mod
 		return s.handleSetGitProviderConfigError(ctx, providerConfig, err)
 	}
 	providerConfig.Username = userData.Username
+	providerConfig.TokenStatus = newValidTokenStatus(gitProvider)
 	if providerConfig.Id == "" {
 		id := stringid.GenerateRandomID()
 		id = stringid.TruncateID(id)
 		providerConfig.Id = id
+	} else {
+		existingConfig, err := s.configStore.Find(ctx, providerConfig.Id)
+		if err == nil {
+			// Keep the secrets of the registered webhooks when updating the config
+			if providerConfig.WebhookSecrets == nil {
+				providerConfig.WebhookSecrets = existingConfig.WebhookSecrets
+			}
+			// Keep refreshing the OAuth token unless it was replaced
+			if providerConfig.RefreshToken == nil && providerConfig.Token == existingConfig.Token {
+				providerConfig.OAuthClientId = existingConfig.OAuthClientId
+				providerConfig.RefreshToken = existingConfig.RefreshToken
+				providerConfig.TokenExpiresAt = existingConfig.TokenExpiresAt
+			}
+		}
 	}
 
 	if providerConfig.Alias == "" {
@@ -4,8 +4,10 @@
 package gitproviders
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -67,29 +69,45 @@ func (s *GitProviderService) GetGitProviderForUrl(ctx context.Context, repoUrl s
 	return nil, "", errors.New("can not get public client for the URL " + repoUrl)
 }
 
+// GetGitProviderForHttpRequest returns the git provider that sent the webhook event.
+// The event must be signed with the secret of one of the webhooks registered for the provider.
 func (s *GitProviderService) GetGitProviderForHttpRequest(ctx context.Context, req *http.Request) (gitprovider.GitProvider, error) {
-	var provider *models.GitProviderConfig
-
 	gitProviders, err := s.configStore.List(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	payload, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+
+	providerFound := false
 	for _, p := range gitProviders {
 		header := req.Header.Get(config.GetWebhookEventHeaderKeyFromGitProvider(p.ProviderId))
 		if header == "" {
 			continue
-		} else {
-			provider = p
-			break
+		}
+		providerFound = true
+
+		gitProvider, err := s.newGitProvider(p)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, secret := range p.WebhookSecrets {
+			if gitProvider.VerifyEventSignature(req, payload, secret) == nil {
+				return gitProvider, nil
+			}
 		}
 	}
 
-	if provider == nil {
-		return nil, errors.New("git provider for HTTP request not found")
+	if providerFound {
+		return nil, gitprovider.ErrInvalidWebhookSignature
 	}
 
-	return s.newGitProvider(provider)
+	return nil, errors.New("git provider for HTTP request not found")
 }
 
 func getHostnameFromUrl(urlToParse string) (string, error) {
@@ -0,0 +1,167 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// Hidden marker used to find the comment posted by the bot on a pull request
+const prBotCommentMarker = "<!-- daytona-pr-bot -->"
+
+// PostPrBotComment comments a link for creating a workspace from the pull request of the event.
+// If the pull request already has a comment from the bot, it is updated instead.
+func (s *GitProviderService) PostPrBotComment(ctx context.Context, data gitprovider.GitEventData) error {
+	if !s.prBotEnabled || data.Type != gitprovider.GitEventTypePullRequest || data.PrNumber == nil {
+		return nil
+	}
+
+	gitProvider, _, err := s.GetGitProviderForUrl(ctx, data.Url)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	if !supportsPrBot(gitProvider) {
+		return nil
+	}
+
+	staticContext, err := gitProvider.ParseStaticGitContext(data.Url)
+	if err != nil {
+		return err
+	}
+
+	repo := &gitprovider.GitRepository{
+		Id:       staticContext.Id,
+		Url:      staticContext.Url,
+		Name:     staticContext.Name,
+		Owner:    staticContext.Owner,
+		Source:   staticContext.Source,
+		Branch:   data.Branch,
+		Sha:      data.Sha,
+		PrNumber: data.PrNumber,
+	}
+
+	return s.upsertPrBotComment(gitProvider, repo, nil)
+}
+
+// UpdatePrBotComment adds the result of a finished prebuild to the comment of the pull request the build was created for.
+// Results of builds for commits that are no longer the head of the pull request are ignored.
+func (s *GitProviderService) UpdatePrBotComment(ctx context.Context, repo *gitprovider.GitRepository, buildState models.JobState) error {
+	if !s.prBotEnabled || repo == nil || repo.PrNumber == nil {
+		return nil
+	}
+
+	if buildState != models.JobStateSuccess && buildState != models.JobStateError {
+		return nil
+	}
+
+	gitProvider, _, err := s.GetGitProviderForUrl(ctx, repo.Url)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	if !supportsPrBot(gitProvider) {
+		return nil
+	}
+
+	return s.upsertPrBotComment(gitProvider, repo, &buildState)
+}
+
+// Pull request comments are only implemented by these providers
+func supportsPrBot(gitProvider gitprovider.GitProvider) bool {
+	switch gitProvider.(type) {
+	case *gitprovider.GitHubGitProvider, *gitprovider.GitLabGitProvider:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *GitProviderService) upsertPrBotComment(gitProvider gitprovider.GitProvider, repo *gitprovider.GitRepository, buildState *models.JobState) error {
+	// The workspace is created from the head of the pull request which might be in a fork
+	prContext, err := gitProvider.GetPrContext(&gitprovider.StaticGitContext{
+		Id:       repo.Id,
+		Url:      repo.Url,
+		Name:     repo.Name,
+		Owner:    repo.Owner,
+		Source:   repo.Source,
+		PrNumber: repo.PrNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get pull request context: %s", err.Error())
+	}
+
+	if buildState != nil {
+		headSha, err := gitProvider.GetLastCommitSha(prContext)
+		if err != nil {
+			return fmt.Errorf("failed to get pull request head: %s", err.Error())
+		}
+
+		if headSha != repo.Sha {
+			log.Debugf("skipping comment update for outdated build of pull request %d", *repo.PrNumber)
+			return nil
+		}
+	}
+
+	// The link opens the branch of the pull request
+	prUrl := gitProvider.GetUrlFromContext(&gitprovider.GetRepositoryContext{
+		Url:    prContext.Url,
+		Branch: prContext.Branch,
+	})
+	workspaceLink := strings.ReplaceAll(s.prBotLinkTemplate, "{url}", url.QueryEscape(prUrl))
+
+	body := getPrBotCommentBody(workspaceLink, repo.Sha, buildState)
+
+	commentId, err := gitProvider.GetPrComment(repo, *repo.PrNumber, prBotCommentMarker)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request comment: %s", err.Error())
+	}
+
+	if commentId == nil {
+		_, err = gitProvider.CreatePrComment(repo, *repo.PrNumber, body)
+		if err != nil {
+			return fmt.Errorf("failed to create pull request comment: %s", err.Error())
+		}
+		return nil
+	}
+
+	err = gitProvider.UpdatePrComment(repo, *repo.PrNumber, *commentId, body)
+	if err != nil {
+		return fmt.Errorf("failed to update pull request comment: %s", err.Error())
+	}
+
+	return nil
+}
+
+func getPrBotCommentBody(workspaceLink string, sha string, buildState *models.JobState) string {
+	var sb strings.Builder
+
+	sb.WriteString(prBotCommentMarker + "\n")
+	sb.WriteString(fmt.Sprintf("### [Open in Daytona](%s)\n\n", workspaceLink))
+	sb.WriteString("Create a workspace for this pull request with a single click.\n")
+
+	if buildState == nil {
+		return sb.String()
+	}
+
+	shortSha := sha
+	if len(shortSha) > 7 {
+		shortSha = shortSha[:7]
+	}
+
+	if *buildState == models.JobStateSuccess {
+		sb.WriteString(fmt.Sprintf("\nPrebuild for `%s` is ready, the workspace will be created from the prebuilt image.\n", shortSha))
+	} else {
+		sb.WriteString(fmt.Sprintf("\nPrebuild for `%s` failed, the workspace will be built on creation.\n", shortSha))
+	}
+
+	return sb.String()
+}
@@ -5,13 +5,20 @@ package gitproviders
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
 
 func (s *GitProviderService) GetPrebuildWebhook(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (*string, error) {
-	gitProvider, err := s.GetGitProvider(ctx, gitProviderId)
+	providerConfig, err := s.configStore.Find(ctx, gitProviderId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	gitProvider, err := s.newGitProvider(providerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
 	}
@@ -21,20 +28,57 @@ func (s *GitProviderService) GetPrebuildWebhook(ctx context.Context, gitProvider
 		return nil, fmt.Errorf("failed to get webhook: %s", err.Error())
 	}
 
+	// Events of webhooks registered without a secret can not be verified so the webhook is replaced
+	if id != nil {
+		if _, ok := providerConfig.WebhookSecrets[*id]; !ok {
+			err = gitProvider.UnregisterPrebuildWebhook(repo, *id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unregister unsigned webhook: %s", err.Error())
+			}
+
+			return nil, nil
+		}
+	}
+
 	return id, nil
 }
 
 func (s *GitProviderService) RegisterPrebuildWebhook(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error) {
-	gitProvider, err := s.GetGitProvider(ctx, gitProviderId)
+	providerConfig, err := s.configStore.Find(ctx, gitProviderId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	gitProvider, err := s.newGitProvider(providerConfig)
 	if err != nil {
 		return "", fmt.Errorf("failed to get git provider: %s", err.Error())
 	}
 
-	id, err := gitProvider.RegisterPrebuildWebhook(repo, endpointUrl)
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %s", err.Error())
+	}
+
+	id, err := gitProvider.RegisterPrebuildWebhook(repo, endpointUrl, secret)
 	if err != nil {
 		return "", fmt.Errorf("failed to register webhook: %s", err.Error())
 	}
 
+	if providerConfig.WebhookSecrets == nil {
+		providerConfig.WebhookSecrets = map[string]string{}
+	}
+	providerConfig.WebhookSecrets[id] = secret
+
+	err = s.configStore.Save(ctx, providerConfig)
+	if err != nil {
+		unregisterErr := gitProvider.UnregisterPrebuildWebhook(repo, id)
+		if unregisterErr != nil {
+			return "", fmt.Errorf("failed to save webhook secret: %s, failed to unregister webhook: %s", err.Error(), unregisterErr.Error())
+		}
+
+		return "", fmt.Errorf("failed to save webhook secret: %s", err.Error())
+	}
+
 	return id, nil
 }
 
@@ -49,5 +93,23 @@ func (s *GitProviderService) UnregisterPrebuildWebhook(ctx context.Context, gitP
 		return fmt.Errorf("failed to unregister webhook: %s", err.Error())
 	}
 
-	return nil
+	providerConfig, err := s.configStore.Find(ctx, gitProviderId)
+	if err != nil || providerConfig.WebhookSecrets == nil {
+		return nil
+	}
+
+	delete(providerConfig.WebhookSecrets, id)
+
+	return s.configStore.Save(ctx, providerConfig)
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
@@ -17,7 +18,10 @@ import (
 )
 
 type GitProviderServiceConfig struct {
-	ConfigStore stores.GitProviderConfigStore
+	ConfigStore       stores.GitProviderConfigStore
+	PrBotEnabled      bool
+	PrBotLinkTemplate string
+	BuildLinkTemplate string
 
 	DetachWorkspaceTemplates func(ctx context.Context, gitProviderConfigId string) error
 	TrackTelemetryEvent      func(event telemetry.Event, clientId string) error
@@ -25,13 +29,20 @@ type GitProviderServiceConfig struct {
 
 type GitProviderService struct {
 	configStore              stores.GitProviderConfigStore
+	prBotEnabled             bool
+	prBotLinkTemplate        string
+	buildLinkTemplate        string
 	detachWorkspaceTemplates func(ctx context.Context, gitProviderConfigId string) error
 	trackTelemetryEvent      func(event telemetry.Event, clientId string) error
+	tokenRefreshMutex        sync.Mutex
 }
 
 func NewGitProviderService(config GitProviderServiceConfig) services.IGitProviderService {
 	return &GitProviderService{
 		configStore:              config.ConfigStore,
+		prBotEnabled:             config.PrBotEnabled,
+		prBotLinkTemplate:        config.PrBotLinkTemplate,
+		buildLinkTemplate:        config.BuildLinkTemplate,
 		detachWorkspaceTemplates: config.DetachWorkspaceTemplates,
 		trackTelemetryEvent:      config.TrackTelemetryEvent,
 	}
@@ -54,6 +65,11 @@ func (s *GitProviderService) GetGitProvider(ctx context.Context, id string) (git
 		} else {
 			return nil, err
 		}
+	} else {
+		err = s.refreshTokenIfExpired(ctx, providerConfig)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return s.newGitProvider(providerConfig)
@@ -168,6 +184,8 @@ func (s *GitProviderService) newGitProvider(config *models.GitProviderConfig) (g
 		return gitprovider.NewGogsGitProvider(config.Token, baseApiUrl), nil
 	case "gitee":
 		return gitprovider.NewGiteeGitProvider(config.Token), nil
+	case "generic":
+		return gitprovider.NewGenericGitProvider(config.Username, config.Token, baseApiUrl), nil
 	default:
 		return nil, errors.New("git provider not found")
 	}
@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/models"
+)
+
+// Tokens are refreshed ahead of their expiry so they stay valid while in use
+const tokenRefreshMargin = 5 * time.Minute
+
+// Refreshes the OAuth token of the config if it is about to expire and persists the new token
+func (s *GitProviderService) refreshTokenIfExpired(ctx context.Context, providerConfig *models.GitProviderConfig) error {
+	if !tokenNeedsRefresh(providerConfig) {
+		return nil
+	}
+
+	s.tokenRefreshMutex.Lock()
+	defer s.tokenRefreshMutex.Unlock()
+
+	// Another request might have refreshed the token while waiting for the lock
+	latestConfig, err := s.configStore.Find(ctx, providerConfig.Id)
+	if err != nil {
+		return err
+	}
+	if !tokenNeedsRefresh(latestConfig) {
+		*providerConfig = *latestConfig
+		return nil
+	}
+
+	endpoints, err := gitprovider.GetOAuthEndpoints(latestConfig.ProviderId, latestConfig.BaseApiUrl)
+	if err != nil {
+		return err
+	}
+
+	token, err := gitprovider.RefreshOAuthToken(ctx, endpoints, *latestConfig.OAuthClientId, *latestConfig.RefreshToken)
+	if err != nil {
+		return fmt.Errorf("failed to refresh token for git provider %s: %w", latestConfig.Alias, err)
+	}
+
+	latestConfig.Token = token.AccessToken
+	latestConfig.RefreshToken = token.RefreshToken
+	latestConfig.TokenExpiresAt = token.ExpiresAt
+
+	// The refreshed token replaces the one the last health check reported on
+	tokenStatus := &models.TokenStatus{
+		Valid:     true,
+		ExpiresAt: token.ExpiresAt,
+		CheckedAt: time.Now(),
+	}
+	if latestConfig.TokenStatus != nil {
+		tokenStatus.Scopes = latestConfig.TokenStatus.Scopes
+	}
+	latestConfig.TokenStatus = tokenStatus
+
+	err = s.configStore.Save(ctx, latestConfig)
+	if err != nil {
+		return err
+	}
+
+	*providerConfig = *latestConfig
+	return nil
+}
+
+func tokenNeedsRefresh(providerConfig *models.GitProviderConfig) bool {
+	if providerConfig.OAuthClientId == nil || providerConfig.RefreshToken == nil || providerConfig.TokenExpiresAt == nil {
+		return false
+	}
+
+	return time.Now().Add(tokenRefreshMargin).After(*providerConfig.TokenExpiresAt)
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"context"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	log "github.com/sirupsen/logrus"
+)
+
+// 1 hour interval
+const DEFAULT_TOKEN_HEALTH_POLL_INTERVAL = "0 0 */1 * * *"
+
+// Tokens expiring within this period are reported as expiring soon
+const TokenExpiryWarningPeriod = 7 * 24 * time.Hour
+
+func (s *GitProviderService) StartTokenHealthPoller(ctx context.Context) error {
+	scheduler := scheduler.NewCronScheduler()
+
+	err := scheduler.AddFunc(DEFAULT_TOKEN_HEALTH_POLL_INTERVAL, func() {
+		err := s.CheckTokenHealth(ctx)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Configs saved before the server was upgraded have no status until the first check
+	go func() {
+		err := s.CheckTokenHealth(ctx)
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
+	scheduler.Start()
+	return nil
+}
+
+// Checks the token of every git provider config and records the result in the config
+func (s *GitProviderService) CheckTokenHealth(ctx context.Context) error {
+	gitProviders, err := s.configStore.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range gitProviders {
+		tokenStatus := s.getTokenStatus(ctx, p.Id)
+
+		if !tokenStatus.Valid {
+			log.Warnf("token of git provider %s is not valid: %s", p.Alias, *tokenStatus.Error)
+		} else if tokenStatus.ExpiresAt != nil && time.Until(*tokenStatus.ExpiresAt) < TokenExpiryWarningPeriod {
+			log.Warnf("token of git provider %s expires at %s", p.Alias, tokenStatus.ExpiresAt.Format(time.RFC1123))
+		}
+
+		// The config is reloaded since the token might have been refreshed
+		providerConfig, err := s.configStore.Find(ctx, p.Id)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		providerConfig.TokenStatus = tokenStatus
+		err = s.configStore.Save(ctx, providerConfig)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (s *GitProviderService) getTokenStatus(ctx context.Context, gitProviderId string) *models.TokenStatus {
+	gitProvider, err := s.GetGitProvider(ctx, gitProviderId)
+	if err != nil {
+		return newInvalidTokenStatus(err)
+	}
+
+	_, err = gitProvider.GetUser()
+	if err != nil {
+		return newInvalidTokenStatus(err)
+	}
+
+	return newValidTokenStatus(gitProvider)
+}
+
+func newValidTokenStatus(gitProvider gitprovider.GitProvider) *models.TokenStatus {
+	tokenStatus := &models.TokenStatus{
+		Valid:     true,
+		CheckedAt: time.Now(),
+	}
+
+	tokenInfo, err := gitProvider.GetTokenInfo()
+	if err != nil {
+		log.Debugf("failed to get token info: %s", err)
+	} else if tokenInfo != nil {
+		tokenStatus.Scopes = tokenInfo.Scopes
+		tokenStatus.ExpiresAt = tokenInfo.ExpiresAt
+	}
+
+	return tokenStatus
+}
+
+func newInvalidTokenStatus(err error) *models.TokenStatus {
+	errMessage := err.Error()
+
+	return &models.TokenStatus{
+		Valid:     false,
+		Error:     &errMessage,
+		CheckedAt: time.Now(),
+	}
+}
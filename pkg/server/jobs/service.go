@@ -24,6 +24,8 @@ type JobServiceConfig struct {
 	UpdateWorkspaceLastJob func(ctx context.Context, workspaceId string, jobId string) error
 	UpdateTargetLastJob    func(ctx context.Context, targetId string, jobId string) error
 	UpdateBuildLastJob     func(ctx context.Context, buildId string, jobId string) error
+	// Called in the background after the state of a build job is updated
+	HandleBuildJobStateUpdate func(ctx context.Context, job *models.Job)
 }
 
 type JobService struct {
@@ -33,6 +35,8 @@ type JobService struct {
 	updateWorkspaceLastJob func(ctx context.Context, workspaceId string, jobId string) error
 	updateTargetLastJob    func(ctx context.Context, targetId string, jobId string) error
 	updateBuildLastJob     func(ctx context.Context, buildId string, jobId string) error
+
+	handleBuildJobStateUpdate func(ctx context.Context, job *models.Job)
 }
 
 func NewJobService(config JobServiceConfig) services.IJobService {
@@ -42,6 +46,8 @@ func NewJobService(config JobServiceConfig) services.IJobService {
 		updateWorkspaceLastJob: config.UpdateWorkspaceLastJob,
 		updateTargetLastJob:    config.UpdateTargetLastJob,
 		updateBuildLastJob:     config.UpdateBuildLastJob,
+
+		handleBuildJobStateUpdate: config.HandleBuildJobStateUpdate,
 	}
 }
 
@@ -108,6 +114,9 @@ func (s *JobService) UpdateState(ctx context.Context, jobId string, updateJobSta
 
 	job.State = updateJobStateDto.State
 	job.Error = updateJobStateDto.ErrorMessage
+	if job.RunnerId == nil && updateJobStateDto.RunnerId != nil {
+		job.RunnerId = updateJobStateDto.RunnerId
+	}
 
 	err = s.jobStore.Save(ctx, job)
 	if err != nil {
@@ -127,7 +136,17 @@ func (s *JobService) UpdateState(ctx context.Context, jobId string, updateJobSta
 		return s.jobStore.RollbackTransaction(ctx, err)
 	}
 
-	return s.jobStore.CommitTransaction(ctx)
+	err = s.jobStore.CommitTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The handler may call external services so the update request is not blocked by it
+	if job.ResourceType == models.ResourceTypeBuild && s.handleBuildJobStateUpdate != nil {
+		go s.handleBuildJobStateUpdate(context.Background(), job)
+	}
+
+	return nil
 }
 
 func (s *JobService) Delete(ctx context.Context, j *models.Job) error {
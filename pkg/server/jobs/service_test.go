@@ -6,6 +6,7 @@ package jobs_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	job_internal "github.com/daytonaio/daytona/internal/testing/job"
 	"github.com/daytonaio/daytona/pkg/models"
@@ -165,3 +166,84 @@ func (s *JobServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedJobs, jobs)
 }
+
+func (s *JobServiceTestSuite) TestUpdateStateHandlesBuildJob() {
+	require := s.Require()
+
+	handledJobs := make(chan *models.Job, 1)
+	jobService := jobs.NewJobService(jobs.JobServiceConfig{
+		JobStore: s.jobStore,
+		UpdateBuildLastJob: func(ctx context.Context, buildId string, jobId string) error {
+			return nil
+		},
+		HandleBuildJobStateUpdate: func(ctx context.Context, job *models.Job) {
+			handledJobs <- job
+		},
+	})
+
+	buildJob := &models.Job{
+		Id:           "6",
+		ResourceId:   "build",
+		ResourceType: models.ResourceTypeBuild,
+		Action:       models.JobActionRun,
+		State:        models.JobStatePending,
+	}
+
+	err := jobService.Create(context.TODO(), buildJob)
+	require.Nil(err)
+
+	err = jobService.UpdateState(context.TODO(), buildJob.Id, services.UpdateJobStateDTO{
+		State: models.JobStateRunning,
+	})
+	require.Nil(err)
+
+	select {
+	case job := <-handledJobs:
+		require.Equal(buildJob.Id, job.Id)
+		require.Equal(models.JobStateRunning, job.State)
+	case <-time.After(time.Second):
+		require.Fail("build job state update was not handled")
+	}
+}
+
+func (s *JobServiceTestSuite) TestUpdateStateClaimsUnassignedJob() {
+	require := s.Require()
+
+	jobService := jobs.NewJobService(jobs.JobServiceConfig{
+		JobStore: s.jobStore,
+		UpdateBuildLastJob: func(ctx context.Context, buildId string, jobId string) error {
+			return nil
+		},
+	})
+
+	buildJob := &models.Job{
+		Id:           "7",
+		ResourceId:   "build",
+		ResourceType: models.ResourceTypeBuild,
+		Action:       models.JobActionRun,
+		State:        models.JobStatePending,
+	}
+
+	err := jobService.Create(context.TODO(), buildJob)
+	require.Nil(err)
+
+	runnerId := "runner"
+	err = jobService.UpdateState(context.TODO(), buildJob.Id, services.UpdateJobStateDTO{
+		State:    models.JobStateRunning,
+		RunnerId: &runnerId,
+	})
+	require.Nil(err)
+
+	otherRunnerId := "other-runner"
+	err = jobService.UpdateState(context.TODO(), buildJob.Id, services.UpdateJobStateDTO{
+		State:    models.JobStateSuccess,
+		RunnerId: &otherRunnerId,
+	})
+	require.Nil(err)
+
+	updated, err := jobService.Find(context.TODO(), &stores.JobFilter{
+		Id: &buildJob.Id,
+	})
+	require.Nil(err)
+	require.Equal(runnerId, *updated.RunnerId)
+}
@@ -0,0 +1,291 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+}
+
+type descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    *descriptor  `json:"config,omitempty"`
+	Layers    []descriptor `json:"layers,omitempty"`
+	Manifests []descriptor `json:"manifests,omitempty"`
+}
+
+// registryClient talks to a registry over the Docker Registry HTTP API V2
+type registryClient struct {
+	baseUrl    string
+	username   string
+	password   string
+	httpClient *http.Client
+	token      string
+}
+
+func newRegistryClient(baseUrl, username, password string) *registryClient {
+	return &registryClient{
+		baseUrl:  strings.TrimSuffix(baseUrl, "/"),
+		username: username,
+		password: password,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+func (c *registryClient) listRepositories() ([]string, error) {
+	repositories := []string{}
+	path := "/v2/_catalog?n=1000"
+
+	for path != "" {
+		var catalog struct {
+			Repositories []string `json:"repositories"`
+		}
+
+		res, err := c.do(http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&catalog)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		repositories = append(repositories, catalog.Repositories...)
+		path = nextPagePath(res.Header.Get("Link"))
+	}
+
+	return repositories, nil
+}
+
+func (c *registryClient) listTags(repository string) ([]string, error) {
+	var tagList struct {
+		Tags []string `json:"tags"`
+	}
+
+	res, err := c.do(http.MethodGet, fmt.Sprintf("/v2/%s/tags/list", repository), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&tagList)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagList.Tags, nil
+}
+
+// getManifest returns the digest of the manifest referenced by reference along with the descriptors of all
+// the blobs it references. The blobs of the platform manifests of an image index are included.
+func (c *registryClient) getManifest(repository, reference string) (string, []descriptor, error) {
+	res, err := c.do(http.MethodGet, fmt.Sprintf("/v2/%s/manifests/%s", repository, reference), map[string]string{
+		"Accept": strings.Join(manifestMediaTypes, ", "),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	defer res.Body.Close()
+
+	var m manifest
+	err = json.NewDecoder(res.Body).Decode(&m)
+	if err != nil {
+		return "", nil, err
+	}
+
+	digest := res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", nil, fmt.Errorf("registry did not return a digest for %s:%s", repository, reference)
+	}
+
+	blobs := []descriptor{}
+	if m.Config != nil {
+		blobs = append(blobs, *m.Config)
+	}
+	blobs = append(blobs, m.Layers...)
+
+	for _, child := range m.Manifests {
+		_, childBlobs, err := c.getManifest(repository, child.Digest)
+		if err != nil {
+			return "", nil, err
+		}
+		blobs = append(blobs, childBlobs...)
+	}
+
+	return digest, blobs, nil
+}
+
+func (c *registryClient) deleteManifest(repository, digest string) error {
+	res, err := c.do(http.MethodDelete, fmt.Sprintf("/v2/%s/manifests/%s", repository, digest), nil)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+// do sends a request to the registry, authenticating with a bearer token when the registry asks for one
+func (c *registryClient) do(method, path string, headers map[string]string) (*http.Response, error) {
+	res, err := c.send(method, path, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusUnauthorized && strings.HasPrefix(res.Header.Get("WWW-Authenticate"), "Bearer ") {
+		res.Body.Close()
+
+		c.token, err = c.getToken(res.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return nil, err
+		}
+
+		res, err = c.send(method, path, headers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res.StatusCode >= 300 {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("registry request %s %s failed with status %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return res, nil
+}
+
+func (c *registryClient) send(method, path string, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.baseUrl+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+func (c *registryClient) getToken(challenge string) (string, error) {
+	params := parseChallenge(strings.TrimPrefix(challenge, "Bearer "))
+
+	realm, ok := params["realm"]
+	if !ok {
+		return "", errors.New("registry authentication challenge has no realm")
+	}
+
+	query := url.Values{}
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := params[key]; ok {
+			query.Set(key, value)
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get registry token: status %d", res.StatusCode)
+	}
+
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&tokenResponse)
+	if err != nil {
+		return "", err
+	}
+
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+
+	return tokenResponse.AccessToken, nil
+}
+
+// parseChallenge parses the key="value" pairs of a WWW-Authenticate header
+func parseChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+
+	for challenge != "" {
+		key, rest, found := strings.Cut(challenge, "=")
+		if !found {
+			break
+		}
+		key = strings.TrimSpace(key)
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+			rest = strings.TrimPrefix(rest, ",")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		params[key] = value
+		challenge = strings.TrimSpace(rest)
+	}
+
+	return params
+}
+
+// nextPagePath returns the path of the next page from a Link header like </v2/_catalog?last=b&n=100>; rel="next"
+func nextPagePath(link string) string {
+	if link == "" {
+		return ""
+	}
+
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start == -1 || end <= start {
+		return ""
+	}
+
+	return link[start+1 : end]
+}
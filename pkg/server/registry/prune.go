@@ -0,0 +1,167 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/services"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Build images are pushed to <namespace>/w-<repository hash>:<build hash>
+const buildImageRepositoryPrefix = "w-"
+
+type PruneConfig struct {
+	// Base URL of the registry API, e.g. https://registry.example.com
+	RegistryUrl string
+	Username    string
+	Password    string
+	// Namespace the build images are pushed to
+	Namespace string
+	// Images that must be kept, with or without the registry server
+	ReferencedImages []string
+	DryRun           bool
+}
+
+type taggedManifest struct {
+	repository string
+	tag        string
+	digest     string
+	blobs      []descriptor
+}
+
+// PruneBuildImages deletes the build image tags of the registry that are not referenced.
+// The reclaimed space is the size of the blobs only used by the deleted tags; blob storage is
+// only freed once the registry garbage collector runs.
+func PruneBuildImages(config PruneConfig) (*services.BuildPruneResult, error) {
+	client := newRegistryClient(config.RegistryUrl, config.Username, config.Password)
+
+	referenced := map[string]bool{}
+	for _, image := range config.ReferencedImages {
+		referenced[stripRegistryServer(image)] = true
+		// The layer cache is kept as long as its build image is
+		referenced[stripRegistryServer(models.GetBuildCacheImage(image))] = true
+	}
+
+	repositories, err := client.listRepositories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registry repositories: %w", err)
+	}
+
+	var kept, unreferenced []taggedManifest
+
+	for _, repository := range repositories {
+		if !isBuildImageRepository(repository, config.Namespace) {
+			continue
+		}
+
+		tags, err := client.listTags(repository)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of %s: %w", repository, err)
+		}
+
+		for _, tag := range tags {
+			digest, blobs, err := client.getManifest(repository, tag)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get manifest of %s:%s: %w", repository, tag, err)
+			}
+
+			m := taggedManifest{
+				repository: repository,
+				tag:        tag,
+				digest:     digest,
+				blobs:      blobs,
+			}
+
+			if referenced[fmt.Sprintf("%s:%s", repository, tag)] {
+				kept = append(kept, m)
+			} else {
+				unreferenced = append(unreferenced, m)
+			}
+		}
+	}
+
+	keptDigests := map[string]bool{}
+	keptBlobs := map[string]bool{}
+	for _, m := range kept {
+		keptDigests[m.repository+"@"+m.digest] = true
+		for _, blob := range m.blobs {
+			keptBlobs[blob.Digest] = true
+		}
+	}
+
+	// Shared blobs are attributed to the first image in name order
+	sort.Slice(unreferenced, func(i, j int) bool {
+		if unreferenced[i].repository != unreferenced[j].repository {
+			return unreferenced[i].repository < unreferenced[j].repository
+		}
+		return unreferenced[i].tag < unreferenced[j].tag
+	})
+
+	result := &services.BuildPruneResult{
+		Images: []services.PrunedBuildImage{},
+		DryRun: config.DryRun,
+	}
+	reclaimedBlobs := map[string]bool{}
+	deletedDigests := map[string]bool{}
+
+	for _, m := range unreferenced {
+		// Deleting a manifest removes every tag pointing to it
+		if keptDigests[m.repository+"@"+m.digest] {
+			continue
+		}
+
+		var size int64
+		for _, blob := range m.blobs {
+			if keptBlobs[blob.Digest] || reclaimedBlobs[blob.Digest] {
+				continue
+			}
+			reclaimedBlobs[blob.Digest] = true
+			size += blob.Size
+		}
+
+		if !config.DryRun && !deletedDigests[m.repository+"@"+m.digest] {
+			err := client.deleteManifest(m.repository, m.digest)
+			if err != nil {
+				log.Errorf("Failed to delete %s:%s from the registry: %s", m.repository, m.tag, err)
+				continue
+			}
+			deletedDigests[m.repository+"@"+m.digest] = true
+		}
+
+		result.Images = append(result.Images, services.PrunedBuildImage{
+			Image: fmt.Sprintf("%s:%s", m.repository, m.tag),
+			Size:  size,
+		})
+		result.ReclaimedSpace += size
+	}
+
+	return result, nil
+}
+
+func isBuildImageRepository(repository, namespace string) bool {
+	namespace = strings.Trim(namespace, "/")
+
+	prefix := buildImageRepositoryPrefix
+	if namespace != "" {
+		prefix = namespace + "/" + buildImageRepositoryPrefix
+	}
+
+	return strings.HasPrefix(repository, prefix) && !strings.Contains(strings.TrimPrefix(repository, prefix), "/")
+}
+
+// stripRegistryServer returns the repository and tag of an image name, e.g. registry.example.com/ns/w-abc:tag -> ns/w-abc:tag
+func stripRegistryServer(image string) string {
+	server, rest, found := strings.Cut(image, "/")
+	if found && (strings.ContainsAny(server, ".:") || server == "localhost") {
+		return rest
+	}
+
+	return image
+}
@@ -0,0 +1,141 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/services"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeManifest struct {
+	digest string
+	layers map[string]int64
+}
+
+type fakeRegistry struct {
+	// repository -> tag -> manifest
+	tags    map[string]map[string]fakeManifest
+	deleted []string
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+
+	if path == "_catalog" {
+		repositories := []string{}
+		for repository := range r.tags {
+			repositories = append(repositories, repository)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"repositories": repositories})
+		return
+	}
+
+	if repository, found := strings.CutSuffix(path, "/tags/list"); found {
+		tags := []string{}
+		for tag := range r.tags[repository] {
+			tags = append(tags, tag)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"tags": tags})
+		return
+	}
+
+	repository, reference, found := strings.Cut(path, "/manifests/")
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if req.Method == http.MethodDelete {
+		r.deleted = append(r.deleted, fmt.Sprintf("%s@%s", repository, reference))
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	m, ok := r.tags[repository][reference]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	layers := []map[string]interface{}{}
+	for digest, size := range m.layers {
+		layers = append(layers, map[string]interface{}{"digest": digest, "size": size})
+	}
+
+	w.Header().Set("Docker-Content-Digest", m.digest)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+		"layers":    layers,
+	})
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		tags: map[string]map[string]fakeManifest{
+			"daytona/w-repo1": {
+				"kept":       {digest: "sha256:kept", layers: map[string]int64{"sha256:base": 100, "sha256:kept": 10}},
+				"kept-cache": {digest: "sha256:kept-cache", layers: map[string]int64{"sha256:base": 100, "sha256:kept-cache": 15}},
+				"old":        {digest: "sha256:old", layers: map[string]int64{"sha256:base": 100, "sha256:old": 20}},
+				"alias":      {digest: "sha256:kept", layers: map[string]int64{"sha256:base": 100, "sha256:kept": 10}},
+				"old-too":    {digest: "sha256:old", layers: map[string]int64{"sha256:base": 100, "sha256:old": 20}},
+			},
+			"daytona/w-repo2": {
+				"stale": {digest: "sha256:stale", layers: map[string]int64{"sha256:stale": 30}},
+			},
+			"daytona/other": {
+				"latest": {digest: "sha256:other", layers: map[string]int64{"sha256:other": 40}},
+			},
+		},
+	}
+}
+
+func TestPruneBuildImages(t *testing.T) {
+	fake := newFakeRegistry()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	result, err := registry.PruneBuildImages(registry.PruneConfig{
+		RegistryUrl:      srv.URL,
+		Namespace:        "/daytona",
+		ReferencedImages: []string{"registry.example.com/daytona/w-repo1:kept"},
+	})
+	require.Nil(t, err)
+
+	require.Equal(t, []services.PrunedBuildImage{
+		{Image: "daytona/w-repo1:old", Size: 20},
+		{Image: "daytona/w-repo1:old-too", Size: 0},
+		{Image: "daytona/w-repo2:stale", Size: 30},
+	}, result.Images)
+	require.Equal(t, int64(50), result.ReclaimedSpace)
+	require.ElementsMatch(t, []string{"daytona/w-repo1@sha256:old", "daytona/w-repo2@sha256:stale"}, fake.deleted)
+}
+
+func TestPruneBuildImagesDryRun(t *testing.T) {
+	fake := newFakeRegistry()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	result, err := registry.PruneBuildImages(registry.PruneConfig{
+		RegistryUrl:      srv.URL,
+		Namespace:        "/daytona",
+		ReferencedImages: []string{"daytona/w-repo1:kept"},
+		DryRun:           true,
+	})
+	require.Nil(t, err)
+
+	require.True(t, result.DryRun)
+	require.Len(t, result.Images, 3)
+	require.Equal(t, int64(50), result.ReclaimedSpace)
+	require.Empty(t, fake.deleted)
+}
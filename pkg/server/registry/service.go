@@ -100,36 +100,14 @@ func (s *LocalContainerRegistry) Start() error {
 		return err
 	}
 
-	//	todo: enable TLS
-	resp, err := cli.ContainerCreate(ctx, &container.Config{
-		Image: s.image,
-		Env: []string{
-			fmt.Sprintf("REGISTRY_HTTP_ADDR=0.0.0.0:%d", s.port),
-		},
-		ExposedPorts: nat.PortSet{
-			nat.Port(fmt.Sprintf("%d/tcp", s.port)): {},
-		},
-	}, &container.HostConfig{
-		Privileged: true,
-		Binds: []string{
-			s.dataPath + ":/var/lib/registry",
-		},
-		PortBindings: nat.PortMap{
-			nat.Port(fmt.Sprintf("%d/tcp", s.port)): []nat.PortBinding{
-				{
-					HostIP:   "0.0.0.0",
-					HostPort: fmt.Sprint(s.port),
-				},
-			},
-		},
-	}, nil, nil, registryContainerName)
+	containerId, err := s.createContainer(ctx, cli, false)
 	if err != nil {
 		return err
 	}
 
 	errChan := make(chan error)
 	go func() {
-		errChan <- cli.ContainerStart(ctx, resp.ID, container.StartOptions{})
+		errChan <- cli.ContainerStart(ctx, containerId, container.StartOptions{})
 	}()
 
 	if s.frps == nil {
@@ -177,6 +155,100 @@ func (s *LocalContainerRegistry) Purge() error {
 	return os.RemoveAll(s.dataPath)
 }
 
+// GarbageCollect frees the storage of the blobs that are no longer referenced by a tagged manifest.
+// The registry is restarted in read-only mode for the run so concurrent pushes are rejected
+// instead of losing the blobs they uploaded.
+func (s *LocalContainerRegistry) GarbageCollect() error {
+	ctx := context.Background()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	err = s.restartContainer(ctx, cli, true)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err := s.restartContainer(ctx, cli, false)
+		if err != nil {
+			log.Errorf("failed to restart the local container registry after garbage collection: %s", err)
+		}
+	}()
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	result, err := dockerClient.ExecSync(registryContainerName, container.ExecOptions{
+		Cmd: []string{"registry", "garbage-collect", "/etc/docker/registry/config.yml", "--delete-untagged"},
+	}, s.logger)
+	if err != nil {
+		return err
+	}
+
+	if result.ExitCode != 0 {
+		return fmt.Errorf("registry garbage collection failed with exit code %d: %s", result.ExitCode, result.StdErr)
+	}
+
+	return nil
+}
+
+// restartContainer replaces the registry container with a new one serving the same data
+func (s *LocalContainerRegistry) restartContainer(ctx context.Context, cli *client.Client, readOnly bool) error {
+	err := DeleteRegistryContainer()
+	if err != nil {
+		return err
+	}
+
+	containerId, err := s.createContainer(ctx, cli, readOnly)
+	if err != nil {
+		return err
+	}
+
+	return cli.ContainerStart(ctx, containerId, container.StartOptions{})
+}
+
+func (s *LocalContainerRegistry) createContainer(ctx context.Context, cli *client.Client, readOnly bool) (string, error) {
+	env := []string{
+		fmt.Sprintf("REGISTRY_HTTP_ADDR=0.0.0.0:%d", s.port),
+		// Required to prune unreferenced build images
+		"REGISTRY_STORAGE_DELETE_ENABLED=true",
+	}
+	if readOnly {
+		env = append(env, `REGISTRY_STORAGE_MAINTENANCE_READONLY={"enabled":true}`)
+	}
+
+	//	todo: enable TLS
+	resp, err := cli.ContainerCreate(ctx, &container.Config{
+		Image: s.image,
+		Env:   env,
+		ExposedPorts: nat.PortSet{
+			nat.Port(fmt.Sprintf("%d/tcp", s.port)): {},
+		},
+	}, &container.HostConfig{
+		Privileged: true,
+		Binds: []string{
+			s.dataPath + ":/var/lib/registry",
+		},
+		PortBindings: nat.PortMap{
+			nat.Port(fmt.Sprintf("%d/tcp", s.port)): []nat.PortBinding{
+				{
+					HostIP:   "0.0.0.0",
+					HostPort: fmt.Sprint(s.port),
+				},
+			},
+		},
+	}, nil, nil, registryContainerName)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.ID, nil
+}
+
 func DeleteRegistryContainer() error {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -26,6 +26,7 @@ type ILocalContainerRegistry interface {
 	Start() error
 	Stop() error
 	Purge() error
+	GarbageCollect() error
 }
 
 type FRPSConfig struct {
@@ -56,4 +57,10 @@ type Config struct {
 	BuildImageNamespace       string              `json:"buildImageNamespace" validate:"optional"`
 	LocalRunnerDisabled       *bool               `json:"localRunnerDisabled" validate:"optional"`
 	SamplesIndexUrl           string              `json:"samplesIndexUrl" validate:"optional"`
+	// Comments a workspace creation link on pull requests of repositories with prebuilds
+	PrBotEnabled *bool `json:"prBotEnabled,omitempty" validate:"optional"`
+	// URL of the page that creates a workspace from the pull request, {url} is replaced with the escaped URL of the pull request branch
+	PrBotLinkTemplate *string `json:"prBotLinkTemplate,omitempty" validate:"optional"`
+	// URL of the page that shows a build, {buildId} is replaced with the ID of the build. Commit statuses of prebuilds link to it.
+	BuildLinkTemplate *string `json:"buildLinkTemplate,omitempty" validate:"optional"`
 } // @name ServerConfig
@@ -6,9 +6,14 @@ package workspaces
 import (
 	"context"
 	"errors"
+	"fmt"
+	"path"
 	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/daytonaio/daytona/pkg/services"
 	"github.com/daytonaio/daytona/pkg/stores"
@@ -72,6 +77,22 @@ func (s *WorkspaceService) Create(ctx context.Context, req services.CreateWorksp
 		w.Repository.Sha = sha
 	}
 
+	w.Repository.SparsePaths = gitprovider.NormalizeSparsePaths(w.Repository.SparsePaths)
+	// The builder reads the devcontainer configuration from the cloned repository
+	if w.BuildConfig != nil && w.BuildConfig.Devcontainer != nil {
+		w.Repository.SparsePaths = gitprovider.AddSparsePath(w.Repository.SparsePaths, path.Dir(w.BuildConfig.Devcontainer.FilePath))
+	}
+
+	err = validateSparsePaths(w.Repository.SparsePaths)
+	if err != nil {
+		return s.handleCreateError(ctx, w, err)
+	}
+
+	err = s.prepareAdditionalRepositories(ctx, w)
+	if err != nil {
+		return s.handleCreateError(ctx, w, err)
+	}
+
 	if w.BuildConfig != nil {
 		cachedBuild, err := s.findCachedBuild(ctx, w)
 		if err == nil {
@@ -163,6 +184,72 @@ func (s *WorkspaceService) handleCreateError(ctx context.Context, w *models.Work
 	}, err
 }
 
+// prepareAdditionalRepositories validates the clone paths of the additional repositories and resolves their commits
+func (s *WorkspaceService) prepareAdditionalRepositories(ctx context.Context, w *models.Workspace) error {
+	clonePaths := []string{w.WorkspaceFolderName()}
+
+	for _, additionalRepository := range w.AdditionalRepositories {
+		if additionalRepository == nil || additionalRepository.Repository == nil {
+			return errors.New("additional repository is not set")
+		}
+
+		repo := additionalRepository.Repository
+		repo.Url = util.CleanUpRepositoryUrl(repo.Url)
+
+		if additionalRepository.ClonePath == "" {
+			additionalRepository.ClonePath = repo.Name
+		}
+
+		clonePath := path.Clean(strings.ReplaceAll(additionalRepository.ClonePath, "\\", "/"))
+		if path.IsAbs(clonePath) || clonePath == "." || clonePath == ".." || strings.HasPrefix(clonePath, "../") {
+			return fmt.Errorf("%w: %s", services.ErrInvalidClonePath, additionalRepository.ClonePath)
+		}
+
+		// Repositories can not be cloned into each other
+		for _, existingClonePath := range clonePaths {
+			if isSameOrNestedPath(existingClonePath, clonePath) || isSameOrNestedPath(clonePath, existingClonePath) {
+				return fmt.Errorf("%w: %s conflicts with %s", services.ErrInvalidClonePath, clonePath, existingClonePath)
+			}
+		}
+		clonePaths = append(clonePaths, clonePath)
+		additionalRepository.ClonePath = clonePath
+
+		repo.SparsePaths = gitprovider.NormalizeSparsePaths(repo.SparsePaths)
+		err := validateSparsePaths(repo.SparsePaths)
+		if err != nil {
+			return err
+		}
+
+		if repo.Sha == "" {
+			sha, err := s.getLastCommitSha(ctx, repo)
+			if err != nil {
+				return err
+			}
+			repo.Sha = sha
+		}
+	}
+
+	return nil
+}
+
+// validateSparsePaths rejects sparse checkout paths with control or shell special characters.
+// The paths end up in clone commands that are run by a shell.
+func validateSparsePaths(sparsePaths []string) error {
+	for _, sparsePath := range sparsePaths {
+		if strings.ContainsFunc(sparsePath, func(r rune) bool {
+			return unicode.IsControl(r) || strings.ContainsRune("$`\"'\\;&|<>*?[]", r)
+		}) {
+			return fmt.Errorf("%w: %s", services.ErrInvalidSparsePath, sparsePath)
+		}
+	}
+
+	return nil
+}
+
+func isSameOrNestedPath(parent, child string) bool {
+	return parent == child || strings.HasPrefix(child, parent+"/")
+}
+
 func isValidWorkspaceName(name string) bool {
 	// The repository name can only contain ASCII letters, digits, and the characters ., -, and _.
 	var validName = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
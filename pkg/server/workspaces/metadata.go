@@ -5,6 +5,7 @@ package workspaces
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/daytonaio/daytona/pkg/models"
 	"github.com/daytonaio/daytona/pkg/stores"
@@ -17,7 +18,38 @@ func (s *WorkspaceService) UpdateMetadata(ctx context.Context, workspaceId strin
 	}
 
 	m.GitStatus = metadata.GitStatus
+	m.AdditionalGitStatuses = metadata.AdditionalGitStatuses
+	m.LifecycleHooks = metadata.LifecycleHooks
+	m.ForwardPorts = metadata.ForwardPorts
 	m.Uptime = metadata.Uptime
 	m.UpdatedAt = metadata.UpdatedAt
 	return m, s.workspaceMetadataStore.Save(ctx, m)
 }
+
+// updateServices stores the sidecar service states reported in the provider metadata
+func (s *WorkspaceService) updateServices(ctx context.Context, workspaceId, providerMetadata string) error {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal([]byte(providerMetadata), &metadata); err != nil {
+		// Provider metadata is not required to be a JSON object
+		return nil
+	}
+
+	servicesJson, ok := metadata[models.WorkspaceServicesProviderMetadataKey].(string)
+	if !ok {
+		return nil
+	}
+
+	var services []models.WorkspaceService
+	err := json.Unmarshal([]byte(servicesJson), &services)
+	if err != nil {
+		return err
+	}
+
+	m, err := s.workspaceMetadataStore.Find(ctx, workspaceId)
+	if err != nil {
+		return stores.ErrWorkspaceMetadataNotFound
+	}
+
+	m.Services = services
+	return s.workspaceMetadataStore.Save(ctx, m)
+}
@@ -6,6 +6,7 @@ package workspaces
 import (
 	"context"
 	"io"
+	"path/filepath"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
@@ -94,6 +95,15 @@ func (s *WorkspaceService) GetWorkspaceLogWriter(ctx context.Context, workspaceI
 	return s.loggerFactory.CreateLogWriter(workspaceId)
 }
 
+// The toolbox audit log is stored in the log directory of the workspace
+func (s *WorkspaceService) GetToolboxAuditLogReader(ctx context.Context, workspaceId string) (io.Reader, error) {
+	return s.loggerFactory.CreateLogReader(filepath.Join(workspaceId, "toolbox-audit"))
+}
+
+func (s *WorkspaceService) GetToolboxAuditLogWriter(ctx context.Context, workspaceId string) (io.WriteCloser, error) {
+	return s.loggerFactory.CreateLogWriter(filepath.Join(workspaceId, "toolbox-audit"))
+}
+
 func (s *WorkspaceService) UpdateProviderMetadata(ctx context.Context, workspaceId, metadata string) error {
 	w, err := s.workspaceStore.Find(ctx, workspaceId)
 	if err != nil {
@@ -101,7 +111,12 @@ func (s *WorkspaceService) UpdateProviderMetadata(ctx context.Context, workspace
 	}
 
 	w.ProviderMetadata = &metadata
-	return s.workspaceStore.Save(ctx, w)
+	err = s.workspaceStore.Save(ctx, w)
+	if err != nil {
+		return err
+	}
+
+	return s.updateServices(ctx, workspaceId, metadata)
 }
 
 func (s *WorkspaceService) UpdateLastJob(ctx context.Context, workspaceId, jobId string) error {
@@ -237,6 +237,60 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, services.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails when clone paths of additional repositories conflict", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDTO
+		invalidWorkspaceRequest.Id = "456"
+		invalidWorkspaceRequest.Name = "workspace2"
+		invalidWorkspaceRequest.Source = services.CreateWorkspaceSourceDTO{
+			Repository: &gitprovider.GitRepository{
+				Id:     "123",
+				Url:    "https://github.com/daytonaio/daytona",
+				Name:   "daytona",
+				Branch: "main",
+				Sha:    "sha1",
+			},
+			AdditionalRepositories: []*models.WorkspaceRepository{
+				{
+					Repository: &gitprovider.GitRepository{
+						Id:     "456",
+						Url:    "https://github.com/daytonaio/docs",
+						Name:   "docs",
+						Branch: "main",
+						Sha:    "sha2",
+					},
+					ClonePath: "daytona/docs",
+				},
+			},
+		}
+
+		_, err := service.Create(ctx, invalidWorkspaceRequest)
+		require.ErrorIs(t, err, services.ErrInvalidClonePath)
+
+		invalidWorkspaceRequest.Source.AdditionalRepositories[0].ClonePath = "../docs"
+
+		_, err = service.Create(ctx, invalidWorkspaceRequest)
+		require.ErrorIs(t, err, services.ErrInvalidClonePath)
+	})
+
+	t.Run("CreateWorkspace fails when sparse paths contain shell special characters", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDTO
+		invalidWorkspaceRequest.Id = "789"
+		invalidWorkspaceRequest.Name = "workspace3"
+		invalidWorkspaceRequest.Source = services.CreateWorkspaceSourceDTO{
+			Repository: &gitprovider.GitRepository{
+				Id:          "123",
+				Url:         "https://github.com/daytonaio/daytona",
+				Name:        "daytona",
+				Branch:      "main",
+				Sha:         "sha1",
+				SparsePaths: []string{"svc$(touch /tmp/pwned)"},
+			},
+		}
+
+		_, err := service.Create(ctx, invalidWorkspaceRequest)
+		require.ErrorIs(t, err, services.ErrInvalidSparsePath)
+	})
+
 	t.Run("FindWorkspace", func(t *testing.T) {
 		w, err := service.Find(ctx, ws.Id, services.WorkspaceRetrievalParams{})
 
@@ -279,11 +333,40 @@ func TestWorkspaceService(t *testing.T) {
 			GitStatus: &models.GitStatus{
 				CurrentBranch: "main",
 			},
+			LifecycleHooks: []models.LifecycleHookStatus{
+				{
+					Name:  "postStartCommand",
+					State: models.LifecycleHookStateSucceeded,
+				},
+			},
+			AdditionalGitStatuses: map[string]*models.GitStatus{
+				"docs": {
+					CurrentBranch: "develop",
+				},
+			},
 		})
 		require.Nil(t, err)
 
 		require.Nil(t, err)
 		require.Equal(t, "main", res.GitStatus.CurrentBranch)
+		require.Equal(t, "develop", res.AdditionalGitStatuses["docs"].CurrentBranch)
+		require.Len(t, res.LifecycleHooks, 1)
+		require.Equal(t, models.LifecycleHookStateSucceeded, res.LifecycleHooks[0].State)
+	})
+
+	t.Run("UpdateProviderMetadata stores sidecar services", func(t *testing.T) {
+		err := service.UpdateProviderMetadata(ctx, createWorkspaceDTO.Id, `{"daytona.services":"[{\"name\":\"db\",\"state\":\"running\"}]"}`)
+		require.Nil(t, err)
+
+		m, err := metadataStore.Find(ctx, createWorkspaceDTO.Id)
+		require.Nil(t, err)
+		require.Equal(t, []models.WorkspaceService{{Name: "db", State: "running"}}, m.Services)
+
+		res, err := service.UpdateMetadata(ctx, createWorkspaceDTO.Id, &models.WorkspaceMetadata{
+			Uptime: 20,
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Services, 1)
 	})
 
 	t.Run("DeleteWorkspace", func(t *testing.T) {
@@ -6,6 +6,7 @@ package workspacetemplates
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -43,6 +44,9 @@ func (s *WorkspaceTemplateService) FindPrebuild(ctx context.Context, workspaceTe
 		Branch:                prebuild.Branch,
 		CommitInterval:        prebuild.CommitInterval,
 		TriggerFiles:          prebuild.TriggerFiles,
+		PullRequestTargets:    prebuild.PullRequestTargets,
+		TagPatterns:           prebuild.TagPatterns,
+		Schedule:              prebuild.Schedule,
 		Retention:             prebuild.Retention,
 	}, nil
 }
@@ -62,6 +66,9 @@ func (s *WorkspaceTemplateService) ListPrebuilds(ctx context.Context, workspaceT
 				Branch:                prebuild.Branch,
 				CommitInterval:        prebuild.CommitInterval,
 				TriggerFiles:          prebuild.TriggerFiles,
+				PullRequestTargets:    prebuild.PullRequestTargets,
+				TagPatterns:           prebuild.TagPatterns,
+				Schedule:              prebuild.Schedule,
 				Retention:             prebuild.Retention,
 			})
 		}
@@ -85,45 +92,102 @@ func (s *WorkspaceTemplateService) ProcessGitEvent(ctx context.Context, data git
 		return fmt.Errorf("failed to get repository context: %s", err)
 	}
 
-	for _, workspaceTemplate := range workspaceTemplates {
-		prebuild, err := workspaceTemplate.FindPrebuild(&models.MatchParams{
-			Branch: &data.Branch,
-		})
-		if err != nil || prebuild == nil {
-			continue
-		}
+	// Builds are created from the ref that triggered the event
+	eventRepo := *repo
+	eventRepo.Branch = data.Branch
+	eventRepo.Sha = data.Sha
+	eventRepo.PrNumber = data.PrNumber
+	if data.Type == gitprovider.GitEventTypeTag && data.Tag != nil {
+		eventRepo.Branch = *data.Tag
+		eventRepo.Target = gitprovider.CloneTargetTag
+	}
+	// The head branch of a pull request from a fork only exists in the fork
+	if data.Type == gitprovider.GitEventTypePullRequest && data.HeadRepoUrl != nil {
+		eventRepo.Url = *data.HeadRepoUrl
+	}
 
-		// Check if the commit's affected files and prebuild config's trigger files have any overlap
-		if len(prebuild.TriggerFiles) > 0 {
-			if slicesHaveCommonEntry(prebuild.TriggerFiles, data.AffectedFiles) {
-				err := s.createBuild(ctx, workspaceTemplate, repo, prebuild.Id)
+	for _, workspaceTemplate := range workspaceTemplates {
+		for _, prebuild := range workspaceTemplate.Prebuilds {
+			switch data.Type {
+			case gitprovider.GitEventTypeTag:
+				if data.Tag == nil || !prebuild.MatchesTag(*data.Tag) {
+					continue
+				}
+			case gitprovider.GitEventTypePullRequest:
+				if data.TargetBranch == nil || !prebuild.MatchesPullRequestTarget(*data.TargetBranch) {
+					continue
+				}
+				// Pull request updates that do not change the head commit (e.g. title or description edits) are ignored
+				newestBuild, err := s.findNewestBuild(ctx, prebuild.Id, data.Branch)
+				if err == nil && newestBuild.Repository != nil && newestBuild.Repository.Sha == data.Sha {
+					continue
+				}
+			default:
+				err := s.processPushEvent(ctx, workspaceTemplate, prebuild, repo, &eventRepo, data)
 				if err != nil {
-					return fmt.Errorf("failed to create build: %s", err)
+					return err
 				}
 				continue
 			}
-		}
 
-		newestBuild, err := s.findNewestBuild(ctx, prebuild.Id)
-		if err != nil {
-			err := s.createBuild(ctx, workspaceTemplate, repo, prebuild.Id)
+			err := s.createBuild(ctx, workspaceTemplate, &eventRepo, prebuild.Id)
 			if err != nil {
 				return fmt.Errorf("failed to create build: %s", err)
 			}
-			continue
 		}
+	}
 
-		commitsRange, err := s.getCommitsRange(ctx, repo, newestBuild.Repository.Sha, data.Sha)
-		if err != nil {
-			return fmt.Errorf("failed to get commits range: %s", err)
-		}
+	return nil
+}
+
+func (s *WorkspaceTemplateService) processPushEvent(ctx context.Context, workspaceTemplate *models.WorkspaceTemplate, prebuild *models.PrebuildConfig, repo, eventRepo *gitprovider.GitRepository, data gitprovider.GitEventData) error {
+	if !prebuild.MatchesBranch(data.Branch) {
+		return nil
+	}
 
-		// Check if the commit interval has been reached
-		if prebuild.CommitInterval != nil && commitsRange >= *prebuild.CommitInterval {
-			err := s.createBuild(ctx, workspaceTemplate, repo, prebuild.Id)
+	// Prebuilds without push triggers only react to pull requests and tags
+	if prebuild.CommitInterval == nil && len(prebuild.TriggerFiles) == 0 {
+		return nil
+	}
+
+	// Pushes that only change files outside of the sparse checkout do not affect the workspace
+	if len(workspaceTemplate.SparsePaths) > 0 && len(data.AffectedFiles) > 0 && !slices.ContainsFunc(data.AffectedFiles, func(file string) bool {
+		return gitprovider.IsInSparseCheckout(workspaceTemplate.SparsePaths, file)
+	}) {
+		return nil
+	}
+
+	// Check if the commit's affected files and prebuild config's trigger files have any overlap
+	if len(prebuild.TriggerFiles) > 0 {
+		if slicesHaveCommonEntry(prebuild.TriggerFiles, data.AffectedFiles) {
+			err := s.createBuild(ctx, workspaceTemplate, eventRepo, prebuild.Id)
 			if err != nil {
 				return fmt.Errorf("failed to create build: %s", err)
 			}
+			return nil
+		}
+	}
+
+	// Branch patterns can match several branches, the commit interval is counted on the pushed branch only
+	newestBuild, err := s.findNewestBuild(ctx, prebuild.Id, data.Branch)
+	if err != nil {
+		err := s.createBuild(ctx, workspaceTemplate, eventRepo, prebuild.Id)
+		if err != nil {
+			return fmt.Errorf("failed to create build: %s", err)
+		}
+		return nil
+	}
+
+	commitsRange, err := s.getCommitsRange(ctx, repo, newestBuild.Repository.Sha, data.Sha)
+	if err != nil {
+		return fmt.Errorf("failed to get commits range: %s", err)
+	}
+
+	// Check if the commit interval has been reached
+	if prebuild.CommitInterval != nil && commitsRange >= *prebuild.CommitInterval {
+		err := s.createBuild(ctx, workspaceTemplate, eventRepo, prebuild.Id)
+		if err != nil {
+			return fmt.Errorf("failed to create build: %s", err)
 		}
 	}
 
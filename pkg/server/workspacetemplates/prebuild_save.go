@@ -6,8 +6,13 @@ package workspacetemplates
 import (
 	"context"
 	"errors"
+	"fmt"
+	"path"
+	"strings"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/services"
 	"github.com/daytonaio/daytona/pkg/stores"
 	"github.com/daytonaio/daytona/pkg/telemetry"
@@ -30,8 +35,36 @@ func (s *WorkspaceTemplateService) SavePrebuild(ctx context.Context, workspaceTe
 		return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, errors.New("prebuild for the specified workspace template and branch already exists"))
 	}
 
-	if createPrebuildDto.CommitInterval == nil && len(createPrebuildDto.TriggerFiles) == 0 {
-		return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, errors.New("either the commit interval or trigger files must be specified"))
+	if createPrebuildDto.CommitInterval == nil && len(createPrebuildDto.TriggerFiles) == 0 && len(createPrebuildDto.PullRequestTargets) == 0 && len(createPrebuildDto.TagPatterns) == 0 && createPrebuildDto.Schedule == nil {
+		return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, errors.New("either the commit interval, trigger files, pull request targets, tag patterns or schedule must be specified"))
+	}
+
+	patterns := append([]string{createPrebuildDto.Branch}, createPrebuildDto.PullRequestTargets...)
+	patterns = append(patterns, createPrebuildDto.TagPatterns...)
+	for _, pattern := range patterns {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, fmt.Errorf("invalid pattern %s: %w", pattern, err))
+		}
+	}
+
+	// Trigger files outside of the sparse checkout would never be present in the workspace
+	for _, triggerFile := range createPrebuildDto.TriggerFiles {
+		if !gitprovider.IsInSparseCheckout(workspaceTemplate.SparsePaths, triggerFile) {
+			return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, fmt.Errorf("trigger file %s is outside of the sparse checkout paths of the workspace template", triggerFile))
+		}
+	}
+
+	if createPrebuildDto.Schedule != nil {
+		// Scheduled builds are created from the head of the branch so it can not be a pattern
+		if strings.ContainsAny(createPrebuildDto.Branch, "*?[") {
+			return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, errors.New("scheduled prebuilds require a branch name instead of a pattern"))
+		}
+
+		err := scheduler.ValidateSchedule(*createPrebuildDto.Schedule)
+		if err != nil {
+			return nil, s.handleSavePrebuildError(ctx, workspaceTemplate, fmt.Errorf("invalid schedule %s: %w", *createPrebuildDto.Schedule, err))
+		}
 	}
 
 	repository, gitProviderId, err := s.getRepositoryContext(ctx, workspaceTemplate.RepositoryUrl)
@@ -40,10 +73,13 @@ func (s *WorkspaceTemplateService) SavePrebuild(ctx context.Context, workspaceTe
 	}
 
 	prebuild := &models.PrebuildConfig{
-		Branch:         createPrebuildDto.Branch,
-		CommitInterval: createPrebuildDto.CommitInterval,
-		TriggerFiles:   createPrebuildDto.TriggerFiles,
-		Retention:      createPrebuildDto.Retention,
+		Branch:             createPrebuildDto.Branch,
+		CommitInterval:     createPrebuildDto.CommitInterval,
+		TriggerFiles:       createPrebuildDto.TriggerFiles,
+		PullRequestTargets: createPrebuildDto.PullRequestTargets,
+		TagPatterns:        createPrebuildDto.TagPatterns,
+		Schedule:           createPrebuildDto.Schedule,
+		Retention:          createPrebuildDto.Retention,
 	}
 
 	if createPrebuildDto.Id != nil {
@@ -93,6 +129,9 @@ func (s *WorkspaceTemplateService) SavePrebuild(ctx context.Context, workspaceTe
 		Branch:                prebuild.Branch,
 		CommitInterval:        prebuild.CommitInterval,
 		TriggerFiles:          prebuild.TriggerFiles,
+		PullRequestTargets:    prebuild.PullRequestTargets,
+		TagPatterns:           prebuild.TagPatterns,
+		Schedule:              prebuild.Schedule,
 		Retention:             prebuild.Retention,
 	}, s.handleSavePrebuildError(ctx, workspaceTemplate, err)
 }
@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacetemplates
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/models"
+	"github.com/daytonaio/daytona/pkg/scheduler"
+	"github.com/daytonaio/daytona/pkg/services"
+	log "github.com/sirupsen/logrus"
+)
+
+// 1 minute interval
+const DEFAULT_SCHEDULE_POLL_INTERVAL = "0 */1 * * * *"
+
+func (s *WorkspaceTemplateService) StartSchedulePoller(ctx context.Context) error {
+	scheduler := scheduler.NewCronScheduler()
+
+	var mu sync.Mutex
+	lastRun := time.Now()
+
+	err := scheduler.AddFunc(DEFAULT_SCHEDULE_POLL_INTERVAL, func() {
+		// Skip the run if the previous one is still creating builds
+		if !mu.TryLock() {
+			return
+		}
+		defer mu.Unlock()
+
+		now := time.Now()
+		err := s.ProcessScheduledPrebuilds(ctx, lastRun, now)
+		if err != nil {
+			log.Error(err)
+		}
+		lastRun = now
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+// Creates builds for the prebuilds whose schedule is due in the (from, to] time range
+// Prebuilds that already have a successful build of the current branch SHA are skipped
+func (s *WorkspaceTemplateService) ProcessScheduledPrebuilds(ctx context.Context, from, to time.Time) error {
+	workspaceTemplates, err := s.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var successfulBuilds []*services.BuildDTO
+
+	for _, workspaceTemplate := range workspaceTemplates {
+		for _, prebuild := range workspaceTemplate.Prebuilds {
+			if prebuild.Schedule == nil {
+				continue
+			}
+
+			due, err := scheduler.IsScheduleDue(*prebuild.Schedule, from, to)
+			if err != nil {
+				log.Errorf("invalid schedule for prebuild %s: %s", prebuild.Id, err)
+				continue
+			}
+			if !due {
+				continue
+			}
+
+			if successfulBuilds == nil {
+				successfulBuilds, err = s.listSuccessfulBuilds(ctx)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = s.processScheduledPrebuild(ctx, workspaceTemplate, prebuild, successfulBuilds)
+			if err != nil {
+				log.Errorf("failed to process scheduled prebuild %s: %s", prebuild.Id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceTemplateService) processScheduledPrebuild(ctx context.Context, workspaceTemplate *models.WorkspaceTemplate, prebuild *models.PrebuildConfig, successfulBuilds []*services.BuildDTO) error {
+	repo, _, err := s.getRepositoryContext(ctx, workspaceTemplate.RepositoryUrl)
+	if err != nil {
+		return fmt.Errorf("failed to get repository context: %s", err)
+	}
+
+	// Builds are created from the head of the prebuild's branch
+	scheduledRepo := *repo
+	scheduledRepo.Branch = prebuild.Branch
+
+	sha, err := s.getLastCommitSha(ctx, &scheduledRepo)
+	if err != nil {
+		return fmt.Errorf("failed to get last commit sha: %s", err)
+	}
+
+	scheduledRepo.Sha = sha
+
+	var shaBuilds []*models.Build
+	for _, b := range successfulBuilds {
+		if b.Repository != nil && b.Repository.Sha == sha {
+			shaBuilds = append(shaBuilds, &b.Build)
+		}
+	}
+
+	build := &models.Build{
+		BuildConfig: workspaceTemplate.BuildConfig,
+		Repository:  &scheduledRepo,
+		EnvVars:     workspaceTemplate.EnvVars,
+	}
+
+	if models.GetCachedBuild(build, shaBuilds) != nil {
+		log.Debugf("skipping scheduled prebuild %s: a build of %s already exists", prebuild.Id, sha)
+		return nil
+	}
+
+	err = s.createBuild(ctx, workspaceTemplate, &scheduledRepo, prebuild.Id)
+	if err != nil {
+		return fmt.Errorf("failed to create build: %s", err)
+	}
+
+	return nil
+}
@@ -15,11 +15,14 @@ import (
 )
 
 var prebuild1 = &models.PrebuildConfig{
-	Id:             "1",
-	Branch:         "feat",
-	CommitInterval: util.Pointer(3),
-	Retention:      3,
-	TriggerFiles:   []string{"file1", "file2"},
+	Id:                 "1",
+	Branch:             "feat",
+	CommitInterval:     util.Pointer(3),
+	Retention:          3,
+	TriggerFiles:       []string{"file1", "file2"},
+	PullRequestTargets: []string{"main"},
+	TagPatterns:        []string{"v*"},
+	Schedule:           util.Pointer("0 3 * * *"),
 }
 
 var prebuild2 = &models.PrebuildConfig{
@@ -45,6 +48,9 @@ var prebuild1Dto = &services.PrebuildDTO{
 	CommitInterval:        prebuild1.CommitInterval,
 	Retention:             prebuild1.Retention,
 	TriggerFiles:          prebuild1.TriggerFiles,
+	PullRequestTargets:    prebuild1.PullRequestTargets,
+	TagPatterns:           prebuild1.TagPatterns,
+	Schedule:              prebuild1.Schedule,
 }
 
 var repository1 *gitprovider.GitRepository = &gitprovider.GitRepository{
@@ -139,7 +145,7 @@ func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventCommitInterval()
 
 	s.buildService.On("Create", services.CreateBuildDTO{
 		PrebuildId:            &prebuild1.Id,
-		Branch:                repository1.Branch,
+		Branch:                "feat",
 		WorkspaceTemplateName: workspaceTemplate1.Name,
 		EnvVars:               workspaceTemplate1.EnvVars,
 	}).Return("", nil)
@@ -147,6 +153,7 @@ func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventCommitInterval()
 	s.buildService.On("Find", &services.BuildFilter{
 		StoreFilter: stores.BuildFilter{
 			PrebuildIds: &[]string{prebuild1.Id},
+			Branch:      util.Pointer("feat"),
 			GetNewest:   util.Pointer(true),
 		},
 	}).Return(&services.BuildDTO{
@@ -158,6 +165,7 @@ func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventCommitInterval()
 	}, nil)
 
 	data := gitprovider.GitEventData{
+		Type:          gitprovider.GitEventTypePush,
 		Url:           repository1.Url,
 		Branch:        "feat",
 		Sha:           "sha4",
@@ -181,12 +189,13 @@ func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventTriggerFiles() {
 
 	s.buildService.On("Create", services.CreateBuildDTO{
 		PrebuildId:            &prebuild1.Id,
-		Branch:                repository1.Branch,
+		Branch:                "feat",
 		WorkspaceTemplateName: workspaceTemplate1.Name,
 		EnvVars:               workspaceTemplate1.EnvVars,
 	}).Return("", nil)
 
 	data := gitprovider.GitEventData{
+		Type:   gitprovider.GitEventTypePush,
 		Url:    repository1.Url,
 		Branch: "feat",
 		Sha:    "sha4",
@@ -200,6 +209,234 @@ func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventTriggerFiles() {
 	require.Nil(err)
 }
 
+func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventOutsideSparseCheckout() {
+	require := s.Require()
+
+	workspaceTemplate1.SparsePaths = []string{"services/api"}
+	defer func() {
+		workspaceTemplate1.SparsePaths = nil
+	}()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	data := gitprovider.GitEventData{
+		Type:   gitprovider.GitEventTypePush,
+		Url:    repository1.Url,
+		Branch: "feat",
+		Sha:    "sha4",
+		Owner:  repository1.Owner,
+		AffectedFiles: []string{
+			"services/web/file1",
+		},
+	}
+
+	// The build service has no expectations so any build lookup or creation fails the test
+	err := s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+}
+
+func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventPullRequest() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	s.buildService.On("Create", services.CreateBuildDTO{
+		PrebuildId:            &prebuild1.Id,
+		Branch:                "fix",
+		PrNumber:              util.Pointer(uint32(7)),
+		WorkspaceTemplateName: workspaceTemplate1.Name,
+		EnvVars:               workspaceTemplate1.EnvVars,
+	}).Return("", nil).Once()
+
+	s.buildService.On("Find", &services.BuildFilter{
+		StoreFilter: stores.BuildFilter{
+			PrebuildIds: &[]string{prebuild1.Id},
+			Branch:      util.Pointer("fix"),
+			GetNewest:   util.Pointer(true),
+		},
+	}).Return((*services.BuildDTO)(nil), stores.ErrBuildNotFound).Once()
+
+	data := gitprovider.GitEventData{
+		Type:          gitprovider.GitEventTypePullRequest,
+		Url:           repository1.Url,
+		Branch:        "fix",
+		Sha:           "sha5",
+		Owner:         repository1.Owner,
+		PrNumber:      util.Pointer(uint32(7)),
+		TargetBranch:  util.Pointer("main"),
+		AffectedFiles: []string{},
+	}
+
+	err := s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+
+	// Pull requests against other branches do not trigger prebuilds
+	data.TargetBranch = util.Pointer("dev")
+	err = s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+
+	// Pull request updates without new commits do not trigger prebuilds
+	s.buildService.On("Find", &services.BuildFilter{
+		StoreFilter: stores.BuildFilter{
+			PrebuildIds: &[]string{prebuild1.Id},
+			Branch:      util.Pointer("fix"),
+			GetNewest:   util.Pointer(true),
+		},
+	}).Return(&services.BuildDTO{
+		Build: models.Build{
+			Id:         "1",
+			PrebuildId: &prebuild1.Id,
+			Repository: &gitprovider.GitRepository{Sha: "sha5"},
+		},
+	}, nil).Once()
+
+	data.TargetBranch = util.Pointer("main")
+	err = s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+}
+
+func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventForkPullRequest() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	s.buildService.On("Create", services.CreateBuildDTO{
+		PrebuildId:            &prebuild1.Id,
+		Branch:                "main",
+		PrNumber:              util.Pointer(uint32(8)),
+		WorkspaceTemplateName: workspaceTemplate1.Name,
+		EnvVars:               workspaceTemplate1.EnvVars,
+	}).Return("", nil).Once()
+
+	s.buildService.On("Find", &services.BuildFilter{
+		StoreFilter: stores.BuildFilter{
+			PrebuildIds: &[]string{prebuild1.Id},
+			Branch:      util.Pointer("main"),
+			GetNewest:   util.Pointer(true),
+		},
+	}).Return((*services.BuildDTO)(nil), stores.ErrBuildNotFound).Once()
+
+	// The head branch has the same name as a branch of the base repository
+	data := gitprovider.GitEventData{
+		Type:          gitprovider.GitEventTypePullRequest,
+		Url:           repository1.Url,
+		Branch:        "main",
+		Sha:           "sha6",
+		Owner:         repository1.Owner,
+		PrNumber:      util.Pointer(uint32(8)),
+		TargetBranch:  util.Pointer("main"),
+		HeadRepoUrl:   util.Pointer("https://github.com/contributor/daytona.git"),
+		AffectedFiles: []string{},
+	}
+
+	err := s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+
+	require.Len(s.buildRepos, 1)
+	require.Equal("https://github.com/contributor/daytona.git", s.buildRepos[0].Url)
+	require.Equal("main", s.buildRepos[0].Branch)
+	require.Equal("sha6", s.buildRepos[0].Sha)
+	require.Equal(uint32(8), *s.buildRepos[0].PrNumber)
+}
+
+func (s *WorkspaceTemplateServiceTestSuite) TestProcessGitEventTag() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	s.buildService.On("Create", services.CreateBuildDTO{
+		PrebuildId:            &prebuild1.Id,
+		Branch:                "v1.0.0",
+		Tag:                   util.Pointer("v1.0.0"),
+		WorkspaceTemplateName: workspaceTemplate1.Name,
+		EnvVars:               workspaceTemplate1.EnvVars,
+	}).Return("", nil)
+
+	data := gitprovider.GitEventData{
+		Type:          gitprovider.GitEventTypeTag,
+		Url:           repository1.Url,
+		Branch:        "main",
+		Sha:           "sha6",
+		Owner:         repository1.Owner,
+		Tag:           util.Pointer("v1.0.0"),
+		AffectedFiles: []string{},
+	}
+
+	err := s.workspaceTemplateService.ProcessGitEvent(context.TODO(), data)
+	require.Nil(err)
+}
+
+func (s *WorkspaceTemplateServiceTestSuite) TestProcessScheduledPrebuilds() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+	s.gitProvider.On("GetLastCommitSha", &gitprovider.StaticGitContext{
+		Url:    repository1.Url,
+		Branch: &prebuild1.Branch,
+	}).Return("sha7", nil)
+
+	s.buildService.On("Create", services.CreateBuildDTO{
+		PrebuildId:            &prebuild1.Id,
+		Branch:                prebuild1.Branch,
+		WorkspaceTemplateName: workspaceTemplate1.Name,
+		EnvVars:               workspaceTemplate1.EnvVars,
+	}).Return("", nil).Once()
+
+	successfulBuildsFilter := &services.BuildFilter{
+		StateNames: &[]models.ResourceStateName{models.ResourceStateNameRunSuccessful},
+	}
+	s.buildService.On("List", successfulBuildsFilter).Return([]*services.BuildDTO{}, nil).Once()
+
+	from := time.Date(2024, 1, 1, 2, 59, 0, 0, time.Local)
+	to := from.Add(time.Minute)
+
+	err := s.workspaceTemplateService.ProcessScheduledPrebuilds(context.TODO(), from, to)
+	require.Nil(err)
+
+	// A successful build of the branch head already exists
+	s.buildService.On("List", successfulBuildsFilter).Return([]*services.BuildDTO{
+		{
+			Build: models.Build{
+				Id:    "1",
+				Image: util.Pointer("image"),
+				User:  util.Pointer("user"),
+				Repository: &gitprovider.GitRepository{
+					Url:    repository1.Url,
+					Branch: prebuild1.Branch,
+					Sha:    "sha7",
+				},
+				LastJob: &models.Job{
+					Action: models.JobActionRun,
+					State:  models.JobStateSuccess,
+				},
+				PrebuildId: &prebuild1.Id,
+			},
+		},
+	}, nil).Once()
+
+	err = s.workspaceTemplateService.ProcessScheduledPrebuilds(context.TODO(), from, to)
+	require.Nil(err)
+
+	// The schedule is not due
+	err = s.workspaceTemplateService.ProcessScheduledPrebuilds(context.TODO(), to, to.Add(time.Hour))
+	require.Nil(err)
+}
+
 func (s *WorkspaceTemplateServiceTestSuite) TestEnforceRetentionPolicy() {
 	require := s.Require()
 
@@ -245,3 +482,21 @@ func (s *WorkspaceTemplateServiceTestSuite) TestEnforceRetentionPolicy() {
 	err := s.workspaceTemplateService.EnforceRetentionPolicy(context.TODO())
 	require.Nil(err)
 }
+
+func (s *WorkspaceTemplateServiceTestSuite) TestMigratePrebuildWebhooks() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	// The unsigned webhook is unregistered by the lookup so a new one is registered
+	s.gitProviderService.On("GetPrebuildWebhook", "github", repository1, "").Return((*string)(nil), nil).Once()
+	s.gitProviderService.On("RegisterPrebuildWebhook", "github", repository1, "").Return("webhook-id", nil).Once()
+
+	err := s.workspaceTemplateService.MigratePrebuildWebhooks(context.TODO())
+	require.Nil(err)
+
+	s.gitProviderService.AssertExpectations(s.T())
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacetemplates
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Re-registers the prebuild webhooks of repositories that have prebuilds
+// Webhooks registered before events were signed have no secret so their events would be rejected
+func (s *WorkspaceTemplateService) MigratePrebuildWebhooks(ctx context.Context) error {
+	workspaceTemplates, err := s.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	migratedUrls := map[string]bool{}
+
+	for _, workspaceTemplate := range workspaceTemplates {
+		if len(workspaceTemplate.Prebuilds) == 0 || migratedUrls[workspaceTemplate.RepositoryUrl] {
+			continue
+		}
+		migratedUrls[workspaceTemplate.RepositoryUrl] = true
+
+		err := s.migratePrebuildWebhook(ctx, workspaceTemplate.RepositoryUrl)
+		if err != nil {
+			log.Errorf("failed to migrate prebuild webhook for %s: %s", workspaceTemplate.RepositoryUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceTemplateService) migratePrebuildWebhook(ctx context.Context, repositoryUrl string) error {
+	repository, gitProviderId, err := s.getRepositoryContext(ctx, repositoryUrl)
+	if err != nil {
+		return fmt.Errorf("failed to get repository context: %s", err)
+	}
+
+	// Webhooks without a secret are unregistered when looked up
+	webhookId, err := s.findPrebuildWebhook(ctx, gitProviderId, repository, s.prebuildWebhookEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if webhookId != nil {
+		return nil
+	}
+
+	_, err = s.registerPrebuildWebhook(ctx, gitProviderId, repository, s.prebuildWebhookEndpoint)
+	return err
+}
@@ -21,7 +21,7 @@ type WorkspaceTemplateServiceConfig struct {
 	PrebuildWebhookEndpoint string
 	ConfigStore             stores.WorkspaceTemplateStore
 
-	FindNewestBuild           func(ctx context.Context, prebuildId string) (*services.BuildDTO, error)
+	FindNewestBuild           func(ctx context.Context, prebuildId, branch string) (*services.BuildDTO, error)
 	ListSuccessfulBuilds      func(ctx context.Context) ([]*services.BuildDTO, error)
 	CreateBuild               func(ctx context.Context, wt *models.WorkspaceTemplate, repo *gitprovider.GitRepository, prebuildId string) error
 	DeleteBuilds              func(ctx context.Context, id, prebuildId *string, force bool) []error
@@ -30,6 +30,7 @@ type WorkspaceTemplateServiceConfig struct {
 	UnregisterPrebuildWebhook func(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, id string) error
 	RegisterPrebuildWebhook   func(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error)
 	GetCommitsRange           func(ctx context.Context, repo *gitprovider.GitRepository, initialSha string, currentSha string) (int, error)
+	GetLastCommitSha          func(ctx context.Context, repo *gitprovider.GitRepository) (string, error)
 	TrackTelemetryEvent       func(event telemetry.Event, clientId string) error
 }
 
@@ -37,12 +38,13 @@ type WorkspaceTemplateService struct {
 	prebuildWebhookEndpoint string
 	templateStore           stores.WorkspaceTemplateStore
 
-	findNewestBuild           func(ctx context.Context, prebuildId string) (*services.BuildDTO, error)
+	findNewestBuild           func(ctx context.Context, prebuildId, branch string) (*services.BuildDTO, error)
 	listSuccessfulBuilds      func(ctx context.Context) ([]*services.BuildDTO, error)
 	createBuild               func(ctx context.Context, wt *models.WorkspaceTemplate, repo *gitprovider.GitRepository, prebuildId string) error
 	deleteBuilds              func(ctx context.Context, id, prebuildId *string, force bool) []error
 	getRepositoryContext      func(ctx context.Context, url string) (repo *gitprovider.GitRepository, gitProviderId string, err error)
 	getCommitsRange           func(ctx context.Context, repo *gitprovider.GitRepository, initialSha string, currentSha string) (int, error)
+	getLastCommitSha          func(ctx context.Context, repo *gitprovider.GitRepository) (string, error)
 	findPrebuildWebhook       func(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (*string, error)
 	unregisterPrebuildWebhook func(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, id string) error
 	registerPrebuildWebhook   func(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error)
@@ -62,6 +64,7 @@ func NewWorkspaceTemplateService(config WorkspaceTemplateServiceConfig) services
 		unregisterPrebuildWebhook: config.UnregisterPrebuildWebhook,
 		registerPrebuildWebhook:   config.RegisterPrebuildWebhook,
 		getCommitsRange:           config.GetCommitsRange,
+		getLastCommitSha:          config.GetLastCommitSha,
 		trackTelemetryEvent:       config.TrackTelemetryEvent,
 	}
 }
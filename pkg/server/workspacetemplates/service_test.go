@@ -72,6 +72,8 @@ type WorkspaceTemplateServiceTestSuite struct {
 	gitProviderService       mocks.MockGitProviderService
 	buildService             mocks.MockBuildService
 	gitProvider              git_provider_mock.MockGitProvider
+	// Repositories the builds were created from
+	buildRepos []*gitprovider.GitRepository
 }
 
 func NewConfigServiceTestSuite() *WorkspaceTemplateServiceTestSuite {
@@ -115,13 +117,15 @@ func (s *WorkspaceTemplateServiceTestSuite) SetupTest() {
 		prebuild1.Id: prebuild1,
 	}
 
+	s.buildRepos = nil
 	s.workspaceTemplateStore = workspacetemplate_internal.NewInMemoryWorkspaceTemplateStore()
 	s.workspaceTemplateService = workspacetemplates.NewWorkspaceTemplateService(workspacetemplates.WorkspaceTemplateServiceConfig{
 		ConfigStore: s.workspaceTemplateStore,
-		FindNewestBuild: func(ctx context.Context, prebuildId string) (*services.BuildDTO, error) {
+		FindNewestBuild: func(ctx context.Context, prebuildId, branch string) (*services.BuildDTO, error) {
 			return s.buildService.Find(&services.BuildFilter{
 				StoreFilter: stores.BuildFilter{
 					PrebuildIds: &[]string{prebuildId},
+					Branch:      &branch,
 					GetNewest:   util.Pointer(true),
 				},
 			})
@@ -135,10 +139,16 @@ func (s *WorkspaceTemplateServiceTestSuite) SetupTest() {
 			createBuildDto := services.CreateBuildDTO{
 				WorkspaceTemplateName: workspaceTemplate.Name,
 				Branch:                repo.Branch,
+				PrNumber:              repo.PrNumber,
 				PrebuildId:            &prebuildId,
 				EnvVars:               workspaceTemplate.EnvVars,
 			}
 
+			if repo.Target == gitprovider.CloneTargetTag {
+				createBuildDto.Tag = &repo.Branch
+			}
+
+			s.buildRepos = append(s.buildRepos, repo)
 			_, err := s.buildService.Create(createBuildDto)
 			return err
 		},
@@ -184,6 +194,17 @@ func (s *WorkspaceTemplateServiceTestSuite) SetupTest() {
 
 			return gitProvider.GetCommitsRange(repo, initialSha, currentSha)
 		},
+		GetLastCommitSha: func(ctx context.Context, repo *gitprovider.GitRepository) (string, error) {
+			gitProvider, _, err := s.gitProviderService.GetGitProviderForUrl(repo.Url)
+			if err != nil {
+				return "", err
+			}
+
+			return gitProvider.GetLastCommitSha(&gitprovider.StaticGitContext{
+				Url:    repo.Url,
+				Branch: &repo.Branch,
+			})
+		},
 	})
 
 	for _, wt := range expectedWorkspaceTemplates {
@@ -17,8 +17,10 @@ type IBuildService interface {
 	Find(ctx context.Context, filter *BuildFilter) (*BuildDTO, error)
 	Create(ctx context.Context, createBuildDTO CreateBuildDTO) (string, error)
 	Delete(ctx context.Context, filter *BuildFilter, force bool) []error
+	Prune(ctx context.Context, dryRun bool) (*BuildPruneResult, error)
 
 	UpdateLastJob(ctx context.Context, buildId, jobId string) error
+	UpdateResult(ctx context.Context, buildId string, result UpdateBuildResultDTO) error
 	HandleSuccessfulRemoval(ctx context.Context, id string) error
 	GetBuildLogReader(ctx context.Context, buildId string) (io.Reader, error)
 	GetBuildLogWriter(ctx context.Context, buildId string) (io.WriteCloser, error)
@@ -32,10 +34,18 @@ type BuildDTO struct {
 type CreateBuildDTO struct {
 	WorkspaceTemplateName string            `json:"workspaceTemplateName" validate:"required"`
 	Branch                string            `json:"branch" validate:"required"`
+	PrNumber              *uint32           `json:"prNumber,omitempty" validate:"optional"`
+	Tag                   *string           `json:"tag,omitempty" validate:"optional"`
 	PrebuildId            *string           `json:"prebuildId" validate:"optional"`
 	EnvVars               map[string]string `json:"envVars" validate:"required"`
 } // @name CreateBuildDTO
 
+type UpdateBuildResultDTO struct {
+	Image      string                  `json:"image" validate:"required"`
+	User       string                  `json:"user" validate:"required"`
+	CacheStats *models.BuildCacheStats `json:"cacheStats" validate:"optional"`
+} // @name UpdateBuildResultDTO
+
 type BuildFilter struct {
 	StateNames  *[]models.ResourceStateName
 	ShowDeleted bool
@@ -43,9 +53,23 @@ type BuildFilter struct {
 }
 
 var (
-	ErrBuildDeleted = errors.New("build is deleted")
+	ErrBuildDeleted         = errors.New("build is deleted")
+	ErrBuildPruneInProgress = errors.New("cannot prune build images while builds are in progress")
 )
 
 func IsBuildDeleted(err error) bool {
 	return err.Error() == ErrBuildDeleted.Error()
 }
+
+type PrunedBuildImage struct {
+	Image string `json:"image" validate:"required"`
+	// Size in bytes of the layers only used by the image
+	Size int64 `json:"size" validate:"required"`
+} // @name PrunedBuildImage
+
+type BuildPruneResult struct {
+	Images []PrunedBuildImage `json:"images" validate:"required"`
+	// Size in bytes of the registry storage reclaimed by removing the images
+	ReclaimedSpace int64 `json:"reclaimedSpace" validate:"required"`
+	DryRun         bool  `json:"dryRun" validate:"required"`
+} // @name BuildPruneResult
@@ -17,6 +17,8 @@ type IGitProviderService interface {
 	FindConfig(ctx context.Context, id string) (*models.GitProviderConfig, error)
 	SaveConfig(ctx context.Context, providerConfig *models.GitProviderConfig) error
 	DeleteConfig(ctx context.Context, id string) error
+	CheckTokenHealth(ctx context.Context) error
+	StartTokenHealthPoller(ctx context.Context) error
 
 	GetGitProvider(ctx context.Context, id string) (gitprovider.GitProvider, error)
 	GetGitProviderForUrl(ctx context.Context, url string) (gitprovider.GitProvider, string, error)
@@ -31,4 +33,8 @@ type IGitProviderService interface {
 	RegisterPrebuildWebhook(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error)
 	GetPrebuildWebhook(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (*string, error)
 	UnregisterPrebuildWebhook(ctx context.Context, gitProviderId string, repo *gitprovider.GitRepository, id string) error
+
+	SetPrebuildCommitStatus(ctx context.Context, repo *gitprovider.GitRepository, buildId string, jobState models.JobState) error
+	PostPrBotComment(ctx context.Context, data gitprovider.GitEventData) error
+	UpdatePrBotComment(ctx context.Context, repo *gitprovider.GitRepository, buildState models.JobState) error
 }
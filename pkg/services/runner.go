@@ -50,6 +50,8 @@ type CreateRunnerResultDTO struct {
 type UpdateJobStateDTO struct {
 	State        models.JobState `json:"state" validate:"required"`
 	ErrorMessage *string         `json:"errorMessage,omitempty" validate:"optional"`
+	// Set by the server to the runner that reported the state, unassigned jobs are claimed by it
+	RunnerId *string `json:"-"`
 } // @name UpdateJobState
 
 type ProviderDTO struct {
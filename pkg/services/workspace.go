@@ -29,6 +29,8 @@ type IWorkspaceService interface {
 
 	GetWorkspaceLogReader(ctx context.Context, workspaceId string) (io.Reader, error)
 	GetWorkspaceLogWriter(ctx context.Context, workspaceId string) (io.WriteCloser, error)
+	GetToolboxAuditLogReader(ctx context.Context, workspaceId string) (io.Reader, error)
+	GetToolboxAuditLogWriter(ctx context.Context, workspaceId string) (io.WriteCloser, error)
 }
 
 type WorkspaceDTO struct {
@@ -61,6 +63,10 @@ func (c *CreateWorkspaceDTO) ToWorkspace() *models.Workspace {
 		Labels:              c.Labels,
 	}
 
+	if len(c.Source.AdditionalRepositories) > 0 {
+		w.AdditionalRepositories = c.Source.AdditionalRepositories
+	}
+
 	if c.Image != nil {
 		w.Image = *c.Image
 	}
@@ -73,7 +79,8 @@ func (c *CreateWorkspaceDTO) ToWorkspace() *models.Workspace {
 }
 
 type CreateWorkspaceSourceDTO struct {
-	Repository *gitprovider.GitRepository `json:"repository" validate:"required"`
+	Repository             *gitprovider.GitRepository    `json:"repository" validate:"required"`
+	AdditionalRepositories []*models.WorkspaceRepository `json:"additionalRepositories,omitempty" validate:"optional"`
 } // @name CreateWorkspaceSourceDTO
 
 type WorkspaceRetrievalParams struct {
@@ -86,6 +93,8 @@ var (
 	ErrWorkspaceDeleted         = errors.New("workspace is deleted")
 	ErrInvalidWorkspaceName     = errors.New("workspace name is not valid. Only [a-zA-Z0-9-_.] are allowed")
 	ErrInvalidWorkspaceTemplate = errors.New("workspace template is invalid")
+	ErrInvalidClonePath         = errors.New("clone path of an additional repository is not valid")
+	ErrInvalidSparsePath        = errors.New("sparse checkout path is not valid")
 )
 
 func IsWorkspaceDeleted(err error) bool {
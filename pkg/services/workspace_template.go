@@ -5,6 +5,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/models"
@@ -25,17 +26,22 @@ type IWorkspaceTemplateService interface {
 
 	StartRetentionPoller(ctx context.Context) error
 	EnforceRetentionPolicy(ctx context.Context) error
+	StartSchedulePoller(ctx context.Context) error
+	MigratePrebuildWebhooks(ctx context.Context) error
+	ProcessScheduledPrebuilds(ctx context.Context, from, to time.Time) error
 	ProcessGitEvent(ctx context.Context, gitEventData gitprovider.GitEventData) error
 }
 
 type CreateWorkspaceTemplateDTO struct {
-	Name                string              `json:"name" validate:"required"`
-	Image               *string             `json:"image,omitempty" validate:"optional"`
-	User                *string             `json:"user,omitempty" validate:"optional"`
-	BuildConfig         *models.BuildConfig `json:"buildConfig,omitempty" validate:"optional"`
-	RepositoryUrl       string              `json:"repositoryUrl" validate:"required"`
-	EnvVars             map[string]string   `json:"envVars" validate:"required"`
-	GitProviderConfigId *string             `json:"gitProviderConfigId" validate:"optional"`
+	Name                string                 `json:"name" validate:"required"`
+	Image               *string                `json:"image,omitempty" validate:"optional"`
+	User                *string                `json:"user,omitempty" validate:"optional"`
+	BuildConfig         *models.BuildConfig    `json:"buildConfig,omitempty" validate:"optional"`
+	RepositoryUrl       string                 `json:"repositoryUrl" validate:"required"`
+	EnvVars             map[string]string      `json:"envVars" validate:"required"`
+	GitProviderConfigId *string                `json:"gitProviderConfigId" validate:"optional"`
+	SparsePaths         []string               `json:"sparsePaths,omitempty" validate:"optional"`
+	Lfs                 *gitprovider.LfsConfig `json:"lfs,omitempty" validate:"optional"`
 } // @name CreateWorkspaceTemplateDTO
 
 type PrebuildDTO struct {
@@ -44,13 +50,19 @@ type PrebuildDTO struct {
 	Branch                string   `json:"branch" validate:"required"`
 	CommitInterval        *int     `json:"commitInterval" validate:"optional"`
 	TriggerFiles          []string `json:"triggerFiles" validate:"optional"`
+	PullRequestTargets    []string `json:"pullRequestTargets" validate:"optional"`
+	TagPatterns           []string `json:"tagPatterns" validate:"optional"`
+	Schedule              *string  `json:"schedule" validate:"optional"`
 	Retention             int      `json:"retention" validate:"required"`
 } // @name PrebuildDTO
 
 type CreatePrebuildDTO struct {
-	Id             *string  `json:"id" validate:"optional"`
-	Branch         string   `json:"branch" validate:"optional"`
-	CommitInterval *int     `json:"commitInterval" validate:"optional"`
-	TriggerFiles   []string `json:"triggerFiles" validate:"optional"`
-	Retention      int      `json:"retention" validate:"required"`
+	Id                 *string  `json:"id" validate:"optional"`
+	Branch             string   `json:"branch" validate:"optional"`
+	CommitInterval     *int     `json:"commitInterval" validate:"optional"`
+	TriggerFiles       []string `json:"triggerFiles" validate:"optional"`
+	PullRequestTargets []string `json:"pullRequestTargets" validate:"optional"`
+	TagPatterns        []string `json:"tagPatterns" validate:"optional"`
+	Schedule           *string  `json:"schedule" validate:"optional"`
+	Retention          int      `json:"retention" validate:"required"`
 } // @name CreatePrebuildDTO
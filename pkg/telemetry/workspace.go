@@ -72,6 +72,12 @@ func getBuilder(bc *models.BuildConfig) string {
 		return "none"
 	} else if bc.Devcontainer != nil {
 		return "devcontainer"
+	} else if bc.Dockerfile != nil {
+		return "dockerfile"
+	} else if bc.Nix != nil {
+		return "nix"
+	} else if bc.Buildpacks != nil {
+		return "buildpacks"
 	} else {
 		return "automatic"
 	}
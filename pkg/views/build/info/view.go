@@ -61,10 +61,26 @@ func Render(b *apiclient.BuildDTO, apiServerConfig *apiclient.ServerConfig, forc
 		output += getInfoLine("Devcontainer path", b.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", b.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
+	if b.BuildConfig != nil && b.BuildConfig.Nix != nil {
+		output += getInfoLine("Nix file path", b.BuildConfig.Nix.FilePath) + "\n"
+	}
+
+	if b.BuildConfig != nil && b.BuildConfig.Buildpacks != nil && b.BuildConfig.Buildpacks.Builder != nil {
+		output += getInfoLine("Buildpacks builder", *b.BuildConfig.Buildpacks.Builder) + "\n"
+	}
+
 	if b.PrebuildId != nil {
 		output += getInfoLine("Prebuild ID", *b.PrebuildId) + "\n"
 	}
 
+	if b.CacheStats != nil && b.CacheStats.TotalSteps > 0 {
+		output += getInfoLine("Cache hits", fmt.Sprintf("%d/%d steps", b.CacheStats.CachedSteps, b.CacheStats.TotalSteps)) + "\n"
+	}
+
 	output += getInfoLine("Created", util.FormatTimestamp(b.CreatedAt)) + "\n"
 
 	output += getInfoLine("Updated", util.FormatTimestamp(b.UpdatedAt)) + "\n"
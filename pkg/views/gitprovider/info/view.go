@@ -6,6 +6,7 @@ package info
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -44,6 +45,18 @@ func Render(gp *gitprovider.GitProviderView, forceUnstyled bool) {
 		output += getInfoLine("Signing Method", gp.SigningMethod) + "\n"
 	}
 
+	if gp.TokenStatus != "" {
+		output += getInfoLine("Token", gp.TokenStatus) + "\n"
+	}
+
+	if gp.TokenError != "" {
+		output += getInfoLine("Token Error", gp.TokenError) + "\n"
+	}
+
+	if len(gp.TokenScopes) > 0 {
+		output += getInfoLine("Token Scopes", strings.Join(gp.TokenScopes, ", ")) + "\n"
+	}
+
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(output)
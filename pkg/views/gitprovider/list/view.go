@@ -5,7 +5,9 @@ package list
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/gitprovider"
 	"github.com/daytonaio/daytona/pkg/views/gitprovider/info"
@@ -18,6 +20,7 @@ type rowData struct {
 	Username      string
 	BaseApiUrl    string
 	SigningMethod string
+	TokenStatus   string
 }
 
 func ListGitProviders(gitProviderViewList []gitprovider.GitProviderView) {
@@ -28,7 +31,7 @@ func ListGitProviders(gitProviderViewList []gitprovider.GitProviderView) {
 
 	var showBaseApiUrlColumn bool
 	var showSigningMethodColumn bool
-	headers := []string{"Name", "Alias", "Username", "Base API URL", "Signing Method"}
+	headers := []string{"Name", "Alias", "Username", "Base API URL", "Signing Method", "Token"}
 
 	for _, gp := range gitProviderViewList {
 		if gp.BaseApiUrl != "" {
@@ -46,15 +49,17 @@ func ListGitProviders(gitProviderViewList []gitprovider.GitProviderView) {
 	}
 
 	if !showBaseApiUrlColumn {
+		index := slices.Index(headers, "Base API URL")
 		headers = removeHeader(headers, "Base API URL")
 		for i := range data {
-			data[i] = removeColumn(data[i], 3)
+			data[i] = removeColumn(data[i], index)
 		}
 	}
 	if !showSigningMethodColumn {
+		index := slices.Index(headers, "Signing Method")
 		headers = removeHeader(headers, "Signing Method")
 		for i := range data {
-			data[i] = removeColumn(data[i], 4)
+			data[i] = removeColumn(data[i], index)
 		}
 	}
 
@@ -99,6 +104,7 @@ func getRowFromRowData(build gitprovider.GitProviderView) []string {
 	data.Username = build.Username
 	data.BaseApiUrl = build.BaseApiUrl
 	data.SigningMethod = build.SigningMethod
+	data.TokenStatus = build.TokenStatus
 
 	return []string{
 		views.NameStyle.Render(data.Name),
@@ -106,5 +112,19 @@ func getRowFromRowData(build gitprovider.GitProviderView) []string {
 		views.DefaultRowDataStyle.Render(data.Username),
 		views.DefaultRowDataStyle.Render(data.BaseApiUrl),
 		views.DefaultRowDataStyle.Render(data.SigningMethod),
+		getTokenStatusStyle(data.TokenStatus).Render(data.TokenStatus),
+	}
+}
+
+func getTokenStatusStyle(tokenStatus string) lipgloss.Style {
+	switch tokenStatus {
+	case gitprovider.TokenStatusValid:
+		return views.ActiveStyle
+	case gitprovider.TokenStatusInvalid, gitprovider.TokenStatusExpired:
+		return views.ErrorStyle
+	case gitprovider.TokenStatusUnknown:
+		return views.DefaultRowDataStyle
+	default:
+		return views.InactiveStyle
 	}
 }
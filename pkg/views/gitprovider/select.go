@@ -14,6 +14,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -79,6 +80,23 @@ func GitProviderCreationView(ctx context.Context, apiClient *apiclient.APIClient
 	usernameFlag := flags["username"]
 	signingMethodFlag := flags["signing-method"]
 	signingKeyFlag := flags["signing-key"]
+	oauthClientIdFlag := flags["oauth-client-id"]
+
+	authMethod := "token"
+	oauthClientId := gitProviderAddView.GetOauthClientId()
+	if oauthClientId != "" {
+		authMethod = "oauth"
+	}
+	initialOauthClientId := oauthClientId
+
+	if oauthClientIdFlag != "" {
+		// The base API URL of self-managed providers is checked once it has been entered
+		if !ProviderRequiresApiUrl(gitProviderAddView.ProviderId) && !gitprovider.SupportsDeviceFlow(gitProviderAddView.ProviderId, nil) {
+			return fmt.Errorf("OAuth device flow is not supported for '%s' provider", gitProviderAddView.ProviderId)
+		}
+		authMethod = "oauth"
+		oauthClientId = oauthClientIdFlag
+	}
 
 	if usernameFlag != "" {
 		if ProviderRequiresUsername(gitProviderAddView.ProviderId) {
@@ -135,6 +153,31 @@ func GitProviderCreationView(ctx context.Context, apiClient *apiclient.APIClient
 			return baseApiUrlFlag != "" || !ProviderRequiresApiUrl(gitProviderAddView.ProviderId)
 		}),
 
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Authentication method").
+				Options(
+					huh.Option[string]{Key: "Personal access token", Value: "token"},
+					huh.Option[string]{Key: "OAuth device flow", Value: "oauth"},
+				).
+				Value(&authMethod),
+		).WithHeight(6).WithHideFunc(func() bool {
+			return tokenFlag != "" || oauthClientIdFlag != "" || !gitprovider.SupportsDeviceFlow(gitProviderAddView.ProviderId, gitProviderAddView.BaseApiUrl)
+		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("OAuth client ID").
+				Description("Client ID of an OAuth application with the device flow enabled").
+				Value(&oauthClientId).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("client ID can not be blank")
+					}
+					return nil
+				}),
+		).WithHeight(6).WithHideFunc(func() bool {
+			return oauthClientIdFlag != "" || authMethod != "oauth"
+		}),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Personal access token").
@@ -147,7 +190,7 @@ func GitProviderCreationView(ctx context.Context, apiClient *apiclient.APIClient
 					return nil
 				}),
 		).WithHeight(5).WithHideFunc(func() bool {
-			return tokenFlag != ""
+			return tokenFlag != "" || authMethod == "oauth"
 		}),
 		huh.NewGroup(
 			huh.NewInput().
@@ -212,6 +255,23 @@ func GitProviderCreationView(ctx context.Context, apiClient *apiclient.APIClient
 		return err
 	}
 
+	if authMethod == "oauth" {
+		_, err := gitprovider.GetOAuthEndpoints(gitProviderAddView.ProviderId, gitProviderAddView.BaseApiUrl)
+		if err != nil {
+			return fmt.Errorf("can not use OAuth device flow for '%s' provider: %w", gitProviderAddView.ProviderId, err)
+		}
+
+		// The token is obtained with the device flow unless the existing authorization is kept
+		if oauthClientId != initialOauthClientId || gitProviderAddView.Token == "" {
+			gitProviderAddView.Token = ""
+			gitProviderAddView.TokenExpiresAt = nil
+		}
+		gitProviderAddView.OauthClientId = &oauthClientId
+	} else {
+		gitProviderAddView.OauthClientId = nil
+		gitProviderAddView.TokenExpiresAt = nil
+	}
+
 	if selectedSigningMethod != "none" {
 		gitProviderAddView.SigningMethod = (*apiclient.SigningMethod)(&selectedSigningMethod)
 		gitProviderAddView.SigningKey = &signingKey
@@ -233,7 +293,7 @@ func IsValidSSHKey(key string) error {
 }
 
 func ProviderRequiresUsername(gitProviderId string) bool {
-	return gitProviderId == "bitbucket" || gitProviderId == "bitbucket-server" || gitProviderId == "aws-codecommit"
+	return gitProviderId == "bitbucket" || gitProviderId == "bitbucket-server" || gitProviderId == "aws-codecommit" || gitProviderId == "generic"
 }
 
 func ProviderRequiresApiUrl(gitProviderId string) bool {
@@ -246,6 +306,7 @@ func ProviderRequiresApiUrl(gitProviderId string) bool {
 		"azure-devops",
 		"aws-codecommit",
 		"gogs",
+		"generic",
 	}
 	return slices.Contains(providersRequiringApiUrl, gitProviderId)
 }
@@ -271,6 +332,8 @@ func getApiUrlDescription(gitProviderId string) string {
 		return "For example: https://ap-south-1.console.aws.amazon.com"
 	} else if gitProviderId == "gogs" {
 		return "For example: https://gogs-host.com"
+	} else if gitProviderId == "generic" {
+		return "The HTTP(S) address repositories are cloned from, SSH is not supported. For example: https://git-host.com"
 	}
 	return ""
 }
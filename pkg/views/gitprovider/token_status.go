@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+const (
+	TokenStatusValid        = "Valid"
+	TokenStatusInvalid      = "Invalid"
+	TokenStatusExpired      = "Expired"
+	TokenStatusUnknown      = "Unknown"
+	tokenExpiryWarningHours = 7 * 24
+)
+
+// Returns a label describing the result of the last token health check
+func GetTokenStatusLabel(tokenStatus *apiclient.GitProviderTokenStatus) string {
+	if tokenStatus == nil {
+		return TokenStatusUnknown
+	}
+
+	if !tokenStatus.Valid {
+		return TokenStatusInvalid
+	}
+
+	if tokenStatus.ExpiresAt == nil {
+		return TokenStatusValid
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, *tokenStatus.ExpiresAt)
+	if err != nil {
+		return TokenStatusValid
+	}
+
+	untilExpiry := time.Until(expiresAt)
+	switch {
+	case untilExpiry <= 0:
+		return TokenStatusExpired
+	case untilExpiry < time.Hour:
+		return "Expires in less than an hour"
+	case untilExpiry < 48*time.Hour:
+		return fmt.Sprintf("Expires in %d hours", int(untilExpiry.Hours()))
+	case untilExpiry < tokenExpiryWarningHours*time.Hour:
+		return fmt.Sprintf("Expires in %d days", int(untilExpiry.Hours()/24))
+	default:
+		return TokenStatusValid
+	}
+}
@@ -13,4 +13,7 @@ type GitProviderView struct {
 	Alias         string
 	SigningMethod string
 	SigningKey    string
+	TokenStatus   string
+	TokenError    string
+	TokenScopes   []string
 }
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/daytonaio/daytona/pkg/scheduler"
 	"github.com/daytonaio/daytona/pkg/views"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -23,6 +24,9 @@ type PrebuildAddView struct {
 	Branch                string
 	CommitInterval        string
 	TriggerFiles          []string
+	PullRequestTargets    []string
+	TagPatterns           []string
+	Schedule              string
 	Retention             string
 	RunBuildOnAdd         bool
 }
@@ -40,6 +44,9 @@ func PrebuildCreationView(prebuildAddView *PrebuildAddView, editing bool) {
 		triggerFilesInput += triggerFile + "\n"
 	}
 
+	pullRequestTargetsInput := strings.Join(prebuildAddView.PullRequestTargets, ", ")
+	tagPatternsInput := strings.Join(prebuildAddView.TagPatterns, ", ")
+
 	formFields := []huh.Field{
 		huh.NewInput().
 			Title("Commit interval").
@@ -62,6 +69,24 @@ func PrebuildCreationView(prebuildAddView *PrebuildAddView, editing bool) {
 			Title("Trigger files").
 			Description("Enter full paths for files whose changes you want to explicitly trigger a prebuild.\nUse newlines for multiple entries.").
 			Value(&triggerFilesInput).Lines(4),
+		huh.NewInput().
+			Title("Pull request targets").
+			Description("Prebuild pull requests opened against these branches, e.g. main, release/*.\nLeave blank to ignore pull requests").
+			Value(&pullRequestTargetsInput),
+		huh.NewInput().
+			Title("Tag patterns").
+			Description("Prebuild tags matching these patterns, e.g. v*.\nLeave blank to ignore tags").
+			Value(&tagPatternsInput),
+		huh.NewInput().
+			Title("Schedule").
+			Description("Cron expression on which to rebuild the branch, e.g. 0 3 * * *.\nLeave blank to disable scheduled prebuilds").
+			Value(&prebuildAddView.Schedule).
+			Validate(func(str string) error {
+				if str == "" {
+					return nil
+				}
+				return scheduler.ValidateSchedule(str)
+			}),
 		huh.NewInput().
 			Title("Retention").
 			Description("Maximum number of resulting builds stored at a time").
@@ -105,4 +130,19 @@ func PrebuildCreationView(prebuildAddView *PrebuildAddView, editing bool) {
 			prebuildAddView.TriggerFiles = append(prebuildAddView.TriggerFiles, strings.TrimRight(line, " "))
 		}
 	}
+
+	prebuildAddView.PullRequestTargets = splitPatterns(pullRequestTargetsInput)
+	prebuildAddView.TagPatterns = splitPatterns(tagPatternsInput)
+}
+
+func splitPatterns(input string) []string {
+	patterns := []string{}
+	for _, pattern := range strings.Split(input, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
 }
@@ -40,6 +40,18 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 		output += getInfoLine("Commit interval", fmt.Sprint(*prebuild.CommitInterval)) + "\n"
 	}
 
+	if len(prebuild.PullRequestTargets) > 0 {
+		output += getInfoLine("PR targets", strings.Join(prebuild.PullRequestTargets, ", ")) + "\n"
+	}
+
+	if len(prebuild.TagPatterns) > 0 {
+		output += getInfoLine("Tag patterns", strings.Join(prebuild.TagPatterns, ", ")) + "\n"
+	}
+
+	if prebuild.Schedule != nil && *prebuild.Schedule != "" {
+		output += getInfoLine("Schedule", *prebuild.Schedule) + "\n"
+	}
+
 	output += getInfoLine("Build retention", fmt.Sprint(prebuild.Retention)) + "\n"
 
 	triggerFileCount := len(prebuild.TriggerFiles)
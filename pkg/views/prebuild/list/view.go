@@ -14,13 +14,15 @@ import (
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 )
 
-var maxTriggerFilesStringLength = 24
+var maxListStringLength = 24
 
 type rowData struct {
 	WorkspaceTemplateName string
 	Branch                string
 	CommitInterval        string
 	TriggerFiles          string
+	PullRequestTargets    string
+	TagPatterns           string
 	Retention             string
 }
 
@@ -37,7 +39,7 @@ func ListPrebuilds(prebuildList []apiclient.PrebuildDTO) {
 	}
 
 	table := util.GetTableView(data, []string{
-		"Workspace Template", "Branch", "Commit Interval", "Trigger files", "Build Retention",
+		"Workspace Template", "Branch", "Commit Interval", "Trigger files", "PR Targets", "Tags", "Build Retention",
 	}, nil, func() {
 		renderUnstyledList(prebuildList)
 	})
@@ -65,7 +67,9 @@ func getRowFromData(prebuildConfig apiclient.PrebuildDTO) []string {
 	} else {
 		data.CommitInterval = views.InactiveStyle.Render("None")
 	}
-	data.TriggerFiles = getTriggerFilesString(prebuildConfig.TriggerFiles)
+	data.TriggerFiles = getListString(prebuildConfig.TriggerFiles)
+	data.PullRequestTargets = getListString(prebuildConfig.PullRequestTargets)
+	data.TagPatterns = getListString(prebuildConfig.TagPatterns)
 	data.Retention = strconv.Itoa(int(prebuildConfig.Retention))
 
 	return []string{
@@ -73,30 +77,32 @@ func getRowFromData(prebuildConfig apiclient.PrebuildDTO) []string {
 		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(data.Branch)),
 		views.ActiveStyle.Render(data.CommitInterval),
 		views.DefaultRowDataStyle.Render(data.TriggerFiles),
+		views.DefaultRowDataStyle.Render(data.PullRequestTargets),
+		views.DefaultRowDataStyle.Render(data.TagPatterns),
 		views.DefaultRowDataStyle.Render(data.Retention),
 	}
 }
 
-func getTriggerFilesString(triggerFiles []string) string {
-	if len(triggerFiles) == 0 {
+func getListString(items []string) string {
+	if len(items) == 0 {
 		return views.InactiveStyle.Render("None")
 	}
 
-	var fileString string
+	var itemString string
 	result := "[ "
 
-	for i, triggerFile := range triggerFiles {
-		fileString += triggerFile
-		if i != len(triggerFiles)-1 {
-			fileString += ", "
+	for i, item := range items {
+		itemString += item
+		if i != len(items)-1 {
+			itemString += ", "
 		}
 	}
 
-	if len(fileString) > maxTriggerFilesStringLength {
-		fileString = fileString[:maxTriggerFilesStringLength-3] + "..."
+	if len(itemString) > maxListStringLength {
+		itemString = itemString[:maxListStringLength-3] + "..."
 	}
 
-	result += fileString
+	result += itemString
 	result += " ]"
 
 	return result
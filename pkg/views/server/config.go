@@ -65,6 +65,18 @@ func RenderConfig(config *server.Config) {
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Local Runner Disabled: "), "Yes") + "\n\n"
 	}
 
+	if config.PrBotEnabled != nil && *config.PrBotEnabled {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Pull Request Bot: "), "Enabled") + "\n\n"
+
+		if config.PrBotLinkTemplate != nil {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Pull Request Bot Link Template: "), *config.PrBotLinkTemplate) + "\n\n"
+		}
+	}
+
+	if config.BuildLinkTemplate != nil && *config.BuildLinkTemplate != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Link Template: "), *config.BuildLinkTemplate) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Registry URL: "), config.RegistryUrl) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Server Download URL: "), config.ServerDownloadUrl) + "\n\n"
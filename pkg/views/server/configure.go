@@ -7,6 +7,7 @@ import (
 	"errors"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -51,6 +52,17 @@ func (m *Model) createForm() *huh.Form {
 	logFileMaxBackups := strconv.Itoa(int(m.config.LogFile.MaxBackups))
 	logFileMaxAge := strconv.Itoa(int(m.config.LogFile.MaxAge))
 
+	// Configs saved before the pull request bot was added do not have the field
+	if m.config.PrBotEnabled == nil {
+		m.config.PrBotEnabled = apiclient.PtrBool(false)
+	}
+	if m.config.PrBotLinkTemplate == nil {
+		m.config.PrBotLinkTemplate = apiclient.PtrString("")
+	}
+	if m.config.BuildLinkTemplate == nil {
+		m.config.BuildLinkTemplate = apiclient.PtrString("")
+	}
+
 	return huh.NewForm(
 		huh.NewGroup(
 			huh.NewInput().
@@ -115,6 +127,38 @@ func (m *Model) createForm() *huh.Form {
 				Description("Directory will be created if it does not exist").
 				Value(&m.config.BinariesPath),
 		),
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Pull Request Bot").
+				Description("Comments a link for opening a workspace on pull requests of repositories with prebuilds").
+				Value(m.config.PrBotEnabled),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Pull Request Bot Link Template").
+				Description("URL of the page that creates a workspace, {url} is replaced with the pull request branch URL").
+				Value(m.config.PrBotLinkTemplate).
+				Validate(func(s string) error {
+					if !strings.Contains(s, "{url}") {
+						return errors.New("link template must contain {url}")
+					}
+					return nil
+				}),
+		).WithHideFunc(func() bool {
+			return !*m.config.PrBotEnabled
+		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Build Link Template").
+				Description("URL of the page that shows a build, {buildId} is replaced with the build ID. Commit statuses of prebuilds link to it.").
+				Value(m.config.BuildLinkTemplate).
+				Validate(func(s string) error {
+					if s != "" && !strings.Contains(s, "{buildId}") {
+						return errors.New("link template must contain {buildId}")
+					}
+					return nil
+				}),
+		),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Log File Path").
@@ -24,7 +24,8 @@ var propertyValueStyle = lipgloss.NewStyle().
 	Foreground(views.Light).
 	Bold(true)
 
-func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
+// Render shows the workspace info. prUpdated marks that the pull request the workspace was created from has new commits.
+func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool, prUpdated bool) {
 	var isCreationView bool
 	var output string
 	nameLabel := "Name"
@@ -56,7 +57,7 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 		return
 	}
 
-	output += getWorkspaceDataOutput(workspace, isCreationView)
+	output += getWorkspaceDataOutput(workspace, isCreationView, prUpdated)
 
 	if !isCreationView {
 		output = views.GetStyledMainTitle("Workspace Info") + "\n" + output
@@ -99,7 +100,7 @@ func renderTUIView(output string, width int, isCreationView bool) {
 	fmt.Println(content)
 }
 
-func getWorkspaceDataOutput(workspace *apiclient.WorkspaceDTO, isCreationView bool) string {
+func getWorkspaceDataOutput(workspace *apiclient.WorkspaceDTO, isCreationView bool, prUpdated bool) string {
 	var output string
 	var repositoryUrl string
 
@@ -116,7 +117,19 @@ func getWorkspaceDataOutput(workspace *apiclient.WorkspaceDTO, isCreationView bo
 		output += getInfoLineGitStatus("Branch", workspace.Metadata.GitStatus) + "\n"
 	}
 
-	output += getInfoLinePrNumber(workspace.Repository.PrNumber, workspace.Repository, workspace.Metadata)
+	output += getInfoLinePrNumber(workspace.Repository.PrNumber, workspace.Repository, workspace.Metadata, prUpdated)
+
+	if workspace.Metadata != nil && len(workspace.Metadata.LifecycleHooks) > 0 {
+		output += getInfoLineLifecycleHooks("Lifecycle hooks", workspace.Metadata.LifecycleHooks)
+	}
+
+	if workspace.Metadata != nil && len(workspace.Metadata.Services) > 0 {
+		output += getInfoLineServices("Services", workspace.Metadata.Services)
+	}
+
+	if workspace.Metadata != nil && len(workspace.Metadata.ForwardPorts) > 0 {
+		output += getInfoLineForwardPorts("Forwarded ports", workspace.Metadata.ForwardPorts)
+	}
 
 	if !isCreationView {
 		output += getInfoLine("Target", fmt.Sprintf("%s (%s)", workspace.Target.Name, workspace.TargetId)) + "\n"
@@ -124,6 +137,26 @@ func getWorkspaceDataOutput(workspace *apiclient.WorkspaceDTO, isCreationView bo
 
 	output += getInfoLine("Repository", repositoryUrl)
 
+	for _, additionalRepository := range workspace.AdditionalRepositories {
+		output += getInfoLineAdditionalRepository(additionalRepository, workspace.Metadata)
+	}
+
+	return output
+}
+
+func getInfoLineAdditionalRepository(additionalRepository apiclient.WorkspaceRepository, metadata *apiclient.WorkspaceMetadata) string {
+	repositoryUrl := additionalRepository.Repository.Url
+	repositoryUrl = strings.TrimPrefix(repositoryUrl, "https://")
+	repositoryUrl = strings.TrimPrefix(repositoryUrl, "http://")
+
+	output := getInfoLine(additionalRepository.ClonePath, repositoryUrl)
+
+	if metadata != nil {
+		if status, ok := metadata.AdditionalGitStatuses[additionalRepository.ClonePath]; ok {
+			output += getInfoLineGitStatus("", &status) + "\n"
+		}
+	}
+
 	return output
 }
 
@@ -190,9 +223,84 @@ func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 	return output
 }
 
-func getInfoLinePrNumber(PrNumber *int32, repo apiclient.GitRepository, metadata *apiclient.WorkspaceMetadata) string {
+func getInfoLineLifecycleHooks(key string, hooks []apiclient.LifecycleHookStatus) string {
+	output := ""
+
+	for i, hook := range hooks {
+		value := fmt.Sprintf("%s (%s)", hook.Name, hook.State)
+		if hook.Error != nil {
+			value = fmt.Sprintf("%s (%s: %s)", hook.Name, hook.State, *hook.Error)
+		}
+
+		if i == 0 {
+			output += getInfoLine(key, value)
+		} else {
+			output += getInfoLine("", value)
+		}
+	}
+
+	return output + "\n"
+}
+
+func getInfoLineServices(key string, services []apiclient.WorkspaceService) string {
+	output := ""
+
+	for i, service := range services {
+		value := fmt.Sprintf("%s (%s)", service.Name, service.State)
+		if service.Status != nil && *service.Status != "" {
+			value = fmt.Sprintf("%s (%s: %s)", service.Name, service.State, *service.Status)
+		}
+
+		if i == 0 {
+			output += getInfoLine(key, value)
+		} else {
+			output += getInfoLine("", value)
+		}
+	}
+
+	return output + "\n"
+}
+
+func getInfoLineForwardPorts(key string, ports []apiclient.WorkspacePort) string {
+	output := ""
+
+	for i, port := range ports {
+		value := fmt.Sprint(port.Port)
+		if port.Label != nil && *port.Label != "" {
+			value = fmt.Sprintf("%d (%s)", port.Port, *port.Label)
+		}
+
+		var details []string
+		if port.GetRequireLocalPort() {
+			details = append(details, "requires local port")
+		}
+		if port.OnAutoForward != nil && *port.OnAutoForward != "" {
+			details = append(details, fmt.Sprintf("on auto forward: %s", *port.OnAutoForward))
+		}
+		if len(details) > 0 {
+			value += " - " + strings.Join(details, ", ")
+		}
+
+		if i == 0 {
+			output += getInfoLine(key, value)
+		} else {
+			output += getInfoLine("", value)
+		}
+	}
+
+	return output + "\n"
+}
+
+func getInfoLinePrNumber(PrNumber *int32, repo apiclient.GitRepository, metadata *apiclient.WorkspaceMetadata, prUpdated bool) string {
 	if PrNumber != nil && (metadata == nil || metadata.GitStatus.CurrentBranch == repo.Branch) {
-		return getInfoLine("PR Number", fmt.Sprintf("#%d", *PrNumber)) + "\n"
+		value := fmt.Sprintf("#%d", *PrNumber)
+		if repo.PullRequest != nil {
+			value += fmt.Sprintf(" (%s <- %s)", repo.PullRequest.BaseBranch, repo.PullRequest.HeadBranch)
+		}
+		if prUpdated {
+			value += " - PR updated since workspace creation, run 'daytona pr sync' to update"
+		}
+		return getInfoLine("PR Number", value) + "\n"
 	}
 	return ""
 }
@@ -84,7 +84,7 @@ func getTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProviders bool)
 
 func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 	for _, workspace := range workspaceList {
-		info_view.Render(&workspace, "", true)
+		info_view.Render(&workspace, "", true, false)
 
 		if workspace.Id != workspaceList[len(workspaceList)-1].Id {
 			fmt.Printf("\n%s\n\n", views.SeparatorString)
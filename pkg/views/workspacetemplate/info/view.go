@@ -44,6 +44,14 @@ func Render(workspaceTemplate *apiclient.WorkspaceTemplate, apiServerConfig *api
 
 	output += getInfoLine("Git Provider ID", gitProviderConfig) + "\n"
 
+	if len(workspaceTemplate.SparsePaths) > 0 {
+		output += getInfoLine("Sparse paths", strings.Join(workspaceTemplate.SparsePaths, ", ")) + "\n"
+	}
+
+	if workspaceTemplate.Lfs != nil {
+		output += getInfoLine("Git LFS", getLfsInfo(workspaceTemplate.Lfs)) + "\n"
+	}
+
 	if workspaceTemplate.Default {
 		output += getInfoLine("Default", "Yes") + "\n"
 	}
@@ -73,6 +81,14 @@ func Render(workspaceTemplate *apiclient.WorkspaceTemplate, apiServerConfig *api
 		output += getInfoLine("Devcontainer path", workspaceTemplate.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if workspaceTemplate.BuildConfig != nil && workspaceTemplate.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", workspaceTemplate.BuildConfig.Dockerfile.FilePath) + "\n"
+	}
+
+	if workspaceTemplate.BuildConfig != nil && workspaceTemplate.BuildConfig.Nix != nil {
+		output += getInfoLine("Nix file path", workspaceTemplate.BuildConfig.Nix.FilePath) + "\n"
+	}
+
 	prebuildCount := len(workspaceTemplate.Prebuilds)
 
 	if prebuildCount > 0 {
@@ -121,6 +137,19 @@ func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
 
+func getLfsInfo(lfsConfig *apiclient.GitLfsConfig) string {
+	info := "All objects"
+	if len(lfsConfig.Include) > 0 {
+		info = "Include " + strings.Join(lfsConfig.Include, ", ")
+	}
+
+	if len(lfsConfig.Exclude) > 0 {
+		info += "; Exclude " + strings.Join(lfsConfig.Exclude, ", ")
+	}
+
+	return info
+}
+
 func getPrebuildLine(prebuild apiclient.PrebuildConfig, order *int) string {
 	var line string
 	if order != nil {
@@ -146,5 +175,17 @@ func GetLabelFromBuild(build *apiclient.BuildConfig) string {
 		return fmt.Sprintf("Devcontainer (%s)", build.Devcontainer.FilePath)
 	}
 
+	if build.Dockerfile != nil {
+		return fmt.Sprintf("Dockerfile (%s)", build.Dockerfile.FilePath)
+	}
+
+	if build.Nix != nil {
+		return fmt.Sprintf("Nix (%s)", build.Nix.FilePath)
+	}
+
+	if build.Buildpacks != nil {
+		return "Buildpacks"
+	}
+
 	return ""
 }